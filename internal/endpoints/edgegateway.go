@@ -18,6 +18,9 @@ const (
 	EdgeGatewayDelete             = EdgeGatewayGet
 	EdgeGatewayUpdate             = EdgeGatewayGet
 
+	T0List = "/infrapicustomerproxy/v2.0/tier-0-vrfs"
+	T0Get  = "/infrapicustomerproxy/v2.0/tier-0-vrfs/{t0-name}"
+
 	NetworkServiceGet    = "/infrapicustomerproxy/v2.0/network"
 	NetworkServiceCreate = "/infrapicustomerproxy/v2.0/services"
 	NetworkServiceDelete = "/infrapicustomerproxy/v2.0/services/{service-id}"
@@ -0,0 +1,42 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package utils //nolint:revive,nolintlint
+
+import (
+	"context"
+	"time"
+)
+
+// PollMinInterval and PollMaxInterval bound the exponential backoff between two polls.
+var (
+	PollMinInterval = 2 * time.Second
+	PollMaxInterval = 30 * time.Second
+)
+
+// Poll calls condition with an exponential backoff until it reports done or returns an error.
+// If the context is done first, the error of the context is returned.
+func Poll(ctx context.Context, condition func() (done bool, err error)) error {
+	interval := PollMinInterval
+
+	for {
+		done, err := condition()
+		if err != nil || done {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+
+		interval = min(interval*2, PollMaxInterval)
+	}
+}
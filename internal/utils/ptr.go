@@ -13,3 +13,21 @@ package utils //nolint:revive,nolintlint
 func ToPTR[T any](v T) *T {
 	return &v
 }
+
+// ToPTROrNil returns a pointer to the value, or nil if the value is the zero value.
+func ToPTROrNil[T comparable](v T) *T {
+	var zero T
+	if v == zero {
+		return nil
+	}
+	return &v
+}
+
+// FromPTR returns the value pointed to, or the zero value if the pointer is nil.
+func FromPTR[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
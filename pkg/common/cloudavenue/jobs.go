@@ -16,6 +16,7 @@ import (
 
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/endpoints"
 	clientcloudavenue "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/clients/cloudavenue"
+	caverrors "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
 )
 
 // JobStatusMessage is a type for job status.
@@ -168,11 +169,11 @@ func (j *JobStatus) WaitWithContext(ctx context.Context, refreshInterval int) er
 				// find the first action that failed
 				for _, a := range j.Actions {
 					if a.Status == string(FAILED) || a.Status == string(ERROR) {
-						return fmt.Errorf("job failed: %s", a.Details)
+						return fmt.Errorf("%w: %s", caverrors.ErrJobFailed, a.Details)
 					}
 				}
 
-				return fmt.Errorf("job failed: %s", j.Description)
+				return fmt.Errorf("%w: %s", caverrors.ErrJobFailed, j.Description)
 			}
 		}
 	}
@@ -20,6 +20,11 @@ var (
 	ErrNotFound      = errors.New("not found")
 	ErrEmpty         = errors.New("empty")
 	ErrInvalidFormat = errors.New("invalid format")
+	ErrConflict      = errors.New("conflict")
+	ErrAlreadyExists = errors.New("already exists")
+
+	// * Job.
+	ErrJobFailed = errors.New("job failed")
 
 	// * Client.
 	ErrConfigureVmwareClient       = errors.New("unable to configure vmware client")
@@ -31,6 +36,42 @@ var (
 	ErrInvalidFirewallRuleIPProtocol = fmt.Errorf("firewall rule ipProtocol has an %w", ErrInvalidFormat)
 	ErrInvalidFirewallRuleAction     = fmt.Errorf("firewall rule action has an %w", ErrInvalidFormat)
 
+	// * EdgeGatewayFirewall.
+	ErrEdgeGatewayFirewallConflict = fmt.Errorf("edge gateway firewall rules have been modified concurrently: %w", ErrConflict)
+
+	// * EdgeGatewayBandwidth.
+	ErrEdgeGatewayBandwidthNotAllowed       = fmt.Errorf("edge gateway bandwidth is not allowed for the T0 class of service: %w", ErrInvalidFormat)
+	ErrEdgeGatewayBandwidthCapacityExceeded = errors.New("edge gateway bandwidth exceeds the remaining capacity of the T0")
+	ErrEdgeGatewayBandwidthNotChecked       = errors.New("edge gateway bandwidth can't be checked without the T0 router")
+
+	// * EdgeGatewayNetworkService.
+	ErrEdgeGatewayNetworkServiceNotEnabled = errors.New("edge gateway network service is not enabled")
+
+	// * EdgeGatewayStatus.
+	ErrEdgeGatewayRealizationFailed = errors.New("edge gateway realization failed")
+
+	// * EdgeGatewaySnapshot.
+	ErrEdgeGatewaySnapshotVersionUnsupported = fmt.Errorf("edge gateway snapshot version is not supported: %w", ErrInvalidFormat)
+	ErrEdgeGatewaySnapshotObjectUnsupported  = errors.New("edge gateway object is not supported by the snapshot")
+	ErrEdgeGatewaySnapshotIPNotAllocated     = fmt.Errorf("IP address of the snapshot is not allocated to the edge gateway: %w", ErrNotFound)
+
+	// * EdgeGatewayPublicIP.
+	ErrEdgeGatewayPublicIPInUse = fmt.Errorf("public IP is still referenced by NAT rules: %w", ErrConflict)
+
+	// * LoadBalancerPool.
+	ErrLoadBalancerPoolConflict              = fmt.Errorf("load balancer pool has been modified concurrently: %w", ErrConflict)
+	ErrLoadBalancerPoolMembersManagedByGroup = errors.New("load balancer pool members are managed by a group")
+
+	// * LoadBalancerVirtualService.
+	ErrLoadBalancerVirtualServiceConflict = fmt.Errorf("load balancer virtual service has been modified concurrently: %w", ErrConflict)
+	ErrLoadBalancerPoolSwitchReverted     = errors.New("virtual service health dropped after the pool switch, the previous pool has been restored")
+
+	// * LoadBalancerServiceEngineGroup.
+	ErrSEGCapacityExceeded = errors.New("service engine group has no capacity left for a new virtual service")
+
+	// * LoadBalancerPolicies.
+	ErrLoadBalancerPoliciesConflict = fmt.Errorf("load balancer HTTP policies have been modified concurrently: %w", ErrConflict)
+
 	// * FirewallAppPortProfile.
 	ErrInvalidFirewallAppPortProfileProtocol = fmt.Errorf("firewall app port profile protocol has an %w", ErrInvalidFormat)
 )
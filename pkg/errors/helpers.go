@@ -15,3 +15,13 @@ import "errors"
 func IsNotFound(e error) bool {
 	return errors.Is(e, ErrNotFound)
 }
+
+// IsConflict - Returns true if the error is due to a concurrent modification.
+func IsConflict(e error) bool {
+	return errors.Is(e, ErrConflict)
+}
+
+// IsAlreadyExists - Returns true if the error is due to an object that already exists.
+func IsAlreadyExists(e error) bool {
+	return errors.Is(e, ErrAlreadyExists)
+}
@@ -0,0 +1,192 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/orange-cloudavenue/common-go/validators"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/endpoints"
+	commoncloudavenue "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/common/cloudavenue"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
+)
+
+// BandwidthPlanner returns the bandwidth planner of the edge gateways.
+func (c *client) BandwidthPlanner() *BandwidthPlanner {
+	return &BandwidthPlanner{client: c}
+}
+
+// GetT0Capacity retrieves the bandwidth capacity of the T0 router.
+func (p *BandwidthPlanner) GetT0Capacity(ctx context.Context, t0Name string) (*T0BandwidthCapacityModel, error) {
+	if t0Name == "" {
+		return nil, fmt.Errorf("t0Name is %w. Please provide a valid t0Name", errors.ErrEmpty)
+	}
+
+	if err := p.client.clientCloudavenue.Refresh(); err != nil {
+		return nil, err
+	}
+
+	capacity, _, err := p.getT0Capacity(ctx, t0Name)
+	return capacity, err
+}
+
+// ValidateCreate validates the bandwidth of an edge gateway creation request.
+// When the request has no UplinkT0, the only T0 router available is used.
+// If several T0 routers are available, the T0 router is chosen by Cloud Avenue on creation,
+// so the bandwidth is not checked and errors.ErrEdgeGatewayBandwidthNotChecked is returned.
+// Set UplinkT0 to check the bandwidth against the capacity of a T0 router.
+func (p *BandwidthPlanner) ValidateCreate(ctx context.Context, edgeGateway *EdgeGatewayModelRequest) error {
+	if err := validators.New().StructCtx(ctx, edgeGateway); err != nil {
+		return err
+	}
+
+	if err := p.client.clientCloudavenue.Refresh(); err != nil {
+		return err
+	}
+
+	t0Name := edgeGateway.UplinkT0
+	if t0Name == "" {
+		t0Names, err := p.listT0Names(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(t0Names) != 1 {
+			return fmt.Errorf("%d T0 routers are available, set UplinkT0 to choose one: %w", len(t0Names), errors.ErrEdgeGatewayBandwidthNotChecked)
+		}
+
+		t0Name = t0Names[0]
+	}
+
+	capacity, _, err := p.getT0Capacity(ctx, t0Name)
+	if err != nil {
+		return err
+	}
+
+	return capacity.validate(edgeGateway.Bandwidth, 0)
+}
+
+// ValidateUpdate validates the bandwidth of an edge gateway update request.
+// The current bandwidth of the edge gateway is considered as available.
+func (p *BandwidthPlanner) ValidateUpdate(ctx context.Context, edgeGateway *EdgeGatewayModelUpdate) error {
+	if err := validators.New().StructCtx(ctx, edgeGateway); err != nil {
+		return err
+	}
+
+	if err := p.client.clientCloudavenue.Refresh(); err != nil {
+		return err
+	}
+
+	vcdEdgeGateway, err := p.client.getVCDEdgeGateway(ctx, edgeGateway.ID)
+	if err != nil {
+		return err
+	}
+
+	egm := &EdgeGatewayModel{}
+	egm.fromVCD(vcdEdgeGateway.EdgeGateway)
+
+	capacity, edgeGateways, err := p.getT0Capacity(ctx, egm.UplinkT0)
+	if err != nil {
+		return err
+	}
+
+	currentBandwidth := 0
+	for _, eg := range edgeGateways {
+		if eg.ID == egm.ID {
+			currentBandwidth = eg.Bandwidth
+			break
+		}
+	}
+
+	return capacity.validate(edgeGateway.Bandwidth, currentBandwidth)
+}
+
+// getT0Capacity computes the bandwidth capacity of the T0 router.
+// It also returns the edge gateways of the T0 router with their bandwidth,
+// except for dedicated T0 routers where the bandwidth is not retrieved.
+func (p *BandwidthPlanner) getT0Capacity(ctx context.Context, t0Name string) (*T0BandwidthCapacityModel, []*EdgeGatewayModel, error) {
+	t0, err := p.getT0(ctx, t0Name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	allowed, ok := v1.EdgeGatewayAllowedBandwidth[t0.ClassService]
+	if !ok {
+		return nil, nil, fmt.Errorf("class of service %s of T0 %s %w", t0.ClassService, t0Name, errors.ErrNotFound)
+	}
+
+	capacity := &T0BandwidthCapacityModel{
+		T0Name:        t0Name,
+		ClassService:  t0.ClassService,
+		Total:         allowed.T0TotalBandwidth,
+		AllowedValues: allowed.T1AllowedBandwidth,
+	}
+
+	// The API returns a bandwidth of 0 for the edge gateways of a dedicated T0,
+	// the allocated bandwidth can't be computed.
+	// See: https://github.com/orange-cloudavenue/terraform-provider-cloudavenue/issues/1229
+	if t0.ClassService.IsVRFDedicated() {
+		return capacity, nil, nil
+	}
+
+	edgeGateways, err := p.client.ListEdgeGatewayWithOptions(ctx, ListEdgeGatewayOptions{UplinkT0: t0Name})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, eg := range edgeGateways {
+		capacity.Allocated += eg.Bandwidth
+	}
+
+	capacity.Remaining = max(capacity.Total-capacity.Allocated, 0)
+	capacity.Computable = true
+
+	return capacity, edgeGateways, nil
+}
+
+// getT0 retrieves the T0 router from the InfrAPI.
+func (p *BandwidthPlanner) getT0(ctx context.Context, t0Name string) (*t0API, error) {
+	r, err := p.client.clientCloudavenue.R().
+		SetContext(ctx).
+		SetResult(&t0API{}).
+		SetError(&commoncloudavenue.APIErrorResponse{}).
+		SetPathParam("t0-name", t0Name).
+		Get(endpoints.T0Get)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving T0 %s: %w", t0Name, err)
+	}
+
+	if r.IsError() {
+		return nil, fmt.Errorf("error retrieving T0 %s: %w", t0Name, commoncloudavenue.ToError(r))
+	}
+
+	return r.Result().(*t0API), nil
+}
+
+// listT0Names retrieves the names of the T0 routers from the InfrAPI.
+func (p *BandwidthPlanner) listT0Names(ctx context.Context) ([]string, error) {
+	r, err := p.client.clientCloudavenue.R().
+		SetContext(ctx).
+		SetResult(&[]string{}).
+		SetError(&commoncloudavenue.APIErrorResponse{}).
+		Get(endpoints.T0List)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving T0 routers: %w", err)
+	}
+
+	if r.IsError() {
+		return nil, fmt.Errorf("error retrieving T0 routers: %w", commoncloudavenue.ToError(r))
+	}
+
+	return *r.Result().(*[]string), nil
+}
@@ -0,0 +1,251 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/endpoints"
+	clientcloudavenue "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/clients/cloudavenue"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
+)
+
+func TestBandwidthPlanner_GetT0Capacity(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	defer httpmock.DeactivateAndReset()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	edgeGatewayID2 := urn.Gateway.String() + uuid.New().String()
+
+	tests := []struct {
+		name          string
+		t0Name        string
+		mockFunc      func()
+		expected      *T0BandwidthCapacityModel
+		expectedError bool
+		err           error
+	}{
+		{
+			name:   testSuccess,
+			t0Name: testVRFName,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil).Times(2)
+				testMockT0(t, v1.T0ClassServiceVRFStandard)
+				clientCAV.EXPECT().OpenAPIGetPage(gomock.Any(), gomock.Any()).Return(testEdgeGatewaysPage(t, 1, 1,
+					&govcdtypes.OpenAPIEdgeGateway{ID: edgeGatewayID, EdgeGatewayUplinks: []govcdtypes.EdgeGatewayUplinks{{UplinkName: testVRFName}}},
+					&govcdtypes.OpenAPIEdgeGateway{ID: edgeGatewayID2, EdgeGatewayUplinks: []govcdtypes.EdgeGatewayUplinks{{UplinkName: testVRFName}}},
+					// Edge gateways of other T0 routers are ignored
+					&govcdtypes.OpenAPIEdgeGateway{ID: urn.Gateway.String() + uuid.New().String(), EdgeGatewayUplinks: []govcdtypes.EdgeGatewayUplinks{{UplinkName: "other-t0"}}},
+				), nil)
+				testMockBandwidths(t, map[string]int{edgeGatewayID: 50, edgeGatewayID2: 25})
+				clientCAV.EXPECT().R().DoAndReturn(clientcloudavenue.MockClient().R).Times(3)
+			},
+			expected: &T0BandwidthCapacityModel{
+				T0Name:        testVRFName,
+				ClassService:  v1.T0ClassServiceVRFStandard,
+				Total:         300,
+				Allocated:     75,
+				Remaining:     225,
+				AllowedValues: v1.EdgeGatewayAllowedBandwidth[v1.T0ClassServiceVRFStandard].T1AllowedBandwidth,
+				Computable:    true,
+			},
+		},
+		{
+			name:   "success-dedicated-t0",
+			t0Name: testVRFName,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				testMockT0(t, v1.T0ClassServiceVRFDedicatedLarge)
+				clientCAV.EXPECT().R().DoAndReturn(clientcloudavenue.MockClient().R)
+			},
+			expected: &T0BandwidthCapacityModel{
+				T0Name:        testVRFName,
+				ClassService:  v1.T0ClassServiceVRFDedicatedLarge,
+				Total:         10000,
+				AllowedValues: v1.EdgeGatewayAllowedBandwidth[v1.T0ClassServiceVRFDedicatedLarge].T1AllowedBandwidth,
+				Computable:    false,
+			},
+		},
+		{
+			name:          "error-empty-t0-name",
+			t0Name:        "",
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           errors.ErrEmpty,
+		},
+		{
+			name:   "error-unknown-class-service",
+			t0Name: testVRFName,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				testMockT0(t, "UNKNOWN")
+				clientCAV.EXPECT().R().DoAndReturn(clientcloudavenue.MockClient().R)
+			},
+			expectedError: true,
+			err:           errors.ErrNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			httpmock.Reset()
+			test.mockFunc()
+
+			capacity, err := c.BandwidthPlanner().GetT0Capacity(context.Background(), test.t0Name)
+			if !test.expectedError {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, capacity)
+				return
+			}
+			assert.Error(t, err)
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+}
+
+func TestBandwidthPlanner_ValidateCreate(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	defer httpmock.DeactivateAndReset()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+
+	// mockT0List registers the responder of the T0 routers list.
+	mockT0List := func(t0Names ...string) {
+		responder, err := httpmock.NewJsonResponder(200, t0Names)
+		if err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", endpoints.T0List, responder)
+	}
+
+	// mockCapacity mocks a standard T0 with 250 Mbps allocated.
+	mockCapacity := func() {
+		clientCAV.EXPECT().Refresh().Return(nil).Times(2)
+		testMockT0(t, v1.T0ClassServiceVRFStandard)
+		clientCAV.EXPECT().OpenAPIGetPage(gomock.Any(), gomock.Any()).Return(testEdgeGatewaysPage(t, 1, 1,
+			&govcdtypes.OpenAPIEdgeGateway{ID: edgeGatewayID, EdgeGatewayUplinks: []govcdtypes.EdgeGatewayUplinks{{UplinkName: testVRFName}}},
+		), nil)
+		testMockBandwidths(t, map[string]int{edgeGatewayID: 250})
+	}
+
+	tests := []struct {
+		name          string
+		request       *EdgeGatewayModelRequest
+		mockFunc      func()
+		expectedError bool
+		err           error
+	}{
+		{
+			name: testSuccess,
+			request: &EdgeGatewayModelRequest{
+				OwnerRef:  &govcdtypes.OpenApiReference{ID: vdcID},
+				UplinkT0:  testVRFName,
+				Bandwidth: 50,
+			},
+			mockFunc: func() {
+				mockCapacity()
+				clientCAV.EXPECT().R().DoAndReturn(clientcloudavenue.MockClient().R).Times(2)
+			},
+		},
+		{
+			name: "success-single-t0",
+			request: &EdgeGatewayModelRequest{
+				OwnerRef:  &govcdtypes.OpenApiReference{ID: vdcID},
+				Bandwidth: 25,
+			},
+			mockFunc: func() {
+				mockCapacity()
+				mockT0List(testVRFName)
+				clientCAV.EXPECT().R().DoAndReturn(clientcloudavenue.MockClient().R).Times(3)
+			},
+		},
+		{
+			name: "error-several-t0-not-checked",
+			request: &EdgeGatewayModelRequest{
+				OwnerRef:  &govcdtypes.OpenApiReference{ID: vdcID},
+				Bandwidth: 25,
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				mockT0List(testVRFName, "other-t0")
+				clientCAV.EXPECT().R().DoAndReturn(clientcloudavenue.MockClient().R)
+			},
+			expectedError: true,
+			err:           errors.ErrEdgeGatewayBandwidthNotChecked,
+		},
+		{
+			name: "error-capacity-exceeded",
+			request: &EdgeGatewayModelRequest{
+				OwnerRef:  &govcdtypes.OpenApiReference{ID: vdcID},
+				UplinkT0:  testVRFName,
+				Bandwidth: 100,
+			},
+			mockFunc: func() {
+				mockCapacity()
+				clientCAV.EXPECT().R().DoAndReturn(clientcloudavenue.MockClient().R).Times(2)
+			},
+			expectedError: true,
+			err:           errors.ErrEdgeGatewayBandwidthCapacityExceeded,
+		},
+		{
+			name: "error-bandwidth-min",
+			request: &EdgeGatewayModelRequest{
+				OwnerRef:  &govcdtypes.OpenApiReference{ID: vdcID},
+				UplinkT0:  testVRFName,
+				Bandwidth: 1,
+			},
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           fmt.Errorf("Bandwidth"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			httpmock.Reset()
+			httpmock.ActivateNonDefault(clientcloudavenue.MockClient().GetClient())
+			test.mockFunc()
+
+			err := c.BandwidthPlanner().ValidateCreate(context.Background(), test.request)
+			if !test.expectedError {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err.Error())
+		})
+	}
+}
@@ -0,0 +1,79 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
+)
+
+type (
+	// BandwidthPlanner computes the bandwidth capacity of the T0 routers
+	// and validates the bandwidth of the edge gateways before they are sent to the API.
+	BandwidthPlanner struct {
+		client *client
+	}
+
+	// T0BandwidthCapacityModel represents the bandwidth capacity of a T0 router.
+	T0BandwidthCapacityModel struct {
+		// T0Name is the name of the T0 router.
+		T0Name string
+
+		// ClassService is the class of service of the T0 router.
+		ClassService v1.ClassService
+
+		// Total is the total bandwidth of the T0 router in Mbps.
+		Total int
+
+		// Allocated is the bandwidth allocated to the edge gateways of the T0 router in Mbps.
+		// Always 0 when Computable is false.
+		Allocated int
+
+		// Remaining is the bandwidth still available on the T0 router in Mbps.
+		// Always 0 when Computable is false.
+		Remaining int
+
+		// AllowedValues is the list of bandwidth values allowed for an edge gateway in Mbps.
+		AllowedValues []int
+
+		// Computable reports whether Allocated and Remaining have been computed.
+		// It is false for dedicated T0 routers: the API returns a bandwidth of 0
+		// for their edge gateways, so the allocated bandwidth cannot be known.
+		Computable bool
+	}
+
+	// t0API is the T0 router returned by the InfrAPI.
+	t0API struct {
+		Tier0Vrf     string          `json:"tier0_vrf"`
+		ClassService v1.ClassService `json:"class_service"`
+	}
+)
+
+// IsAllowed reports whether the bandwidth is one of the allowed values.
+func (m *T0BandwidthCapacityModel) IsAllowed(bandwidth int) bool {
+	return slices.Contains(m.AllowedValues, bandwidth)
+}
+
+// validate checks that the bandwidth is allowed and fits in the remaining capacity.
+// released is the bandwidth given back by the edge gateway being updated.
+func (m *T0BandwidthCapacityModel) validate(bandwidth, released int) error {
+	if !m.IsAllowed(bandwidth) {
+		return fmt.Errorf("bandwidth %d Mbps on T0 %s (class %s), allowed values are %v: %w", bandwidth, m.T0Name, m.ClassService, m.AllowedValues, errors.ErrEdgeGatewayBandwidthNotAllowed)
+	}
+
+	if m.Computable && bandwidth > m.Remaining+released {
+		return fmt.Errorf("bandwidth %d Mbps on T0 %s, %d Mbps available: %w", bandwidth, m.T0Name, m.Remaining+released, errors.ErrEdgeGatewayBandwidthCapacityExceeded)
+	}
+
+	return nil
+}
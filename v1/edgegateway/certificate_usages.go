@@ -0,0 +1,222 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/edgeloadbalancer"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/org"
+)
+
+// ReplaceCertificateUsages replaces a certificate of the certificate library by another one in all
+// the virtual services (CertificateRef) and pools (CaCertificateRefs) of all the edge gateways.
+// It returns the plan of the objects referencing the old certificate, and applies it unless DryRun is set.
+// With DeleteOldCertificate, the old certificate is deleted once no virtual service or pool references it anymore.
+//
+// The objects are updated in the order of the usages and the replacement stops at the first failure.
+// The plan is then returned with the error: the Status of each usage tells which objects
+// already serve or trust the new certificate, which one failed and which ones are still pending.
+// The objects already updated keep the new certificate and ReplaceCertificateUsages
+// can be called again to update the remaining ones.
+func (c *client) ReplaceCertificateUsages(ctx context.Context, oldCertificateID, newCertificateID string, opts ReplaceCertificateUsagesOptions) (*CertificateUsagesPlanModel, error) {
+	if err := certificateIDValidator("oldCertificateID", oldCertificateID); err != nil {
+		return nil, err
+	}
+
+	if err := certificateIDValidator("newCertificateID", newCertificateID); err != nil {
+		return nil, err
+	}
+
+	if oldCertificateID == newCertificateID {
+		return nil, fmt.Errorf("oldCertificateID and newCertificateID are the same certificate %s", oldCertificateID)
+	}
+
+	certificates, err := c.clientOrg.ListCertificatesInLibrary(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving certificates: %w", err)
+	}
+
+	idx := slices.IndexFunc(certificates, func(certificate *org.CertificateModel) bool { return certificate.ID == newCertificateID })
+	if idx == -1 {
+		return nil, fmt.Errorf("certificate %s %w in the certificate library", newCertificateID, errors.ErrNotFound)
+	}
+	newCertificateRef := govcdtypes.OpenApiReference{ID: certificates[idx].ID, Name: certificates[idx].Name}
+
+	state, err := c.listCertificateUsages(ctx, oldCertificateID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &CertificateUsagesPlanModel{
+		OldCertificateID: oldCertificateID,
+		NewCertificateID: newCertificateID,
+		Usages:           state.usages,
+	}
+
+	if opts.DryRun {
+		return plan, nil
+	}
+
+	for i := range plan.Usages {
+		usage := &plan.Usages[i]
+
+		var err error
+		switch usage.Kind {
+		case CertificateUsageKindPool:
+			_, err = c.clientLoadBalancer.UpdatePool(ctx, usage.ID, poolRequestWithCertificate(state.pools[usage.ID], oldCertificateID, newCertificateRef))
+		case CertificateUsageKindVirtualService:
+			_, err = c.clientLoadBalancer.UpdateVirtualService(ctx, usage.ID, virtualServiceRequestWithCertificate(state.virtualServices[usage.ID], newCertificateRef.ID))
+		}
+		if err != nil {
+			usage.Status = CertificateUsageStatusFailed
+			usage.Error = err.Error()
+			return plan, fmt.Errorf("error replacing certificate of %s %s: %w", usage.Kind, usage.Name, err)
+		}
+		usage.Status = CertificateUsageStatusApplied
+	}
+
+	if opts.DeleteOldCertificate {
+		// The usages are listed again to detect the objects referencing the old certificate in the meantime
+		remaining, err := c.listCertificateUsages(ctx, oldCertificateID)
+		if err != nil {
+			return plan, err
+		}
+
+		if len(remaining.usages) > 0 {
+			return plan, fmt.Errorf("certificate %s is still referenced by %d objects and can't be deleted: %w", oldCertificateID, len(remaining.usages), errors.ErrConflict)
+		}
+
+		if err := c.clientOrg.DeleteCertificateFromLibrary(ctx, oldCertificateID); err != nil {
+			return plan, fmt.Errorf("error deleting certificate %s: %w", oldCertificateID, err)
+		}
+		plan.OldCertificateDeleted = true
+	}
+
+	return plan, nil
+}
+
+// * Local functions
+
+// listCertificateUsages retrieves the virtual services and the pools of all the edge gateways
+// referencing the certificate. The pools come before the virtual services in the usages.
+func (c *client) listCertificateUsages(ctx context.Context, certificateID string) (*certificateUsagesState, error) {
+	state := &certificateUsagesState{
+		pools:           make(map[string]*edgeloadbalancer.PoolModel),
+		virtualServices: make(map[string]*edgeloadbalancer.VirtualServiceModel),
+	}
+	vsUsages := make([]CertificateUsageModel, 0)
+
+	for edgeGateway, err := range c.IterEdgeGateways(ctx, ListEdgeGatewayOptions{SkipBandwidth: true}) {
+		if err != nil {
+			return nil, err
+		}
+
+		pools, err := c.clientLoadBalancer.ListPools(ctx, edgeGateway.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving pools of edge gateway %s: %w", edgeGateway.Name, err)
+		}
+
+		for _, pool := range pools {
+			if !slices.ContainsFunc(pool.CaCertificateRefs, func(ref govcdtypes.OpenApiReference) bool { return ref.ID == certificateID }) {
+				continue
+			}
+			state.pools[pool.ID] = pool
+			state.usages = append(state.usages, CertificateUsageModel{
+				Kind:            CertificateUsageKindPool,
+				EdgeGatewayID:   edgeGateway.ID,
+				EdgeGatewayName: edgeGateway.Name,
+				ID:              pool.ID,
+				Name:            pool.Name,
+				Status:          CertificateUsageStatusPending,
+			})
+		}
+
+		vss, err := c.clientLoadBalancer.ListVirtualServices(ctx, edgeGateway.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving virtual services of edge gateway %s: %w", edgeGateway.Name, err)
+		}
+
+		for _, vs := range vss {
+			if vs.CertificateRef == nil || vs.CertificateRef.ID != certificateID {
+				continue
+			}
+			state.virtualServices[vs.ID] = vs
+			vsUsages = append(vsUsages, CertificateUsageModel{
+				Kind:            CertificateUsageKindVirtualService,
+				EdgeGatewayID:   edgeGateway.ID,
+				EdgeGatewayName: edgeGateway.Name,
+				ID:              vs.ID,
+				Name:            vs.Name,
+				Status:          CertificateUsageStatusPending,
+			})
+		}
+	}
+
+	state.usages = append(state.usages, vsUsages...)
+
+	return state, nil
+}
+
+// poolRequestWithCertificate converts a pool to a request where the old CA certificate is replaced by the new one.
+func poolRequestWithCertificate(pool *edgeloadbalancer.PoolModel, oldCertificateID string, newCertificateRef govcdtypes.OpenApiReference) edgeloadbalancer.PoolModelRequest {
+	request := snapshotPoolFromModel(pool).PoolModelRequest
+	request.GatewayRef = pool.GatewayRef
+
+	if pool.MemberGroupRef != nil {
+		request.Members = nil
+		request.MemberGroupRef = pool.MemberGroupRef
+	}
+
+	for _, ref := range pool.CaCertificateRefs {
+		switch {
+		case ref.ID == oldCertificateID:
+			ref = newCertificateRef
+		case ref.ID == newCertificateRef.ID:
+			// The new certificate is already trusted by the pool
+			continue
+		}
+		request.CaCertificateRefs = append(request.CaCertificateRefs, ref)
+	}
+
+	return request
+}
+
+// virtualServiceRequestWithCertificate converts a virtual service to a request serving the new certificate.
+func virtualServiceRequestWithCertificate(vs *edgeloadbalancer.VirtualServiceModel, newCertificateID string) edgeloadbalancer.VirtualServiceModelRequest {
+	request := snapshotVirtualServiceFromModel(vs).VirtualServiceModelRequest
+	request.EdgeGatewayID = vs.EdgeGatewayRef.ID
+	request.PoolID = vs.PoolRef.ID
+	request.CertificateID = &newCertificateID
+
+	if vs.ServiceEngineGroupRef != nil {
+		request.ServiceEngineGroupID = &vs.ServiceEngineGroupRef.ID
+	}
+
+	return request
+}
+
+func certificateIDValidator(name, certificateID string) error {
+	if certificateID == "" {
+		return fmt.Errorf("%s is %w. Please provide a valid %s", name, errors.ErrEmpty, name)
+	}
+
+	if !urn.IsCertificateLibraryItem(certificateID) {
+		return fmt.Errorf("%s has %w. Please provide a valid %s", name, errors.ErrInvalidFormat, name)
+	}
+
+	return nil
+}
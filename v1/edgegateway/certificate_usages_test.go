@@ -0,0 +1,287 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/utils"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/edgeloadbalancer"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/org"
+)
+
+func TestClient_ReplaceCertificateUsages(t *testing.T) {
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	edgeGatewayID2 := urn.Gateway.String() + uuid.New().String()
+	oldCertificateID := urn.CertificateLibraryItem.String() + uuid.New().String()
+	newCertificateID := urn.CertificateLibraryItem.String() + uuid.New().String()
+	caCertificateID := urn.CertificateLibraryItem.String() + uuid.New().String()
+	poolID := urn.LoadBalancerPool.String() + uuid.New().String()
+	virtualServiceID := urn.LoadBalancerVirtualService.String() + uuid.New().String()
+	virtualServiceID2 := urn.LoadBalancerVirtualService.String() + uuid.New().String()
+
+	certificates := org.CertificatesModel{
+		{ID: oldCertificateID, Name: "old"},
+		{ID: newCertificateID, Name: "new"},
+	}
+
+	// mockUsages registers the mocks of the listing of the usages of the old certificate.
+	// The pool of the first edge gateway and the virtual service of the second one use the old certificate.
+	mockUsages := func(t *testing.T, clientCAV *MockclientInterface) {
+		t.Helper()
+
+		clientCAV.EXPECT().Refresh().Return(nil)
+		clientCAV.EXPECT().OpenAPIGetPage(gomock.Any(), gomock.Any()).Return(testEdgeGatewaysPage(t, 1, 1,
+			&govcdtypes.OpenAPIEdgeGateway{ID: edgeGatewayID, Name: testEdgeGatewayName},
+			&govcdtypes.OpenAPIEdgeGateway{ID: edgeGatewayID2, Name: testEdgeGatewayName2},
+		), nil)
+
+		clientCAV.EXPECT().ListPools(gomock.Any(), edgeGatewayID).Return([]*edgeloadbalancer.PoolModel{
+			{
+				ID:                     poolID,
+				Name:                   "pool",
+				GatewayRef:             govcdtypes.OpenApiReference{ID: edgeGatewayID},
+				Enabled:                utils.ToPTR(true),
+				CaCertificateRefs:      []govcdtypes.OpenApiReference{{ID: caCertificateID, Name: "ca"}, {ID: oldCertificateID, Name: "old"}},
+				CommonNameCheckEnabled: utils.ToPTR(true),
+			},
+		}, nil)
+		clientCAV.EXPECT().ListVirtualServices(gomock.Any(), edgeGatewayID).Return([]*edgeloadbalancer.VirtualServiceModel{
+			{ID: virtualServiceID, Name: "vs-other", CertificateRef: &govcdtypes.OpenApiReference{ID: caCertificateID}},
+		}, nil)
+
+		clientCAV.EXPECT().ListPools(gomock.Any(), edgeGatewayID2).Return(nil, nil)
+		clientCAV.EXPECT().ListVirtualServices(gomock.Any(), edgeGatewayID2).Return([]*edgeloadbalancer.VirtualServiceModel{
+			{
+				ID:                    virtualServiceID2,
+				Name:                  "vs",
+				EdgeGatewayRef:        govcdtypes.OpenApiReference{ID: edgeGatewayID2},
+				PoolRef:               govcdtypes.OpenApiReference{ID: poolID},
+				ServiceEngineGroupRef: &govcdtypes.OpenApiReference{ID: "seg"},
+				CertificateRef:        &govcdtypes.OpenApiReference{ID: oldCertificateID, Name: "old"},
+				IPv6VirtualIPAddress:  "2001:db8::1",
+			},
+		}, nil)
+	}
+
+	// expectedUsages returns the usages of the old certificate with the status of their update.
+	expectedUsages := func(poolStatus, vsStatus CertificateUsageStatus) []CertificateUsageModel {
+		return []CertificateUsageModel{
+			{Kind: CertificateUsageKindPool, EdgeGatewayID: edgeGatewayID, EdgeGatewayName: testEdgeGatewayName, ID: poolID, Name: "pool", Status: poolStatus},
+			{Kind: CertificateUsageKindVirtualService, EdgeGatewayID: edgeGatewayID2, EdgeGatewayName: testEdgeGatewayName2, ID: virtualServiceID2, Name: "vs", Status: vsStatus},
+		}
+	}
+
+	// mockApply registers the mocks of the update of the pool and of the virtual service.
+	mockApply := func(t *testing.T, clientCAV *MockclientInterface) {
+		t.Helper()
+
+		gomock.InOrder(
+			clientCAV.EXPECT().UpdatePool(gomock.Any(), poolID, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, pool edgeloadbalancer.PoolModelRequest) (*edgeloadbalancer.PoolModel, error) {
+				assert.Equal(t, edgeGatewayID, pool.GatewayRef.ID)
+				assert.Equal(t, []govcdtypes.OpenApiReference{{ID: caCertificateID, Name: "ca"}, {ID: newCertificateID, Name: "new"}}, pool.CaCertificateRefs)
+				return &edgeloadbalancer.PoolModel{ID: poolID}, nil
+			}),
+			clientCAV.EXPECT().UpdateVirtualService(gomock.Any(), virtualServiceID2, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, vs edgeloadbalancer.VirtualServiceModelRequest) (*edgeloadbalancer.VirtualServiceModel, error) {
+				assert.Equal(t, newCertificateID, *vs.CertificateID)
+				assert.Equal(t, edgeGatewayID2, vs.EdgeGatewayID)
+				assert.Equal(t, poolID, vs.PoolID)
+				assert.Equal(t, "seg", *vs.ServiceEngineGroupID)
+				assert.Equal(t, "2001:db8::1", vs.IPv6VirtualIPAddress)
+				return &edgeloadbalancer.VirtualServiceModel{ID: virtualServiceID2}, nil
+			}),
+		)
+	}
+
+	// mockNoUsages registers the mocks of a listing of the usages where nothing references the old certificate.
+	mockNoUsages := func(t *testing.T, clientCAV *MockclientInterface) {
+		t.Helper()
+
+		clientCAV.EXPECT().Refresh().Return(nil)
+		clientCAV.EXPECT().OpenAPIGetPage(gomock.Any(), gomock.Any()).Return(testEdgeGatewaysPage(t, 1, 1,
+			&govcdtypes.OpenAPIEdgeGateway{ID: edgeGatewayID, Name: testEdgeGatewayName},
+		), nil)
+		clientCAV.EXPECT().ListPools(gomock.Any(), edgeGatewayID).Return(nil, nil)
+		clientCAV.EXPECT().ListVirtualServices(gomock.Any(), edgeGatewayID).Return(nil, nil)
+	}
+
+	tests := []struct {
+		name             string
+		oldCertificateID string
+		newCertificateID string
+		opts             ReplaceCertificateUsagesOptions
+		mockFunc         func(t *testing.T, clientCAV *MockclientInterface)
+		expected         *CertificateUsagesPlanModel
+		expectedError    bool
+		err              error
+	}{
+		{
+			name:             testSuccess,
+			oldCertificateID: oldCertificateID,
+			newCertificateID: newCertificateID,
+			mockFunc: func(t *testing.T, clientCAV *MockclientInterface) {
+				clientCAV.EXPECT().ListCertificatesInLibrary(gomock.Any()).Return(certificates, nil)
+				mockUsages(t, clientCAV)
+				mockApply(t, clientCAV)
+			},
+			expected: &CertificateUsagesPlanModel{OldCertificateID: oldCertificateID, NewCertificateID: newCertificateID, Usages: expectedUsages(CertificateUsageStatusApplied, CertificateUsageStatusApplied)},
+		},
+		{
+			name:             "success-dry-run",
+			oldCertificateID: oldCertificateID,
+			newCertificateID: newCertificateID,
+			opts:             ReplaceCertificateUsagesOptions{DryRun: true, DeleteOldCertificate: true},
+			mockFunc: func(t *testing.T, clientCAV *MockclientInterface) {
+				clientCAV.EXPECT().ListCertificatesInLibrary(gomock.Any()).Return(certificates, nil)
+				mockUsages(t, clientCAV)
+			},
+			expected: &CertificateUsagesPlanModel{OldCertificateID: oldCertificateID, NewCertificateID: newCertificateID, Usages: expectedUsages(CertificateUsageStatusPending, CertificateUsageStatusPending)},
+		},
+		{
+			name:             "success-delete-old-certificate",
+			oldCertificateID: oldCertificateID,
+			newCertificateID: newCertificateID,
+			opts:             ReplaceCertificateUsagesOptions{DeleteOldCertificate: true},
+			mockFunc: func(t *testing.T, clientCAV *MockclientInterface) {
+				clientCAV.EXPECT().ListCertificatesInLibrary(gomock.Any()).Return(certificates, nil)
+				mockUsages(t, clientCAV)
+				mockApply(t, clientCAV)
+				mockNoUsages(t, clientCAV)
+				clientCAV.EXPECT().DeleteCertificateFromLibrary(gomock.Any(), oldCertificateID).Return(nil)
+			},
+			expected: &CertificateUsagesPlanModel{OldCertificateID: oldCertificateID, NewCertificateID: newCertificateID, Usages: expectedUsages(CertificateUsageStatusApplied, CertificateUsageStatusApplied), OldCertificateDeleted: true},
+		},
+		{
+			name:             "error-old-certificate-still-referenced",
+			oldCertificateID: oldCertificateID,
+			newCertificateID: newCertificateID,
+			opts:             ReplaceCertificateUsagesOptions{DeleteOldCertificate: true},
+			mockFunc: func(t *testing.T, clientCAV *MockclientInterface) {
+				clientCAV.EXPECT().ListCertificatesInLibrary(gomock.Any()).Return(certificates, nil)
+				mockNoUsages(t, clientCAV)
+				// A virtual service referencing the old certificate is created in the meantime
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().OpenAPIGetPage(gomock.Any(), gomock.Any()).Return(testEdgeGatewaysPage(t, 1, 1,
+					&govcdtypes.OpenAPIEdgeGateway{ID: edgeGatewayID, Name: testEdgeGatewayName},
+				), nil)
+				clientCAV.EXPECT().ListPools(gomock.Any(), edgeGatewayID).Return(nil, nil)
+				clientCAV.EXPECT().ListVirtualServices(gomock.Any(), edgeGatewayID).Return([]*edgeloadbalancer.VirtualServiceModel{
+					{ID: virtualServiceID, Name: "vs-new", CertificateRef: &govcdtypes.OpenApiReference{ID: oldCertificateID}},
+				}, nil)
+			},
+			expected:      &CertificateUsagesPlanModel{OldCertificateID: oldCertificateID, NewCertificateID: newCertificateID},
+			expectedError: true,
+			err:           errors.ErrConflict,
+		},
+		{
+			name:             "error-update-pool",
+			oldCertificateID: oldCertificateID,
+			newCertificateID: newCertificateID,
+			mockFunc: func(t *testing.T, clientCAV *MockclientInterface) {
+				clientCAV.EXPECT().ListCertificatesInLibrary(gomock.Any()).Return(certificates, nil)
+				mockUsages(t, clientCAV)
+				clientCAV.EXPECT().UpdatePool(gomock.Any(), poolID, gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			expected: func() *CertificateUsagesPlanModel {
+				usages := expectedUsages(CertificateUsageStatusFailed, CertificateUsageStatusPending)
+				usages[0].Error = "error"
+				return &CertificateUsagesPlanModel{OldCertificateID: oldCertificateID, NewCertificateID: newCertificateID, Usages: usages}
+			}(),
+			expectedError: true,
+		},
+		{
+			name:             "error-update-virtual-service",
+			oldCertificateID: oldCertificateID,
+			newCertificateID: newCertificateID,
+			mockFunc: func(t *testing.T, clientCAV *MockclientInterface) {
+				clientCAV.EXPECT().ListCertificatesInLibrary(gomock.Any()).Return(certificates, nil)
+				mockUsages(t, clientCAV)
+				clientCAV.EXPECT().UpdatePool(gomock.Any(), poolID, gomock.Any()).Return(&edgeloadbalancer.PoolModel{ID: poolID}, nil)
+				clientCAV.EXPECT().UpdateVirtualService(gomock.Any(), virtualServiceID2, gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			// The plan tells that the pool has already been updated
+			expected: func() *CertificateUsagesPlanModel {
+				usages := expectedUsages(CertificateUsageStatusApplied, CertificateUsageStatusFailed)
+				usages[1].Error = "error"
+				return &CertificateUsagesPlanModel{OldCertificateID: oldCertificateID, NewCertificateID: newCertificateID, Usages: usages}
+			}(),
+			expectedError: true,
+		},
+		{
+			name:             "error-new-certificate-not-found",
+			oldCertificateID: oldCertificateID,
+			newCertificateID: urn.CertificateLibraryItem.String() + uuid.New().String(),
+			mockFunc: func(_ *testing.T, clientCAV *MockclientInterface) {
+				clientCAV.EXPECT().ListCertificatesInLibrary(gomock.Any()).Return(certificates, nil)
+			},
+			expectedError: true,
+			err:           errors.ErrNotFound,
+		},
+		{
+			name:             "error-empty-old-certificate-id",
+			newCertificateID: newCertificateID,
+			expectedError:    true,
+			err:              errors.ErrEmpty,
+		},
+		{
+			name:             "error-invalid-new-certificate-id",
+			oldCertificateID: oldCertificateID,
+			newCertificateID: uuid.New().String(),
+			expectedError:    true,
+			err:              errors.ErrInvalidFormat,
+		},
+		{
+			name:             "error-same-certificate",
+			oldCertificateID: oldCertificateID,
+			newCertificateID: oldCertificateID,
+			expectedError:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Mock controller.
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock client for cloudavenue.
+			clientCAV := NewMockclientInterface(ctrl)
+
+			c, _ := NewFakeClient(clientCAV)
+
+			if test.mockFunc != nil {
+				test.mockFunc(t, clientCAV)
+			}
+
+			plan, err := c.ReplaceCertificateUsages(context.Background(), test.oldCertificateID, test.newCertificateID, test.opts)
+			if test.expectedError {
+				assert.Error(t, err)
+				if test.err != nil {
+					assert.ErrorIs(t, err, test.err)
+				}
+				assert.Equal(t, test.expected, plan)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, plan)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/edgeloadbalancer"
+)
+
+type (
+	// ReplaceCertificateUsagesOptions defines the behavior of a certificate replacement.
+	ReplaceCertificateUsagesOptions struct {
+		// DryRun computes the plan without applying it.
+		DryRun bool
+
+		// DeleteOldCertificate deletes the old certificate from the certificate library
+		// once it is no longer referenced by a virtual service or a pool.
+		DeleteOldCertificate bool
+	}
+
+	// CertificateUsagesPlanModel is the list of objects updated, or that would be updated,
+	// by a certificate replacement.
+	CertificateUsagesPlanModel struct {
+		OldCertificateID string
+		NewCertificateID string
+
+		// Usages is the list of objects referencing the old certificate, in the order they are updated.
+		Usages []CertificateUsageModel
+
+		// OldCertificateDeleted reports whether the old certificate has been deleted from the certificate library.
+		OldCertificateDeleted bool
+	}
+
+	// CertificateUsageModel represents an object of an edge gateway referencing a certificate.
+	CertificateUsageModel struct {
+		Kind CertificateUsageKind
+
+		EdgeGatewayID   string
+		EdgeGatewayName string
+
+		// ID and Name of the virtual service or of the pool.
+		ID   string
+		Name string
+
+		// Status reports whether the object has been updated with the new certificate.
+		Status CertificateUsageStatus
+
+		// Error is the reason of the failure of the update.
+		Error string
+	}
+
+	CertificateUsageKind   string
+	CertificateUsageStatus string
+
+	// certificateUsagesState holds the virtual services and the pools referencing a certificate.
+	certificateUsagesState struct {
+		usages          []CertificateUsageModel
+		pools           map[string]*edgeloadbalancer.PoolModel
+		virtualServices map[string]*edgeloadbalancer.VirtualServiceModel
+	}
+)
+
+const (
+	// CertificateUsageKindVirtualService is a virtual service serving the certificate.
+	CertificateUsageKindVirtualService CertificateUsageKind = "VIRTUAL_SERVICE"
+	// CertificateUsageKindPool is a pool using the certificate as CA certificate.
+	CertificateUsageKindPool CertificateUsageKind = "POOL"
+
+	// CertificateUsageStatusPending is an object not updated, because of DryRun or of a previous failure.
+	CertificateUsageStatusPending CertificateUsageStatus = "PENDING"
+	// CertificateUsageStatusApplied is an object updated with the new certificate.
+	CertificateUsageStatusApplied CertificateUsageStatus = "APPLIED"
+	// CertificateUsageStatusFailed is an object whose update failed, the next objects are not updated.
+	CertificateUsageStatusFailed CertificateUsageStatus = "FAILED"
+)
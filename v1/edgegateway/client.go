@@ -11,14 +11,19 @@ package edgegateway
 
 import (
 	"context"
+	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 
 	"github.com/go-resty/resty/v2"
 
 	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
 
 	clientcloudavenue "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/clients/cloudavenue"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/edgeloadbalancer"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/org"
 )
 
 //go:generate mockgen -source=client.go -destination=zz_generated_client_test.go -self_package github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/edgegateway -package edgegateway -copyright_file "../../mock_header.txt"
@@ -27,30 +32,127 @@ type (
 	// Exposed client interface.
 	Client interface {
 		ListEdgeGateway(ctx context.Context) ([]*EdgeGatewayModel, error)
+		ListEdgeGatewayWithOptions(ctx context.Context, opts ListEdgeGatewayOptions) ([]*EdgeGatewayModel, error)
+		IterEdgeGateways(ctx context.Context, opts ListEdgeGatewayOptions) iter.Seq2[*EdgeGatewayModel, error]
 		GetEdgeGateway(ctx context.Context, edgeGatewayNameOrID string) (*EdgeGateway, error)
 		CreateEdgeGateway(ctx context.Context, edgeGateway *EdgeGatewayModelRequest) (*EdgeGatewayModel, error)
 		UpdateEdgeGateway(ctx context.Context, edgeGateway *EdgeGatewayModelUpdate) error
 		DeleteEdgeGateway(ctx context.Context, edgeGatewayNameOrID string) error
+		WaitForStatus(ctx context.Context, edgeGatewayNameOrID, status string) (*EdgeGatewayModel, error)
+		BandwidthPlanner() *BandwidthPlanner
+
+		// * Static Routes
+		ListStaticRoutes(ctx context.Context, edgeGatewayNameOrID string) ([]*StaticRouteModel, error)
+		GetStaticRoute(ctx context.Context, edgeGatewayNameOrID, staticRouteNameOrID string) (*StaticRouteModel, error)
+		CreateStaticRoute(ctx context.Context, edgeGatewayNameOrID string, staticRoute StaticRouteModelRequest) (*StaticRouteModel, error)
+		UpdateStaticRoute(ctx context.Context, edgeGatewayNameOrID, staticRouteID string, staticRoute StaticRouteModelRequest) (*StaticRouteModel, error)
+		DeleteStaticRoute(ctx context.Context, edgeGatewayNameOrID, staticRouteID string) error
+
+		// * IPsec VPN Tunnels
+		ListIPSecVPNTunnels(ctx context.Context, edgeGatewayNameOrID string) ([]*IPSecVPNTunnelModel, error)
+		GetIPSecVPNTunnel(ctx context.Context, edgeGatewayNameOrID, tunnelNameOrID string) (*IPSecVPNTunnelModel, error)
+		CreateIPSecVPNTunnel(ctx context.Context, edgeGatewayNameOrID string, tunnel IPSecVPNTunnelModelRequest) (*IPSecVPNTunnelModel, error)
+		UpdateIPSecVPNTunnel(ctx context.Context, edgeGatewayNameOrID, tunnelID string, tunnel IPSecVPNTunnelModelRequest) (*IPSecVPNTunnelModel, error)
+		DeleteIPSecVPNTunnel(ctx context.Context, edgeGatewayNameOrID, tunnelID string) error
+		GetTunnelStatus(ctx context.Context, edgeGatewayNameOrID, tunnelNameOrID string) (*IPSecVPNTunnelStatusModel, error)
+
+		// * Firewall
+		GetFirewall(ctx context.Context, edgeGatewayNameOrID string) (*FirewallModel, error)
+		UpdateFirewall(ctx context.Context, edgeGatewayNameOrID string, rules []FirewallRuleModelRequest) (*FirewallModel, error)
+		DeleteFirewall(ctx context.Context, edgeGatewayNameOrID string) error
+		GetFirewallRule(ctx context.Context, edgeGatewayNameOrID, ruleNameOrID string) (*FirewallRuleModel, error)
+		AddFirewallRule(ctx context.Context, edgeGatewayNameOrID string, rule FirewallRuleModelRequest, position FirewallRulePosition) (*FirewallRuleModel, error)
+		UpdateFirewallRule(ctx context.Context, edgeGatewayNameOrID, ruleNameOrID string, rule FirewallRuleModelRequest) (*FirewallRuleModel, error)
+		DeleteFirewallRule(ctx context.Context, edgeGatewayNameOrID, ruleNameOrID string) error
+		MoveFirewallRule(ctx context.Context, edgeGatewayNameOrID, ruleNameOrID string, position FirewallRulePosition) error
+
+		// * Network service access
+		GrantServiceAccess(ctx context.Context, edgeGatewayNameOrID string, serviceNames, sourceNetworks []string) ([]*ServiceAccessModel, error)
+		RevokeServiceAccess(ctx context.Context, edgeGatewayNameOrID string, serviceNames []string) error
+
+		// * Snapshots
+		ExportSnapshot(ctx context.Context, edgeGatewayNameOrID string) (*SnapshotModel, error)
+		ImportSnapshot(ctx context.Context, edgeGatewayNameOrID string, snapshot *SnapshotModel, opts ImportSnapshotOptions) (*SnapshotDiffModel, error)
+
+		// * Applications
+		ExposeApplication(ctx context.Context, spec ExposeApplicationModelRequest) (*ExposedApplicationModel, error)
+		TeardownApplication(ctx context.Context, app *ExposedApplicationModel) error
+
+		// * Certificates
+		ReplaceCertificateUsages(ctx context.Context, oldCertificateID, newCertificateID string, opts ReplaceCertificateUsagesOptions) (*CertificateUsagesPlanModel, error)
 	}
 
 	// Internal client interfaces.
 	clientInterface interface {
+		clientGoVCD
 		clientGoVCDOrg
 		clientCloudavenue
+		clientOrg
+		clientLoadBalancer
 	}
 
 	client struct {
+		clientGoVCD
 		clientGoVCDOrg
 		clientCloudavenue
+		clientOrg
+		clientLoadBalancer
+	}
+
+	// clientGoVCD is the subset of the VMware client used to query the OpenAPI endpoints not, or not fully, covered by govcd.
+	clientGoVCD interface {
+		OpenAPIGetPage(endpoint string, queryParameters url.Values) (*govcdtypes.OpenApiPages, error)
+		OpenAPIGetItem(endpoint string, queryParameters url.Values, outType any) error
+		OpenAPIPutItem(endpoint string, payload, outType any) error
+
+		GetAllNetworkContextProfiles(queryParameters url.Values) ([]*govcdtypes.NsxtNetworkContextProfile, error)
 	}
 
 	clientGoVCDOrg interface {
-		GetAllNsxtEdgeGateways(queryParameters url.Values) ([]*govcd.NsxtEdgeGateway, error)
 		GetNsxtEdgeGatewayById(id string) (*govcd.NsxtEdgeGateway, error)
 		GetNsxtEdgeGatewayByName(name string) (*govcd.NsxtEdgeGateway, error)
 
 		GetVDCById(vdcID string, refresh bool) (*govcd.Vdc, error)
 		GetVdcGroupById(id string) (*govcd.VdcGroup, error)
+
+		GetAllOpenApiOrgVdcNetworks(queryParameters url.Values) ([]*govcd.OpenApiOrgVdcNetwork, error)
+
+		GetAllNsxtAppPortProfiles(queryParameters url.Values, scope string) ([]*govcd.NsxtAppPortProfile, error)
+		CreateNsxtAppPortProfile(appPortProfileConfig *govcdtypes.NsxtAppPortProfile) (*govcd.NsxtAppPortProfile, error)
+	}
+
+	// clientOrg is the subset of the org client used by the edge gateway client.
+	clientOrg interface {
+		ListCertificatesInLibrary(ctx context.Context) (org.CertificatesModel, error)
+		CreateCertificateInLibrary(ctx context.Context, cert *org.CertificateCreateRequest) (*org.CertificateModel, error)
+		DeleteCertificateFromLibrary(ctx context.Context, certificateID string) error
+	}
+
+	// clientLoadBalancer is the subset of the edge load balancer client used by the edge gateway snapshots
+	// and the exposed applications.
+	clientLoadBalancer interface {
+		ListServiceEngineGroups(ctx context.Context, edgeGatewayID string) ([]*edgeloadbalancer.ServiceEngineGroupModel, error)
+
+		ListPools(ctx context.Context, edgeGatewayID string) ([]*edgeloadbalancer.PoolModel, error)
+		GetPool(ctx context.Context, edgeGatewayID, poolNameOrID string) (*edgeloadbalancer.PoolModel, error)
+		CreatePool(ctx context.Context, pool edgeloadbalancer.PoolModelRequest) (*edgeloadbalancer.PoolModel, error)
+		UpdatePool(ctx context.Context, poolID string, pool edgeloadbalancer.PoolModelRequest) (*edgeloadbalancer.PoolModel, error)
+		DeletePool(ctx context.Context, poolID string) error
+
+		ListVirtualServices(ctx context.Context, edgeGatewayID string) ([]*edgeloadbalancer.VirtualServiceModel, error)
+		CreateVirtualService(ctx context.Context, vsr edgeloadbalancer.VirtualServiceModelRequest) (*edgeloadbalancer.VirtualServiceModel, error)
+		UpdateVirtualService(ctx context.Context, virtualServiceID string, vsr edgeloadbalancer.VirtualServiceModelRequest) (*edgeloadbalancer.VirtualServiceModel, error)
+		DeleteVirtualService(ctx context.Context, virtualServiceID string) error
+
+		GetPoliciesHTTPRequest(ctx context.Context, virtualServiceID string) (*edgeloadbalancer.PoliciesHTTPRequestModel, error)
+		UpdatePoliciesHTTPRequest(ctx context.Context, policies *edgeloadbalancer.PoliciesHTTPRequestModel, opts ...edgeloadbalancer.PoliciesHTTPUpdateOptions) (*edgeloadbalancer.PoliciesHTTPRequestModel, error)
+		DeletePoliciesHTTPRequest(ctx context.Context, virtualServiceID string) error
+		GetPoliciesHTTPResponse(ctx context.Context, virtualServiceID string) (*edgeloadbalancer.PoliciesHTTPResponseModel, error)
+		UpdatePoliciesHTTPResponse(ctx context.Context, policies *edgeloadbalancer.PoliciesHTTPResponseModel, opts ...edgeloadbalancer.PoliciesHTTPUpdateOptions) (*edgeloadbalancer.PoliciesHTTPResponseModel, error)
+		DeletePoliciesHTTPResponse(ctx context.Context, virtualServiceID string) error
+		GetPoliciesHTTPSecurity(ctx context.Context, virtualServiceID string) (*edgeloadbalancer.PoliciesHTTPSecurityModel, error)
+		UpdatePoliciesHTTPSecurity(ctx context.Context, policies *edgeloadbalancer.PoliciesHTTPSecurityModel, opts ...edgeloadbalancer.PoliciesHTTPUpdateOptions) (*edgeloadbalancer.PoliciesHTTPSecurityModel, error)
+		DeletePoliciesHTTPSecurity(ctx context.Context, virtualServiceID string) error
 	}
 
 	clientCloudavenue interface {
@@ -67,17 +169,33 @@ func NewClient() (Client, error) {
 		return nil, err
 	}
 
+	orgClient, err := org.NewClient()
+	if err != nil {
+		return nil, err
+	}
+
+	loadBalancerClient, err := edgeloadbalancer.NewClient()
+	if err != nil {
+		return nil, err
+	}
+
 	return &client{
-		clientCloudavenue: c,
-		clientGoVCDOrg:    c.Org,
+		clientCloudavenue:  c,
+		clientGoVCD:        &vcdClient{client: &c.Vmware.Client},
+		clientGoVCDOrg:     c.Org,
+		clientOrg:          orgClient,
+		clientLoadBalancer: loadBalancerClient,
 	}, nil
 }
 
 // NewFakeClient creates a new fake Org client used for testing.
 func NewFakeClient(i clientInterface) (Client, error) {
 	return &client{
-		clientCloudavenue: i,
-		clientGoVCDOrg:    i,
+		clientCloudavenue:  i,
+		clientGoVCD:        i,
+		clientGoVCDOrg:     i,
+		clientOrg:          i,
+		clientLoadBalancer: i,
 	}, nil
 }
 
@@ -86,3 +204,63 @@ func newFakeEdgeGatewayClient(i clientInterface) *EdgeGateway {
 		clientInterface: i,
 	}
 }
+
+// elevatedAPIVersions mirrors the elevated API versions used by govcd for the endpoints queried through vcdClient,
+// from the highest to the lowest.
+var elevatedAPIVersions = map[string][]string{
+	govcdtypes.OpenApiPathVersion1_0_0 + govcdtypes.OpenApiEndpointEdgeGateways: {"39.0", "37.1"},
+}
+
+// vcdClient implements clientGoVCD with the VMware client.
+type vcdClient struct {
+	client *govcd.Client
+}
+
+// OpenAPIGetItem retrieves a single item of an OpenAPI endpoint.
+func (v *vcdClient) OpenAPIGetItem(endpoint string, queryParameters url.Values, outType any) error {
+	urlRef, err := v.client.OpenApiBuildEndpoint(endpoint)
+	if err != nil {
+		return fmt.Errorf("error building endpoint %s: %w", endpoint, err)
+	}
+
+	return v.client.OpenApiGetItem(v.apiVersion(endpoint), urlRef, queryParameters, outType, nil)
+}
+
+// OpenAPIGetPage retrieves a single page of an OpenAPI endpoint.
+// Unlike the govcd GetAll functions, it does not follow the next pages.
+func (v *vcdClient) OpenAPIGetPage(endpoint string, queryParameters url.Values) (*govcdtypes.OpenApiPages, error) {
+	page := &govcdtypes.OpenApiPages{}
+	if err := v.OpenAPIGetItem(endpoint, queryParameters, page); err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// OpenAPIPutItem updates an item of an OpenAPI endpoint and retrieves it once the update is done.
+func (v *vcdClient) OpenAPIPutItem(endpoint string, payload, outType any) error {
+	urlRef, err := v.client.OpenApiBuildEndpoint(endpoint)
+	if err != nil {
+		return fmt.Errorf("error building endpoint %s: %w", endpoint, err)
+	}
+
+	return v.client.OpenApiPutItem(v.apiVersion(endpoint), urlRef, nil, payload, outType, nil)
+}
+
+// GetAllNetworkContextProfiles retrieves the network context profiles matching the query parameters.
+func (v *vcdClient) GetAllNetworkContextProfiles(queryParameters url.Values) ([]*govcdtypes.NsxtNetworkContextProfile, error) {
+	return govcd.GetAllNetworkContextProfiles(v.client, queryParameters)
+}
+
+// apiVersion returns the API version used to query the endpoint.
+// Like govcd, the highest elevated version supported by both VCD and the client is used,
+// and the client API version otherwise.
+func (v *vcdClient) apiVersion(endpoint string) string {
+	for _, version := range elevatedAPIVersions[endpoint] {
+		if v.client.APIVCDMaxVersionIs(">= "+version) && !v.client.APIClientVersionIs("> "+version) {
+			return version
+		}
+	}
+
+	return v.client.APIVersion
+}
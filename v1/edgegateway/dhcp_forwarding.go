@@ -0,0 +1,104 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/orange-cloudavenue/common-go/validators"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+var (
+	getDHCPForwarding = func(edgeClient fakeDHCPForwardingEdgeGatewayClient) (*govcdtypes.NsxtEdgeGatewayDhcpForwarder, error) {
+		return edgeClient.GetDhcpForwarder()
+	}
+
+	updateDHCPForwarding = func(edgeClient fakeDHCPForwardingEdgeGatewayClient, dhcpForwarder *govcdtypes.NsxtEdgeGatewayDhcpForwarder) (*govcdtypes.NsxtEdgeGatewayDhcpForwarder, error) {
+		return edgeClient.UpdateDhcpForwarder(dhcpForwarder)
+	}
+)
+
+// GetDHCPForwarding retrieves the DHCP forwarding configuration of the edge gateway.
+func (e *EdgeGateway) GetDHCPForwarding(ctx context.Context) (*DHCPForwardingModel, error) {
+	edgeGateway, err := e.getVCDEdgeGateway(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dhcpForwarder, err := getDHCPForwarding(edgeGateway)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving DHCP forwarding of edge gateway %s: %w", e.Name, err)
+	}
+
+	dhcpm := &DHCPForwardingModel{}
+	dhcpm.fromVCD(dhcpForwarder)
+
+	return dhcpm, nil
+}
+
+// UpdateDHCPForwarding updates the DHCP forwarding configuration of the edge gateway.
+// Note: the DHCP forwarding cannot be enabled if a network of the edge gateway uses the DHCP service in EDGE mode.
+func (e *EdgeGateway) UpdateDHCPForwarding(ctx context.Context, dhcpForwarding DHCPForwardingModelRequest) (*DHCPForwardingModel, error) {
+	if err := validators.New().StructCtx(ctx, &dhcpForwarding); err != nil {
+		return nil, err
+	}
+
+	edgeGateway, err := e.getVCDEdgeGateway(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dhcpForwarder, err := getDHCPForwarding(edgeGateway)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving DHCP forwarding of edge gateway %s: %w", e.Name, err)
+	}
+
+	dhcpForwarderUpdated, err := updateDHCPForwarding(edgeGateway, &govcdtypes.NsxtEdgeGatewayDhcpForwarder{
+		Enabled:     *dhcpForwarding.Enabled,
+		DhcpServers: dhcpForwarding.DHCPServers,
+		// Version is required to prevent overwriting a concurrent change
+		Version: dhcpForwarder.Version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error updating DHCP forwarding of edge gateway %s: %w", e.Name, err)
+	}
+
+	dhcpm := &DHCPForwardingModel{}
+	dhcpm.fromVCD(dhcpForwarderUpdated)
+
+	return dhcpm, nil
+}
+
+// DeleteDHCPForwarding removes the DHCP forwarding configuration of the edge gateway.
+// The DHCP forwarding is disabled and its DHCP servers are removed.
+func (e *EdgeGateway) DeleteDHCPForwarding(ctx context.Context) error {
+	edgeGateway, err := e.getVCDEdgeGateway(ctx)
+	if err != nil {
+		return err
+	}
+
+	dhcpForwarder, err := getDHCPForwarding(edgeGateway)
+	if err != nil {
+		return fmt.Errorf("error retrieving DHCP forwarding of edge gateway %s: %w", e.Name, err)
+	}
+
+	if _, err := updateDHCPForwarding(edgeGateway, &govcdtypes.NsxtEdgeGatewayDhcpForwarder{
+		Enabled:     false,
+		DhcpServers: []string{},
+		Version:     dhcpForwarder.Version,
+	}); err != nil {
+		return fmt.Errorf("error deleting DHCP forwarding of edge gateway %s: %w", e.Name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,230 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/utils"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+)
+
+func TestEdgeGateway_GetDHCPForwarding(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	e := newFakeEdgeGatewayClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+
+	tests := []struct {
+		name          string
+		mockFunc      func()
+		expected      *DHCPForwardingModel
+		expectedError bool
+		err           error
+	}{
+		{
+			name: testSuccess,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getDHCPForwarding = func(_ fakeDHCPForwardingEdgeGatewayClient) (*govcdtypes.NsxtEdgeGatewayDhcpForwarder, error) {
+					return &govcdtypes.NsxtEdgeGatewayDhcpForwarder{
+						Enabled:     true,
+						DhcpServers: []string{"192.168.10.5"},
+					}, nil
+				}
+			},
+			expected: &DHCPForwardingModel{
+				Enabled:     true,
+				DHCPServers: []string{"192.168.10.5"},
+			},
+		},
+		{
+			name: "error-get-dhcp-forwarding",
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getDHCPForwarding = func(_ fakeDHCPForwardingEdgeGatewayClient) (*govcdtypes.NsxtEdgeGatewayDhcpForwarder, error) {
+					return nil, fmt.Errorf("error")
+				}
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error retrieving DHCP forwarding"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mockFunc()
+
+			e.EdgeGatewayModel = &EdgeGatewayModel{
+				ID:   edgeGatewayID,
+				Name: testEdgeGatewayName,
+			}
+
+			dhcp, err := e.GetDHCPForwarding(context.Background())
+			if !test.expectedError {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, dhcp)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err.Error())
+		})
+	}
+}
+
+func TestEdgeGateway_UpdateDHCPForwarding(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	e := newFakeEdgeGatewayClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+
+	tests := []struct {
+		name          string
+		request       DHCPForwardingModelRequest
+		mockFunc      func()
+		expectedError bool
+		err           error
+	}{
+		{
+			name: testSuccess,
+			request: DHCPForwardingModelRequest{
+				Enabled:     utils.ToPTR(true),
+				DHCPServers: []string{"192.168.10.5", "192.168.10.6"},
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getDHCPForwarding = func(_ fakeDHCPForwardingEdgeGatewayClient) (*govcdtypes.NsxtEdgeGatewayDhcpForwarder, error) {
+					return &govcdtypes.NsxtEdgeGatewayDhcpForwarder{Version: govcdtypes.VersionField{Version: 5}}, nil
+				}
+				updateDHCPForwarding = func(_ fakeDHCPForwardingEdgeGatewayClient, dhcpForwarder *govcdtypes.NsxtEdgeGatewayDhcpForwarder) (*govcdtypes.NsxtEdgeGatewayDhcpForwarder, error) {
+					if dhcpForwarder.Version.Version != 5 {
+						return nil, fmt.Errorf("version is not set")
+					}
+					return dhcpForwarder, nil
+				}
+			},
+		},
+		{
+			name: "error-validation-missing-servers",
+			request: DHCPForwardingModelRequest{
+				Enabled: utils.ToPTR(true),
+			},
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           fmt.Errorf("DHCPServers"),
+		},
+		{
+			name: "error-validation-invalid-server",
+			request: DHCPForwardingModelRequest{
+				Enabled:     utils.ToPTR(true),
+				DHCPServers: []string{"not-an-ip"},
+			},
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           fmt.Errorf("DHCPServers"),
+		},
+		{
+			name: "error-update-dhcp-forwarding",
+			request: DHCPForwardingModelRequest{
+				Enabled: utils.ToPTR(false),
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getDHCPForwarding = func(_ fakeDHCPForwardingEdgeGatewayClient) (*govcdtypes.NsxtEdgeGatewayDhcpForwarder, error) {
+					return &govcdtypes.NsxtEdgeGatewayDhcpForwarder{}, nil
+				}
+				updateDHCPForwarding = func(_ fakeDHCPForwardingEdgeGatewayClient, _ *govcdtypes.NsxtEdgeGatewayDhcpForwarder) (*govcdtypes.NsxtEdgeGatewayDhcpForwarder, error) {
+					return nil, fmt.Errorf("error")
+				}
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error updating DHCP forwarding"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mockFunc()
+
+			e.EdgeGatewayModel = &EdgeGatewayModel{
+				ID:   edgeGatewayID,
+				Name: testEdgeGatewayName,
+			}
+
+			dhcp, err := e.UpdateDHCPForwarding(context.Background(), test.request)
+			if !test.expectedError {
+				assert.NoError(t, err)
+				assert.Equal(t, test.request.DHCPServers, dhcp.DHCPServers)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err.Error())
+		})
+	}
+}
+
+func TestEdgeGateway_DeleteDHCPForwarding(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	e := newFakeEdgeGatewayClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+
+	clientCAV.EXPECT().Refresh().Return(nil)
+	clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+	getDHCPForwarding = func(_ fakeDHCPForwardingEdgeGatewayClient) (*govcdtypes.NsxtEdgeGatewayDhcpForwarder, error) {
+		return &govcdtypes.NsxtEdgeGatewayDhcpForwarder{Enabled: true, DhcpServers: []string{"192.168.10.5"}}, nil
+	}
+	updateDHCPForwarding = func(_ fakeDHCPForwardingEdgeGatewayClient, dhcpForwarder *govcdtypes.NsxtEdgeGatewayDhcpForwarder) (*govcdtypes.NsxtEdgeGatewayDhcpForwarder, error) {
+		if dhcpForwarder.Enabled || len(dhcpForwarder.DhcpServers) != 0 {
+			return nil, fmt.Errorf("DHCP forwarding is not reset")
+		}
+		return dhcpForwarder, nil
+	}
+
+	e.EdgeGatewayModel = &EdgeGatewayModel{
+		ID:   edgeGatewayID,
+		Name: testEdgeGatewayName,
+	}
+
+	assert.NoError(t, e.DeleteDHCPForwarding(context.Background()))
+}
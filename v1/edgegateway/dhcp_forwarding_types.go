@@ -0,0 +1,50 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+type (
+	fakeDHCPForwardingEdgeGatewayClient interface {
+		GetDhcpForwarder() (*govcdtypes.NsxtEdgeGatewayDhcpForwarder, error)
+		UpdateDhcpForwarder(dhcpForwarderConfig *govcdtypes.NsxtEdgeGatewayDhcpForwarder) (*govcdtypes.NsxtEdgeGatewayDhcpForwarder, error)
+	}
+
+	// DHCPForwardingModel represents the DHCP forwarding (relay) configuration of an edge gateway.
+	DHCPForwardingModel struct {
+		// Enabled reports whether the DHCP forwarding is enabled.
+		Enabled bool
+
+		// DHCPServers is the list of DHCP servers to which the requests are relayed.
+		DHCPServers []string
+	}
+
+	// DHCPForwardingModelRequest represents the request model for updating the DHCP forwarding.
+	DHCPForwardingModelRequest struct {
+		// Enabled enables the DHCP forwarding.
+		Enabled *bool `validate:"required"`
+
+		// DHCPServers is the list of DHCP servers to which the requests are relayed.
+		// Required when the DHCP forwarding is enabled.
+		DHCPServers []string `validate:"required_if=Enabled true,omitempty,max=8,dive,ip"`
+	}
+)
+
+// fromVCD converts a VCD DHCP forwarder configuration to the internal DHCPForwardingModel.
+func (m *DHCPForwardingModel) fromVCD(vcdDHCPForwarder *govcdtypes.NsxtEdgeGatewayDhcpForwarder) {
+	if vcdDHCPForwarder == nil {
+		return
+	}
+
+	m.Enabled = vcdDHCPForwarder.Enabled
+	m.DHCPServers = vcdDHCPForwarder.DhcpServers
+}
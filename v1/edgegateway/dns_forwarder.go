@@ -0,0 +1,132 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/orange-cloudavenue/common-go/validators"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+var (
+	getDNSForwarder = func(edgeClient fakeDNSForwarderEdgeGatewayClient) (*govcd.NsxtEdgeGatewayDns, error) {
+		return edgeClient.GetDnsConfig()
+	}
+
+	updateDNSForwarder = func(dnsClient fakeDNSForwarderClient, dnsConfig *govcdtypes.NsxtEdgeGatewayDns) (*govcd.NsxtEdgeGatewayDns, error) {
+		return dnsClient.Update(dnsConfig)
+	}
+
+	deleteDNSForwarder = func(dnsClient fakeDNSForwarderClient) error {
+		return dnsClient.Delete()
+	}
+)
+
+// GetDNSForwarder retrieves the DNS forwarder configuration of the edge gateway.
+func (e *EdgeGateway) GetDNSForwarder(ctx context.Context) (*DNSForwarderModel, error) {
+	edgeGateway, err := e.getVCDEdgeGateway(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dns, err := getDNSForwarder(edgeGateway)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving DNS forwarder of edge gateway %s: %w", e.Name, err)
+	}
+
+	dnsm := &DNSForwarderModel{}
+	dnsm.fromVCD(dns.NsxtEdgeGatewayDns)
+
+	return dnsm, nil
+}
+
+// UpdateDNSForwarder updates the DNS forwarder configuration of the edge gateway.
+// Existing forwarder zones are matched by name to keep their IDs.
+func (e *EdgeGateway) UpdateDNSForwarder(ctx context.Context, dnsForwarder DNSForwarderModelRequest) (*DNSForwarderModel, error) {
+	if err := validators.New().StructCtx(ctx, &dnsForwarder); err != nil {
+		return nil, err
+	}
+
+	edgeGateway, err := e.getVCDEdgeGateway(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dns, err := getDNSForwarder(edgeGateway)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving DNS forwarder of edge gateway %s: %w", e.Name, err)
+	}
+
+	// Index the existing zones by name to reuse their IDs
+	existingZoneIDs := make(map[string]string)
+	if dns.NsxtEdgeGatewayDns.DefaultForwarderZone != nil {
+		existingZoneIDs[dns.NsxtEdgeGatewayDns.DefaultForwarderZone.DisplayName] = dns.NsxtEdgeGatewayDns.DefaultForwarderZone.ID
+	}
+	for _, zone := range dns.NsxtEdgeGatewayDns.ConditionalForwarderZones {
+		existingZoneIDs[zone.DisplayName] = zone.ID
+	}
+
+	dnsConfig := &govcdtypes.NsxtEdgeGatewayDns{
+		Enabled:         *dnsForwarder.Enabled,
+		ListenerIp:      dnsForwarder.ListenerIP,
+		SnatRuleEnabled: dnsForwarder.SNATRuleEnabled,
+		DefaultForwarderZone: &govcdtypes.NsxtDnsForwarderZoneConfig{
+			ID:              existingZoneIDs[dnsForwarder.DefaultForwarderZone.Name],
+			DisplayName:     dnsForwarder.DefaultForwarderZone.Name,
+			UpstreamServers: dnsForwarder.DefaultForwarderZone.UpstreamServers,
+		},
+		ConditionalForwarderZones: make([]*govcdtypes.NsxtDnsForwarderZoneConfig, 0, len(dnsForwarder.ConditionalForwarderZones)),
+		// Version is required to prevent overwriting a concurrent change
+		Version: dns.NsxtEdgeGatewayDns.Version,
+	}
+
+	for _, zone := range dnsForwarder.ConditionalForwarderZones {
+		dnsConfig.ConditionalForwarderZones = append(dnsConfig.ConditionalForwarderZones, &govcdtypes.NsxtDnsForwarderZoneConfig{
+			ID:              existingZoneIDs[zone.Name],
+			DisplayName:     zone.Name,
+			UpstreamServers: zone.UpstreamServers,
+			DnsDomainNames:  zone.DomainNames,
+		})
+	}
+
+	dnsUpdated, err := updateDNSForwarder(dns, dnsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error updating DNS forwarder of edge gateway %s: %w", e.Name, err)
+	}
+
+	dnsm := &DNSForwarderModel{}
+	dnsm.fromVCD(dnsUpdated.NsxtEdgeGatewayDns)
+
+	return dnsm, nil
+}
+
+// DeleteDNSForwarder removes the DNS forwarder configuration of the edge gateway.
+// The DNS forwarder is disabled and all its forwarder zones are removed.
+func (e *EdgeGateway) DeleteDNSForwarder(ctx context.Context) error {
+	edgeGateway, err := e.getVCDEdgeGateway(ctx)
+	if err != nil {
+		return err
+	}
+
+	dns, err := getDNSForwarder(edgeGateway)
+	if err != nil {
+		return fmt.Errorf("error retrieving DNS forwarder of edge gateway %s: %w", e.Name, err)
+	}
+
+	if err := deleteDNSForwarder(dns); err != nil {
+		return fmt.Errorf("error deleting DNS forwarder of edge gateway %s: %w", e.Name, err)
+	}
+
+	return nil
+}
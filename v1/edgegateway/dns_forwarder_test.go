@@ -0,0 +1,343 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/utils"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+)
+
+const (
+	testDNSDefaultZoneName     = "default-zone"
+	testDNSConditionalZoneName = "corp-zone"
+	testDNSListenerIP          = "100.64.0.1"
+)
+
+func testVCDDNSForwarder(defaultZoneID string) *govcd.NsxtEdgeGatewayDns {
+	return &govcd.NsxtEdgeGatewayDns{
+		NsxtEdgeGatewayDns: &govcdtypes.NsxtEdgeGatewayDns{
+			Enabled:    true,
+			ListenerIp: testDNSListenerIP,
+			DefaultForwarderZone: &govcdtypes.NsxtDnsForwarderZoneConfig{
+				ID:              defaultZoneID,
+				DisplayName:     testDNSDefaultZoneName,
+				UpstreamServers: []string{"1.1.1.1"},
+			},
+			Version: &govcdtypes.VersionField{Version: 3},
+		},
+	}
+}
+
+func TestEdgeGateway_GetDNSForwarder(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	e := newFakeEdgeGatewayClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+	zoneID := uuid.New().String()
+
+	tests := []struct {
+		name          string
+		mockFunc      func()
+		expected      *DNSForwarderModel
+		expectedError bool
+		err           error
+	}{
+		{
+			name: testSuccess,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getDNSForwarder = func(_ fakeDNSForwarderEdgeGatewayClient) (*govcd.NsxtEdgeGatewayDns, error) {
+					dns := testVCDDNSForwarder(zoneID)
+					dns.NsxtEdgeGatewayDns.ConditionalForwarderZones = []*govcdtypes.NsxtDnsForwarderZoneConfig{
+						{
+							DisplayName:     testDNSConditionalZoneName,
+							UpstreamServers: []string{"10.0.0.53"},
+							DnsDomainNames:  []string{"corp.example.com"},
+						},
+					}
+					return dns, nil
+				}
+			},
+			expected: &DNSForwarderModel{
+				Enabled:    true,
+				ListenerIP: testDNSListenerIP,
+				DefaultForwarderZone: &DNSForwarderModelZone{
+					ID:              zoneID,
+					Name:            testDNSDefaultZoneName,
+					UpstreamServers: []string{"1.1.1.1"},
+				},
+				ConditionalForwarderZones: []*DNSForwarderModelZone{
+					{
+						Name:            testDNSConditionalZoneName,
+						UpstreamServers: []string{"10.0.0.53"},
+						DomainNames:     []string{"corp.example.com"},
+					},
+				},
+			},
+		},
+		{
+			name: "refresh-error",
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(fmt.Errorf("error"))
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error"),
+		},
+		{
+			name: "error-get-edge-gateway",
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(nil, fmt.Errorf("error"))
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error retrieving edge gateway"),
+		},
+		{
+			name: "error-get-dns-forwarder",
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getDNSForwarder = func(_ fakeDNSForwarderEdgeGatewayClient) (*govcd.NsxtEdgeGatewayDns, error) {
+					return nil, fmt.Errorf("error")
+				}
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error retrieving DNS forwarder"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mockFunc()
+
+			e.EdgeGatewayModel = &EdgeGatewayModel{
+				ID:   edgeGatewayID,
+				Name: testEdgeGatewayName,
+			}
+
+			dns, err := e.GetDNSForwarder(context.Background())
+			if !test.expectedError {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, dns)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err.Error())
+		})
+	}
+}
+
+func TestEdgeGateway_UpdateDNSForwarder(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	e := newFakeEdgeGatewayClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+	zoneID := uuid.New().String()
+
+	validRequest := func() DNSForwarderModelRequest {
+		return DNSForwarderModelRequest{
+			Enabled: utils.ToPTR(true),
+			DefaultForwarderZone: DNSForwarderModelDefaultZoneRequest{
+				Name:            testDNSDefaultZoneName,
+				UpstreamServers: []string{"1.1.1.1", "8.8.8.8"},
+			},
+			ConditionalForwarderZones: []DNSForwarderModelConditionalZoneRequest{
+				{
+					Name:            testDNSConditionalZoneName,
+					UpstreamServers: []string{"10.0.0.53"},
+					DomainNames:     []string{"corp.example.com"},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		request       func() DNSForwarderModelRequest
+		mockFunc      func()
+		expectedError bool
+		err           error
+	}{
+		{
+			name:    testSuccess,
+			request: validRequest,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getDNSForwarder = func(_ fakeDNSForwarderEdgeGatewayClient) (*govcd.NsxtEdgeGatewayDns, error) {
+					return testVCDDNSForwarder(zoneID), nil
+				}
+				updateDNSForwarder = func(_ fakeDNSForwarderClient, dnsConfig *govcdtypes.NsxtEdgeGatewayDns) (*govcd.NsxtEdgeGatewayDns, error) {
+					if dnsConfig.Version == nil || dnsConfig.Version.Version != 3 {
+						return nil, fmt.Errorf("version is not set")
+					}
+					if dnsConfig.DefaultForwarderZone.ID != zoneID {
+						return nil, fmt.Errorf("default zone ID is not kept")
+					}
+					return &govcd.NsxtEdgeGatewayDns{NsxtEdgeGatewayDns: dnsConfig}, nil
+				}
+			},
+		},
+		{
+			name: "error-validation-too-many-upstream-servers",
+			request: func() DNSForwarderModelRequest {
+				r := validRequest()
+				r.DefaultForwarderZone.UpstreamServers = []string{"1.1.1.1", "1.0.0.1", "8.8.8.8", "8.8.4.4"}
+				return r
+			},
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           fmt.Errorf("UpstreamServers"),
+		},
+		{
+			name: "error-validation-invalid-domain",
+			request: func() DNSForwarderModelRequest {
+				r := validRequest()
+				r.ConditionalForwarderZones[0].DomainNames = []string{"not a domain"}
+				return r
+			},
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           fmt.Errorf("DomainNames"),
+		},
+		{
+			name:    "error-update-dns-forwarder",
+			request: validRequest,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getDNSForwarder = func(_ fakeDNSForwarderEdgeGatewayClient) (*govcd.NsxtEdgeGatewayDns, error) {
+					return testVCDDNSForwarder(zoneID), nil
+				}
+				updateDNSForwarder = func(_ fakeDNSForwarderClient, _ *govcdtypes.NsxtEdgeGatewayDns) (*govcd.NsxtEdgeGatewayDns, error) {
+					return nil, fmt.Errorf("error")
+				}
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error updating DNS forwarder"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mockFunc()
+
+			e.EdgeGatewayModel = &EdgeGatewayModel{
+				ID:   edgeGatewayID,
+				Name: testEdgeGatewayName,
+			}
+
+			dns, err := e.UpdateDNSForwarder(context.Background(), test.request())
+			if !test.expectedError {
+				assert.NoError(t, err)
+				assert.Equal(t, zoneID, dns.DefaultForwarderZone.ID)
+				assert.Len(t, dns.ConditionalForwarderZones, 1)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err.Error())
+		})
+	}
+}
+
+func TestEdgeGateway_DeleteDNSForwarder(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	e := newFakeEdgeGatewayClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+
+	tests := []struct {
+		name          string
+		mockFunc      func()
+		expectedError bool
+		err           error
+	}{
+		{
+			name: testSuccess,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getDNSForwarder = func(_ fakeDNSForwarderEdgeGatewayClient) (*govcd.NsxtEdgeGatewayDns, error) {
+					return testVCDDNSForwarder(uuid.New().String()), nil
+				}
+				deleteDNSForwarder = func(_ fakeDNSForwarderClient) error {
+					return nil
+				}
+			},
+		},
+		{
+			name: "error-delete-dns-forwarder",
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getDNSForwarder = func(_ fakeDNSForwarderEdgeGatewayClient) (*govcd.NsxtEdgeGatewayDns, error) {
+					return testVCDDNSForwarder(uuid.New().String()), nil
+				}
+				deleteDNSForwarder = func(_ fakeDNSForwarderClient) error {
+					return fmt.Errorf("error")
+				}
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error deleting DNS forwarder"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mockFunc()
+
+			e.EdgeGatewayModel = &EdgeGatewayModel{
+				ID:   edgeGatewayID,
+				Name: testEdgeGatewayName,
+			}
+
+			err := e.DeleteDNSForwarder(context.Background())
+			if !test.expectedError {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err.Error())
+		})
+	}
+}
@@ -0,0 +1,135 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+type (
+	fakeDNSForwarderEdgeGatewayClient interface {
+		GetDnsConfig() (*govcd.NsxtEdgeGatewayDns, error)
+	}
+
+	fakeDNSForwarderClient interface {
+		Update(updatedConfig *govcdtypes.NsxtEdgeGatewayDns) (*govcd.NsxtEdgeGatewayDns, error)
+		Delete() error
+	}
+
+	// DNSForwarderModel represents the DNS forwarder configuration of an edge gateway.
+	DNSForwarderModel struct {
+		// Enabled reports whether the DNS forwarder is enabled.
+		Enabled bool
+
+		// ListenerIP is the IP address on which the DNS forwarder listens.
+		ListenerIP string
+
+		// SNATRuleEnabled reports whether a SNAT rule exists for the DNS forwarder.
+		SNATRuleEnabled bool
+
+		// DefaultForwarderZone is the zone used when no conditional forwarder zone matches.
+		DefaultForwarderZone *DNSForwarderModelZone
+
+		// ConditionalForwarderZones is the list of zones with their matching DNS domains.
+		ConditionalForwarderZones []*DNSForwarderModelZone
+	}
+
+	// DNSForwarderModelZone represents a forwarder zone of the DNS forwarder.
+	DNSForwarderModelZone struct {
+		ID string
+
+		// Name of the forwarder zone
+		Name string
+
+		// UpstreamServers is the list of DNS servers to which the queries are forwarded.
+		UpstreamServers []string
+
+		// DomainNames is the list of domains matched by the zone.
+		// Always empty for the default forwarder zone.
+		DomainNames []string
+	}
+
+	// DNSForwarderModelRequest represents the request model for updating the DNS forwarder.
+	DNSForwarderModelRequest struct {
+		// Enabled enables the DNS forwarder.
+		Enabled *bool `validate:"required"`
+
+		// ListenerIP is the IP address on which the DNS forwarder listens.
+		// It can only be changed when the edge gateway has a dedicated external network.
+		ListenerIP string `validate:"omitempty,ipv4"`
+
+		// SNATRuleEnabled creates a SNAT rule for the DNS forwarder.
+		// It is required in NAT routed environments to reach the upstream servers.
+		SNATRuleEnabled bool
+
+		// DefaultForwarderZone is the zone used when no conditional forwarder zone matches.
+		DefaultForwarderZone DNSForwarderModelDefaultZoneRequest `validate:"required"`
+
+		// ConditionalForwarderZones is the list of zones with their matching DNS domains.
+		ConditionalForwarderZones []DNSForwarderModelConditionalZoneRequest `validate:"omitempty,max=5,dive"`
+	}
+
+	// DNSForwarderModelDefaultZoneRequest represents the default forwarder zone of a DNS forwarder request.
+	DNSForwarderModelDefaultZoneRequest struct {
+		// Name of the forwarder zone
+		Name string `validate:"required"`
+
+		// UpstreamServers is the list of DNS servers to which the queries are forwarded.
+		UpstreamServers []string `validate:"required,min=1,max=3,dive,ip"`
+	}
+
+	// DNSForwarderModelConditionalZoneRequest represents a conditional forwarder zone of a DNS forwarder request.
+	DNSForwarderModelConditionalZoneRequest struct {
+		// Name of the forwarder zone
+		Name string `validate:"required"`
+
+		// UpstreamServers is the list of DNS servers to which the queries are forwarded.
+		UpstreamServers []string `validate:"required,min=1,max=3,dive,ip"`
+
+		// DomainNames is the list of domains matched by the zone.
+		DomainNames []string `validate:"required,min=1,dive,fqdn"`
+	}
+)
+
+// fromVCD converts a VCD DNS forwarder configuration to the internal DNSForwarderModel.
+func (m *DNSForwarderModel) fromVCD(vcdDNS *govcdtypes.NsxtEdgeGatewayDns) {
+	if vcdDNS == nil {
+		return
+	}
+
+	m.Enabled = vcdDNS.Enabled
+	m.ListenerIP = vcdDNS.ListenerIp
+	m.SNATRuleEnabled = vcdDNS.SnatRuleEnabled
+
+	if vcdDNS.DefaultForwarderZone != nil {
+		m.DefaultForwarderZone = &DNSForwarderModelZone{}
+		m.DefaultForwarderZone.fromVCD(vcdDNS.DefaultForwarderZone)
+	}
+
+	m.ConditionalForwarderZones = make([]*DNSForwarderModelZone, 0, len(vcdDNS.ConditionalForwarderZones))
+	for _, zone := range vcdDNS.ConditionalForwarderZones {
+		z := &DNSForwarderModelZone{}
+		z.fromVCD(zone)
+		m.ConditionalForwarderZones = append(m.ConditionalForwarderZones, z)
+	}
+}
+
+// fromVCD converts a VCD DNS forwarder zone to the internal DNSForwarderModelZone.
+func (m *DNSForwarderModelZone) fromVCD(vcdZone *govcdtypes.NsxtDnsForwarderZoneConfig) {
+	if vcdZone == nil {
+		return
+	}
+
+	m.ID = vcdZone.ID
+	m.Name = vcdZone.DisplayName
+	m.UpstreamServers = vcdZone.UpstreamServers
+	m.DomainNames = vcdZone.DnsDomainNames
+}
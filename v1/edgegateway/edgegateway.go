@@ -11,48 +11,179 @@ package edgegateway
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/orange-cloudavenue/common-go/validators"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
 
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/endpoints"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/utils"
 	commoncloudavenue "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/common/cloudavenue"
+	caverrors "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 )
 
+const (
+	defaultListEdgeGatewayPageSize             = 25
+	defaultListEdgeGatewayBandwidthConcurrency = 5
+	defaultWaitForStatusTimeout                = 10 * time.Minute
+
+	edgeGatewayBackingNSXT        = "NSXT_BACKED"
+	edgeGatewayUplinkBackingT0    = "NSXT_TIER0"
+	edgeGatewayUplinkBackingT0VRF = "NSXT_VRF_TIER0"
+)
+
 // ListEdgeGateway fetches all edge gateways and returns them as a slice of EdgeGatewayModel.
 func (c *client) ListEdgeGateway(ctx context.Context) ([]*EdgeGatewayModel, error) {
-	if err := c.clientCloudavenue.Refresh(); err != nil {
-		return nil, err
+	return c.ListEdgeGatewayWithOptions(ctx, ListEdgeGatewayOptions{})
+}
+
+// ListEdgeGatewayWithOptions fetches the edge gateways matching the options
+// and returns them as a slice of EdgeGatewayModel.
+func (c *client) ListEdgeGatewayWithOptions(ctx context.Context, opts ListEdgeGatewayOptions) ([]*EdgeGatewayModel, error) {
+	edgeGatewayModels := make([]*EdgeGatewayModel, 0)
+
+	for egm, err := range c.IterEdgeGateways(ctx, opts) {
+		if err != nil {
+			return nil, err
+		}
+
+		edgeGatewayModels = append(edgeGatewayModels, egm)
 	}
 
-	edgeGateways, err := c.clientGoVCDOrg.GetAllNsxtEdgeGateways(nil)
+	return edgeGatewayModels, nil
+}
+
+// IterEdgeGateways returns an iterator over the edge gateways matching the options.
+// The edge gateways are retrieved page by page, the next page is only requested
+// when the previous one has been consumed. On error, the iterator yields the error and stops.
+func (c *client) IterEdgeGateways(ctx context.Context, opts ListEdgeGatewayOptions) iter.Seq2[*EdgeGatewayModel, error] {
+	return func(yield func(*EdgeGatewayModel, error) bool) {
+		if err := validators.New().StructCtx(ctx, &opts); err != nil {
+			yield(nil, err)
+			return
+		}
+
+		if err := c.clientCloudavenue.Refresh(); err != nil {
+			yield(nil, err)
+			return
+		}
+
+		queryParameters := opts.toQueryParameters()
+
+		for page := 1; ; page++ {
+			queryParameters.Set("page", strconv.Itoa(page))
+
+			edgeGatewayModels, pageCount, err := c.listEdgeGatewayPage(ctx, queryParameters, opts)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			for _, egm := range edgeGatewayModels {
+				if !yield(egm, nil) {
+					return
+				}
+			}
+
+			if page >= pageCount {
+				return
+			}
+		}
+	}
+}
+
+// listEdgeGatewayPage retrieves a page of edge gateways matching the options.
+// It returns the edge gateways and the number of pages.
+func (c *client) listEdgeGatewayPage(ctx context.Context, queryParameters url.Values, opts ListEdgeGatewayOptions) ([]*EdgeGatewayModel, int, error) {
+	page, err := c.clientGoVCD.OpenAPIGetPage(govcdtypes.OpenApiPathVersion1_0_0+govcdtypes.OpenApiEndpointEdgeGateways, queryParameters)
 	if err != nil {
-		return nil, err
+		return nil, 0, fmt.Errorf("error retrieving edge gateways: %w", err)
 	}
 
-	edgeGatewayModels := make([]*EdgeGatewayModel, 0)
+	vcdEdgeGateways := make([]*govcdtypes.OpenAPIEdgeGateway, 0)
+	if len(page.Values) > 0 {
+		if err := json.Unmarshal(page.Values, &vcdEdgeGateways); err != nil {
+			return nil, 0, fmt.Errorf("error decoding edge gateways: %w", err)
+		}
+	}
+
+	edgeGatewayModels := make([]*EdgeGatewayModel, 0, len(vcdEdgeGateways))
+	for _, eg := range vcdEdgeGateways {
+		// Like govcd, the endpoint also returns the NSX-V edge gateways
+		if eg.GatewayBacking == nil || eg.GatewayBacking.GatewayType != edgeGatewayBackingNSXT {
+			continue
+		}
+
+		// Like govcd, the T0 uplink is moved first since fromVCD reads it at index 0
+		reorderEdgeGatewayUplinks(eg.EdgeGatewayUplinks)
 
-	for _, eg := range edgeGateways {
 		egm := &EdgeGatewayModel{}
-		egm.fromVCD(eg.EdgeGateway)
+		egm.fromVCD(eg)
+
+		// UplinkT0 and Status are not filtered by the API
+		if opts.UplinkT0 != "" && egm.UplinkT0 != opts.UplinkT0 {
+			continue
+		}
+		if opts.Status != "" && egm.Status != opts.Status {
+			continue
+		}
 
 		edgeGatewayModels = append(edgeGatewayModels, egm)
+	}
 
-		bandwidth, err := c.getBandwidth(ctx, egm)
-		if err != nil {
-			return nil, fmt.Errorf("error retrieving edge gateway %s bandwidth: %w", egm.ID, err)
+	if !opts.SkipBandwidth {
+		if err := c.setBandwidths(ctx, edgeGatewayModels, opts.BandwidthConcurrency); err != nil {
+			return nil, 0, err
 		}
+	}
+
+	return edgeGatewayModels, page.PageCount, nil
+}
 
-		egm.Bandwidth = bandwidth
+// reorderEdgeGatewayUplinks moves the uplink backed by a T0 or a T0 VRF to the first position.
+func reorderEdgeGatewayUplinks(uplinks []govcdtypes.EdgeGatewayUplinks) {
+	for i, uplink := range uplinks {
+		if uplink.BackingType != nil && (*uplink.BackingType == edgeGatewayUplinkBackingT0 || *uplink.BackingType == edgeGatewayUplinkBackingT0VRF) {
+			uplinks[0], uplinks[i] = uplinks[i], uplinks[0]
+			return
+		}
 	}
+}
 
-	return edgeGatewayModels, nil
+// setBandwidths retrieves the bandwidth of the edge gateways with at most concurrency calls in parallel.
+func (c *client) setBandwidths(ctx context.Context, edgeGatewayModels []*EdgeGatewayModel, concurrency int) error {
+	if concurrency == 0 {
+		concurrency = defaultListEdgeGatewayBandwidthConcurrency
+	}
+
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(concurrency)
+
+	for _, egm := range edgeGatewayModels {
+		g.Go(func() error {
+			bandwidth, err := c.getBandwidth(gCtx, egm)
+			if err != nil {
+				return fmt.Errorf("error retrieving edge gateway %s bandwidth: %w", egm.ID, err)
+			}
+
+			egm.Bandwidth = bandwidth
+			return nil
+		})
+	}
+
+	return g.Wait()
 }
 
 // GetEdgeGateway retrieves an Edge Gateway by name or ID.
@@ -131,6 +262,13 @@ func (c *client) CreateEdgeGateway(ctx context.Context, edgeGateway *EdgeGateway
 		return nil, errors.New("owner reference name or ID is required")
 	}
 
+	// Validate the bandwidth against the T0 capacity.
+	// Without UplinkT0 and with several T0 routers, the T0 router is chosen by Cloud Avenue
+	// and the bandwidth is checked by the API only.
+	if err := c.BandwidthPlanner().ValidateCreate(ctx, edgeGateway); err != nil && !errors.Is(err, caverrors.ErrEdgeGatewayBandwidthNotChecked) {
+		return nil, err
+	}
+
 	var isVDCGroup bool
 
 	// If OwnerRef Name is not set get the name from the ID
@@ -238,36 +376,128 @@ func (c *client) CreateEdgeGateway(ctx context.Context, edgeGateway *EdgeGateway
 		}
 	}
 
+	if edgeGateway.WaitReady {
+		return c.WaitForStatus(ctx, edgeGatewayCreated.ID, EdgeGatewayStatusRealized)
+	}
+
 	return edgeGatewayCreated, nil
 }
 
 func (c *client) UpdateEdgeGateway(ctx context.Context, edgeGateway *EdgeGatewayModelUpdate) error {
-	if err := validators.New().Struct(edgeGateway); err != nil {
+	// Validate the request and the bandwidth against the T0 capacity
+	if err := c.BandwidthPlanner().ValidateUpdate(ctx, edgeGateway); err != nil {
 		return err
 	}
 
-	if err := c.clientCloudavenue.Refresh(); err != nil {
-		return err
-	}
-
-	return c.updateBandwidth(
+	if err := c.updateBandwidth(
 		ctx,
 		&EdgeGatewayModel{
 			ID:        edgeGateway.ID,
 			Bandwidth: edgeGateway.Bandwidth,
 		},
 		edgeGateway.Bandwidth,
-	)
+	); err != nil {
+		return err
+	}
+
+	if edgeGateway.WaitReady {
+		_, err := c.WaitForStatus(ctx, edgeGateway.ID, EdgeGatewayStatusRealized)
+		return err
+	}
+
+	return nil
+}
+
+// WaitForStatus waits for the edge gateway to reach the status and returns it.
+// The status is polled with an exponential backoff until the context is done,
+// or until 10 minutes if the context has no deadline.
+// If the edge gateway ends in REALIZATION_FAILED status, an *EdgeGatewayRealizationError is returned.
+func (c *client) WaitForStatus(ctx context.Context, edgeGatewayNameOrID, status string) (*EdgeGatewayModel, error) {
+	if err := c.edgeGatewayNameOrIDValidation(edgeGatewayNameOrID); err != nil {
+		return nil, err
+	}
+
+	if status == "" {
+		return nil, fmt.Errorf("status is %w. Please provide a valid status", caverrors.ErrEmpty)
+	}
+
+	if _, deadlineSet := ctx.Deadline(); !deadlineSet {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultWaitForStatusTimeout)
+		defer cancel()
+	}
+
+	edgeGatewayModel := new(EdgeGatewayModel)
+
+	err := utils.Poll(ctx, func() (bool, error) {
+		if err := c.clientCloudavenue.Refresh(); err != nil {
+			return false, err
+		}
+
+		vcdEdgeGateway, err := c.getVCDEdgeGateway(ctx, edgeGatewayNameOrID)
+		if err != nil {
+			return false, err
+		}
+
+		edgeGatewayModel.fromVCD(vcdEdgeGateway.EdgeGateway)
+
+		switch edgeGatewayModel.Status {
+		case status:
+			bandwidth, err := c.getBandwidth(ctx, edgeGatewayModel)
+			if err != nil {
+				return false, fmt.Errorf("error retrieving edge gateway %s bandwidth: %w", edgeGatewayNameOrID, err)
+			}
+
+			edgeGatewayModel.Bandwidth = bandwidth
+			return true, nil
+		case EdgeGatewayStatusRealizationFailed:
+			return false, &EdgeGatewayRealizationError{
+				ID:     edgeGatewayModel.ID,
+				Name:   edgeGatewayModel.Name,
+				Status: edgeGatewayModel.Status,
+			}
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("timeout reached waiting for edge gateway %s to be in %s status, current status is %s (%w)", edgeGatewayNameOrID, status, edgeGatewayModel.Status, ctx.Err())
+		}
+		return nil, err
+	}
+
+	return edgeGatewayModel, nil
 }
 
 // * Local functions
 
 // getEdgeGateway retrieves an edge gateway by name or ID.
 func (c *client) getEdgeGateway(ctx context.Context, edgeGatewayNameOrID string) (*EdgeGatewayModel, error) {
+	edgeGatewayModel := new(EdgeGatewayModel)
+
+	vcdEdgeGateway, err := c.getVCDEdgeGateway(ctx, edgeGatewayNameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	edgeGatewayModel.fromVCD(vcdEdgeGateway.EdgeGateway)
+
+	bandwidth, err := c.getBandwidth(ctx, edgeGatewayModel)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving edge gateway %s bandwidth: %w", edgeGatewayNameOrID, err)
+	}
+
+	edgeGatewayModel.Bandwidth = bandwidth
+
+	return edgeGatewayModel, nil
+}
+
+// getVCDEdgeGateway retrieves the govcd edge gateway by name or ID.
+func (c *client) getVCDEdgeGateway(_ context.Context, edgeGatewayNameOrID string) (*govcd.NsxtEdgeGateway, error) {
 	var (
-		vcdEdgeGateway   *govcd.NsxtEdgeGateway
-		err              error
-		edgeGatewayModel = new(EdgeGatewayModel)
+		vcdEdgeGateway *govcd.NsxtEdgeGateway
+		err            error
 	)
 
 	// If edgeGatewayNameOrID is a URN, get edge gateway by ID (more efficient)
@@ -281,16 +511,21 @@ func (c *client) getEdgeGateway(ctx context.Context, edgeGatewayNameOrID string)
 		return nil, fmt.Errorf("error retrieving edge gateway %s: %w", edgeGatewayNameOrID, err)
 	}
 
-	edgeGatewayModel.fromVCD(vcdEdgeGateway.EdgeGateway)
+	return vcdEdgeGateway, nil
+}
 
-	bandwidth, err := c.getBandwidth(ctx, edgeGatewayModel)
-	if err != nil {
-		return nil, fmt.Errorf("error retrieving edge gateway %s bandwidth: %w", edgeGatewayNameOrID, err)
+// getVCDEdgeGateway retrieves the govcd edge gateway of the EdgeGateway.
+func (e *EdgeGateway) getVCDEdgeGateway(_ context.Context) (*govcd.NsxtEdgeGateway, error) {
+	if err := e.Refresh(); err != nil {
+		return nil, err
 	}
 
-	edgeGatewayModel.Bandwidth = bandwidth
+	vcdEdgeGateway, err := e.GetNsxtEdgeGatewayById(e.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving edge gateway %s: %w", e.ID, err)
+	}
 
-	return edgeGatewayModel, nil
+	return vcdEdgeGateway, nil
 }
 
 // getBandwidth retrieves the bandwidth of an edge gateway.
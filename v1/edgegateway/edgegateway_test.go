@@ -13,7 +13,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/google/uuid"
@@ -25,9 +27,11 @@ import (
 	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
 
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/endpoints"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/utils"
 	clientcloudavenue "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/clients/cloudavenue"
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 )
 
 const (
@@ -556,10 +560,53 @@ func TestClient_GetEdgeGateway(t *testing.T) {
 	}
 }
 
+// testEdgeGatewaysPage returns an OpenAPI page containing the edge gateways.
+// The edge gateways without backing are returned as NSX-T backed.
+func testEdgeGatewaysPage(t *testing.T, page, pageCount int, edgeGateways ...*govcdtypes.OpenAPIEdgeGateway) *govcdtypes.OpenApiPages {
+	t.Helper()
+
+	for i, eg := range edgeGateways {
+		if eg.GatewayBacking == nil {
+			nsxtEdgeGateway := *eg
+			nsxtEdgeGateway.GatewayBacking = &govcdtypes.OpenAPIEdgeGatewayBacking{GatewayType: edgeGatewayBackingNSXT}
+			edgeGateways[i] = &nsxtEdgeGateway
+		}
+	}
+
+	values, err := json.Marshal(edgeGateways)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &govcdtypes.OpenApiPages{
+		Page:        page,
+		PageCount:   pageCount,
+		ResultTotal: len(edgeGateways),
+		Values:      values,
+	}
+}
+
+// testMockBandwidths registers the bandwidth responders of the edge gateways.
+// The key is the edge gateway ID and the value the bandwidth.
+func testMockBandwidths(t *testing.T, bandwidths map[string]int) {
+	t.Helper()
+
+	httpmock.ActivateNonDefault(clientcloudavenue.MockClient().GetClient())
+	for edgeGatewayID, bandwidth := range bandwidths {
+		responder, err := httpmock.NewJsonResponder(200, json.RawMessage(fmt.Sprintf(`{"rateLimit":%d}`, bandwidth)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		httpmock.RegisterResponder("GET", endpoints.InlineTemplate(endpoints.EdgeGatewayGet, map[string]string{testEdgeID: urn.ExtractUUID(edgeGatewayID)}), responder)
+	}
+}
+
 func TestClient_ListEdgeGateway(t *testing.T) {
 	// Mock controller.
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
+	defer httpmock.DeactivateAndReset()
 
 	// Mock client for cloudavenue.
 	clientCAV := NewMockclientInterface(ctrl)
@@ -570,6 +617,27 @@ func TestClient_ListEdgeGateway(t *testing.T) {
 	edgeGatewayID2 := urn.Gateway.String() + uuid.New().String()
 	vdcID := urn.VDC.String() + uuid.New().String()
 
+	vcdEdgeGateway := &govcdtypes.OpenAPIEdgeGateway{
+		ID:          edgeGatewayID,
+		Name:        testEdgeGatewayName,
+		Description: testEdgeGatewayDesc,
+		Status:      testEdgeGatewayStatus,
+		OwnerRef: &govcdtypes.OpenApiReference{
+			ID:   vdcID,
+			Name: testVDCName,
+		},
+	}
+	vcdEdgeGateway2 := &govcdtypes.OpenAPIEdgeGateway{
+		ID:          edgeGatewayID2,
+		Name:        testEdgeGatewayName2,
+		Description: testEdgeGatewayDesc2,
+		Status:      testEdgeGatewayStatus,
+		OwnerRef: &govcdtypes.OpenApiReference{
+			ID:   vdcID,
+			Name: testVDCName,
+		},
+	}
+
 	tests := []struct {
 		name                string
 		mockFunc            func()
@@ -581,54 +649,9 @@ func TestClient_ListEdgeGateway(t *testing.T) {
 			name: testSuccess,
 			mockFunc: func() {
 				clientCAV.EXPECT().Refresh().Return(nil)
-				clientCAV.EXPECT().GetAllNsxtEdgeGateways(nil).Return([]*govcd.NsxtEdgeGateway{
-					{
-						EdgeGateway: &govcdtypes.OpenAPIEdgeGateway{
-							ID:          edgeGatewayID,
-							Name:        testEdgeGatewayName,
-							Description: testEdgeGatewayDesc,
-							Status:      testEdgeGatewayStatus,
-							OwnerRef: &govcdtypes.OpenApiReference{
-								ID:   vdcID,
-								Name: testVDCName,
-							},
-						},
-					},
-					{
-						EdgeGateway: &govcdtypes.OpenAPIEdgeGateway{
-							ID:          edgeGatewayID2,
-							Name:        testEdgeGatewayName2,
-							Description: testEdgeGatewayDesc2,
-							Status:      testEdgeGatewayStatus,
-							OwnerRef: &govcdtypes.OpenApiReference{
-								ID:   vdcID,
-								Name: testVDCName,
-							},
-						},
-					},
-				}, nil)
-				// mock getBandwidth test-edge-gateway
-				clientCAV.EXPECT().R().DoAndReturn(func() *resty.Request {
-					httpmock.ActivateNonDefault(clientcloudavenue.MockClient().GetClient())
-					responder, err := httpmock.NewJsonResponder(200, json.RawMessage(`{"rateLimit":10}`))
-					if err != nil {
-						t.Fatal(err)
-					}
-
-					httpmock.RegisterResponder("GET", endpoints.InlineTemplate(endpoints.EdgeGatewayGet, map[string]string{testEdgeID: urn.ExtractUUID(edgeGatewayID)}), responder)
-					return clientcloudavenue.MockClient().R()
-				})
-				// mock getBandwidth test-edge-gateway-2
-				clientCAV.EXPECT().R().DoAndReturn(func() *resty.Request {
-					httpmock.ActivateNonDefault(clientcloudavenue.MockClient().GetClient())
-					responder, err := httpmock.NewJsonResponder(200, json.RawMessage(`{"rateLimit":100}`))
-					if err != nil {
-						t.Fatal(err)
-					}
-
-					httpmock.RegisterResponder("GET", endpoints.InlineTemplate(endpoints.EdgeGatewayGet, map[string]string{testEdgeID: urn.ExtractUUID(edgeGatewayID2)}), responder)
-					return clientcloudavenue.MockClient().R()
-				})
+				clientCAV.EXPECT().OpenAPIGetPage(gomock.Any(), gomock.Any()).Return(testEdgeGatewaysPage(t, 1, 1, vcdEdgeGateway, vcdEdgeGateway2), nil)
+				testMockBandwidths(t, map[string]int{edgeGatewayID: 10, edgeGatewayID2: 100})
+				clientCAV.EXPECT().R().DoAndReturn(clientcloudavenue.MockClient().R).Times(2)
 			},
 			expectedEdgeGateway: []*EdgeGatewayModel{
 				{
@@ -660,7 +683,7 @@ func TestClient_ListEdgeGateway(t *testing.T) {
 			name: "error-get-all-edge-gateways",
 			mockFunc: func() {
 				clientCAV.EXPECT().Refresh().Return(nil)
-				clientCAV.EXPECT().GetAllNsxtEdgeGateways(nil).Return(nil, fmt.Errorf("error"))
+				clientCAV.EXPECT().OpenAPIGetPage(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
 			},
 			expectedEdgeGateway: nil,
 			expectedError:       true,
@@ -679,51 +702,10 @@ func TestClient_ListEdgeGateway(t *testing.T) {
 			name: "error-get-bandwidth",
 			mockFunc: func() {
 				clientCAV.EXPECT().Refresh().Return(nil)
-				clientCAV.EXPECT().GetAllNsxtEdgeGateways(nil).Return([]*govcd.NsxtEdgeGateway{
-					{
-						EdgeGateway: &govcdtypes.OpenAPIEdgeGateway{
-							ID:          edgeGatewayID,
-							Name:        testEdgeGatewayName,
-							Description: testEdgeGatewayDesc,
-							Status:      testEdgeGatewayStatus,
-							OwnerRef: &govcdtypes.OpenApiReference{
-								ID:   vdcID,
-								Name: testVDCName,
-							},
-						},
-					},
-					{
-						EdgeGateway: &govcdtypes.OpenAPIEdgeGateway{
-							ID:          edgeGatewayID2,
-							Name:        testEdgeGatewayName2,
-							Description: testEdgeGatewayDesc2,
-							Status:      testEdgeGatewayStatus,
-							OwnerRef: &govcdtypes.OpenApiReference{
-								ID:   vdcID,
-								Name: testVDCName,
-							},
-						},
-					},
-				}, nil)
-				// mock getBandwidth test-edge-gateway
-				clientCAV.EXPECT().R().DoAndReturn(func() *resty.Request {
-					httpmock.ActivateNonDefault(clientcloudavenue.MockClient().GetClient())
-					responder, err := httpmock.NewJsonResponder(200, json.RawMessage(`{"rateLimit":10}`))
-					if err != nil {
-						t.Fatal(err)
-					}
-
-					httpmock.RegisterResponder("GET", endpoints.InlineTemplate(endpoints.EdgeGatewayGet, map[string]string{testEdgeID: urn.ExtractUUID(edgeGatewayID)}), responder)
-					return clientcloudavenue.MockClient().R()
-				})
-
-				// mock getBandwidth test-edge-gateway-2
-				clientCAV.EXPECT().R().DoAndReturn(func() *resty.Request {
-					httpmock.ActivateNonDefault(clientcloudavenue.MockClient().GetClient())
-					responder := httpmock.NewErrorResponder(fmt.Errorf("error"))
-					httpmock.RegisterResponder("GET", endpoints.InlineTemplate(endpoints.EdgeGatewayGet, map[string]string{testEdgeID: urn.ExtractUUID(edgeGatewayID2)}), responder)
-					return clientcloudavenue.MockClient().R()
-				})
+				clientCAV.EXPECT().OpenAPIGetPage(gomock.Any(), gomock.Any()).Return(testEdgeGatewaysPage(t, 1, 1, vcdEdgeGateway, vcdEdgeGateway2), nil)
+				testMockBandwidths(t, map[string]int{edgeGatewayID: 10})
+				httpmock.RegisterResponder("GET", endpoints.InlineTemplate(endpoints.EdgeGatewayGet, map[string]string{testEdgeID: urn.ExtractUUID(edgeGatewayID2)}), httpmock.NewErrorResponder(fmt.Errorf("error")))
+				clientCAV.EXPECT().R().DoAndReturn(clientcloudavenue.MockClient().R).MaxTimes(2)
 			},
 			expectedEdgeGateway: nil,
 			expectedError:       true,
@@ -733,6 +715,7 @@ func TestClient_ListEdgeGateway(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
+			httpmock.Reset()
 			test.mockFunc()
 
 			allEdgeGateways, err := c.ListEdgeGateway(context.Background())
@@ -750,6 +733,187 @@ func TestClient_ListEdgeGateway(t *testing.T) {
 	}
 }
 
+func TestClient_ListEdgeGatewayWithOptions(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	vdcGroupID := urn.VDCGroup.String() + uuid.New().String()
+
+	edgeGateway := func(name, t0, status string) *govcdtypes.OpenAPIEdgeGateway {
+		return &govcdtypes.OpenAPIEdgeGateway{
+			ID:                 urn.Gateway.String() + uuid.New().String(),
+			Name:               name,
+			Status:             status,
+			EdgeGatewayUplinks: []govcdtypes.EdgeGatewayUplinks{{UplinkName: t0}},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		opts          ListEdgeGatewayOptions
+		mockFunc      func()
+		expectedNames []string
+		expectedError bool
+		err           error
+	}{
+		{
+			name: "success-filters-and-pages",
+			opts: ListEdgeGatewayOptions{
+				OwnerNameOrID: vdcGroupID,
+				UplinkT0:      testVRFName,
+				NamePattern:   "prod-*",
+				Status:        "REALIZED",
+				PageSize:      2,
+				SkipBandwidth: true,
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				gomock.InOrder(
+					clientCAV.EXPECT().OpenAPIGetPage(gomock.Any(), url.Values{
+						"filter":   []string{"ownerRef.id==" + vdcGroupID + ";name==prod-*"},
+						"pageSize": []string{"2"},
+						"page":     []string{"1"},
+					}).Return(testEdgeGatewaysPage(t, 1, 2,
+						edgeGateway("prod-1", testVRFName, "REALIZED"),
+						edgeGateway("prod-2", "other-t0", "REALIZED"),
+					), nil),
+					clientCAV.EXPECT().OpenAPIGetPage(gomock.Any(), gomock.Any()).DoAndReturn(func(_ string, queryParameters url.Values) (*govcdtypes.OpenApiPages, error) {
+						assert.Equal(t, "2", queryParameters.Get("page"))
+						return testEdgeGatewaysPage(t, 2, 2,
+							edgeGateway("prod-3", testVRFName, "REALIZATION_FAILED"),
+							edgeGateway("prod-4", testVRFName, "REALIZED"),
+						), nil
+					}),
+				)
+			},
+			expectedNames: []string{"prod-1", "prod-4"},
+		},
+		{
+			name: "success-owner-name",
+			opts: ListEdgeGatewayOptions{
+				OwnerNameOrID: testVDCName,
+				SkipBandwidth: true,
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().OpenAPIGetPage(gomock.Any(), gomock.Any()).DoAndReturn(func(_ string, queryParameters url.Values) (*govcdtypes.OpenApiPages, error) {
+					assert.Equal(t, "ownerRef.name=="+testVDCName, queryParameters.Get("filter"))
+					assert.Equal(t, "25", queryParameters.Get("pageSize"))
+					return testEdgeGatewaysPage(t, 1, 1, edgeGateway(testEdgeGatewayName, testVRFName, "REALIZED")), nil
+				})
+			},
+			expectedNames: []string{testEdgeGatewayName},
+		},
+		{
+			name: "success-nsxt-only-and-t0-uplink-not-first",
+			opts: ListEdgeGatewayOptions{
+				UplinkT0:      testVRFName,
+				SkipBandwidth: true,
+			},
+			mockFunc: func() {
+				nsxtEdgeGateway := edgeGateway(testEdgeGatewayName, "", "REALIZED")
+				nsxtEdgeGateway.EdgeGatewayUplinks = []govcdtypes.EdgeGatewayUplinks{
+					{UplinkName: "segment-backed", BackingType: utils.ToPTR("IMPORTED_T_LOGICAL_SWITCH")},
+					{UplinkName: testVRFName, BackingType: utils.ToPTR(edgeGatewayUplinkBackingT0VRF)},
+				}
+
+				nsxvEdgeGateway := edgeGateway(testEdgeGatewayName2, testVRFName, "REALIZED")
+				nsxvEdgeGateway.GatewayBacking = &govcdtypes.OpenAPIEdgeGatewayBacking{GatewayType: "NSXV_BACKED"}
+
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().OpenAPIGetPage(gomock.Any(), gomock.Any()).Return(testEdgeGatewaysPage(t, 1, 1, nsxtEdgeGateway, nsxvEdgeGateway), nil)
+			},
+			expectedNames: []string{testEdgeGatewayName},
+		},
+		{
+			name: "error-fiql-reserved-character-in-name",
+			opts: ListEdgeGatewayOptions{
+				NamePattern: "prod;ownerRef.name==other",
+			},
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           fmt.Errorf("NamePattern"),
+		},
+		{
+			name: "error-fiql-reserved-character-in-owner",
+			opts: ListEdgeGatewayOptions{
+				OwnerNameOrID: "vdc,name==*",
+			},
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           fmt.Errorf("OwnerNameOrID"),
+		},
+		{
+			name: "error-fiql-parenthesis-in-name",
+			opts: ListEdgeGatewayOptions{
+				NamePattern: "prod (old)",
+			},
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           fmt.Errorf("NamePattern"),
+		},
+		{
+			name: "error-invalid-page-size",
+			opts: ListEdgeGatewayOptions{
+				PageSize: 1000,
+			},
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           fmt.Errorf("PageSize"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mockFunc()
+
+			edgeGateways, err := c.ListEdgeGatewayWithOptions(context.Background(), test.opts)
+			if !test.expectedError {
+				assert.NoError(t, err)
+				names := make([]string, 0, len(edgeGateways))
+				for _, eg := range edgeGateways {
+					names = append(names, eg.Name)
+					assert.Equal(t, 0, eg.Bandwidth)
+				}
+				assert.Equal(t, test.expectedNames, names)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err.Error())
+		})
+	}
+}
+
+func TestClient_IterEdgeGateways(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	// Only the first page is requested when the iteration stops early
+	clientCAV.EXPECT().Refresh().Return(nil)
+	clientCAV.EXPECT().OpenAPIGetPage(gomock.Any(), gomock.Any()).Return(testEdgeGatewaysPage(t, 1, 3,
+		&govcdtypes.OpenAPIEdgeGateway{ID: urn.Gateway.String() + uuid.New().String(), Name: testEdgeGatewayName},
+		&govcdtypes.OpenAPIEdgeGateway{ID: urn.Gateway.String() + uuid.New().String(), Name: testEdgeGatewayName2},
+	), nil).Times(1)
+
+	for eg, err := range c.IterEdgeGateways(context.Background(), ListEdgeGatewayOptions{SkipBandwidth: true}) {
+		assert.NoError(t, err)
+		assert.Equal(t, testEdgeGatewayName, eg.Name)
+		break
+	}
+}
+
 // TODO Wait migration VDC and VDCGroup to new SDK
 // func TestClient_CreateEdgeGateway(t *testing.T) {
 
@@ -786,6 +950,19 @@ func TestClient_ListEdgeGateway(t *testing.T) {
 
 // }
 
+// testMockT0 registers the responder of the T0 router.
+func testMockT0(t *testing.T, classService v1.ClassService) {
+	t.Helper()
+
+	httpmock.ActivateNonDefault(clientcloudavenue.MockClient().GetClient())
+	responder, err := httpmock.NewJsonResponder(200, json.RawMessage(fmt.Sprintf(`{"tier0_vrf":"%s","class_service":"%s"}`, testVRFName, classService)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	httpmock.RegisterResponder("GET", endpoints.InlineTemplate(endpoints.T0Get, map[string]string{"t0-name": testVRFName}), responder)
+}
+
 func TestClient_UpdateEdgeGateway(t *testing.T) {
 	// Mock controller.
 	ctrl := gomock.NewController(t)
@@ -798,14 +975,55 @@ func TestClient_UpdateEdgeGateway(t *testing.T) {
 	c, _ := NewFakeClient(clientCAV)
 
 	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	edgeGatewayID2 := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
 
 	jobStatusID := uuid.New().String()
 
+	vcdEdgeGateway := testVCDEdgeGateway(edgeGatewayID, vdcID)
+	vcdEdgeGateway.EdgeGateway.EdgeGatewayUplinks = []govcdtypes.EdgeGatewayUplinks{{UplinkName: testVRFName}}
+	vcdEdgeGateway2 := &govcdtypes.OpenAPIEdgeGateway{
+		ID:                 edgeGatewayID2,
+		Name:               testEdgeGatewayName2,
+		EdgeGatewayUplinks: []govcdtypes.EdgeGatewayUplinks{{UplinkName: testVRFName}},
+	}
+
+	// mockPlanner mocks the T0 capacity, the T0 has 2 edge gateways with 100 Mbps each.
+	mockPlanner := func(classService v1.ClassService) {
+		clientCAV.EXPECT().Refresh().Return(nil)
+		clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(vcdEdgeGateway, nil)
+		testMockT0(t, classService)
+		if !classService.IsVRFDedicated() {
+			clientCAV.EXPECT().Refresh().Return(nil)
+			clientCAV.EXPECT().OpenAPIGetPage(gomock.Any(), gomock.Any()).Return(testEdgeGatewaysPage(t, 1, 1, vcdEdgeGateway.EdgeGateway, vcdEdgeGateway2), nil)
+			testMockBandwidths(t, map[string]int{edgeGatewayID: 100, edgeGatewayID2: 100})
+		}
+	}
+
+	// mockUpdate mocks the update of the bandwidth.
+	mockUpdate := func() {
+		responder, err := httpmock.NewJsonResponder(200, json.RawMessage(`{"message":"Job successfully created","jobId":"`+jobStatusID+`"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		httpmock.RegisterResponder("PUT", endpoints.InlineTemplate(endpoints.EdgeGatewayUpdate, map[string]string{testEdgeID: urn.ExtractUUID(edgeGatewayID)}), responder)
+
+		// * mock getJobStatus
+		responderJob, err := httpmock.NewJsonResponder(200, json.RawMessage(`[{"actions":[],"description":"string","name":"string","status":"DONE"}]`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		httpmock.RegisterResponder("GET", endpoints.InlineTemplate(endpoints.JobStatusGet, map[string]string{testJobID: jobStatusID}), responderJob)
+	}
+
 	tests := []struct {
 		name          string
 		edgeID        string
 		mockFunc      func()
 		bandwidth     int
+		waitReady     bool
 		expectedError bool
 		err           error
 	}{
@@ -813,60 +1031,86 @@ func TestClient_UpdateEdgeGateway(t *testing.T) {
 			name:   testSuccess,
 			edgeID: edgeGatewayID,
 			mockFunc: func() {
-				clientCAV.EXPECT().Refresh().Return(nil)
-
-				// mock getBandwidth test-edge-gateway
-				clientCAV.EXPECT().R().DoAndReturn(func() *resty.Request {
-					httpmock.ActivateNonDefault(clientcloudavenue.MockClient().GetClient())
-
-					// * mock getBandwidth
-					responder, err := httpmock.NewJsonResponder(200, json.RawMessage(`{"message":"Job successfully created","jobId":"`+jobStatusID+`"}`))
-					if err != nil {
-						t.Fatal(err)
-					}
-
-					httpmock.RegisterResponder("PUT", endpoints.InlineTemplate(endpoints.EdgeGatewayUpdate, map[string]string{testEdgeID: urn.ExtractUUID(edgeGatewayID)}), responder)
-
-					// * mock getJobStatus
-					responderJob, err := httpmock.NewJsonResponder(200, json.RawMessage(`[{"actions":[],"description":"string","name":"string","status":"DONE"}]`))
-					if err != nil {
-						t.Fatal(err)
-					}
-
-					httpmock.RegisterResponder("GET", endpoints.InlineTemplate(endpoints.JobStatusGet, map[string]string{testJobID: jobStatusID}), responderJob)
-					return clientcloudavenue.MockClient().R()
-				})
+				mockPlanner(v1.T0ClassServiceVRFStandard)
+				mockUpdate()
+				// T0, 2 bandwidths and update
+				clientCAV.EXPECT().R().DoAndReturn(clientcloudavenue.MockClient().R).Times(4)
 			},
-			bandwidth:     10,
+			// 300 - 200 allocated + 100 released by the edge gateway
+			bandwidth:     200,
+			expectedError: false,
+		},
+		{
+			name:   "success-dedicated-t0",
+			edgeID: edgeGatewayID,
+			mockFunc: func() {
+				mockPlanner(v1.T0ClassServiceVRFDedicatedMedium)
+				mockUpdate()
+				// T0 and update
+				clientCAV.EXPECT().R().DoAndReturn(clientcloudavenue.MockClient().R).Times(2)
+			},
+			bandwidth:     2000,
 			expectedError: false,
 		},
 		{
-			name:   "error-no-id",
-			edgeID: "",
+			name:   "success-wait-ready",
+			edgeID: edgeGatewayID,
 			mockFunc: func() {
+				mockPlanner(v1.T0ClassServiceVRFDedicatedMedium)
+				mockUpdate()
+				// WaitForStatus
+				vcdEdgeGatewayRealized := testVCDEdgeGateway(edgeGatewayID, vdcID)
+				vcdEdgeGatewayRealized.EdgeGateway.Status = EdgeGatewayStatusRealized
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(vcdEdgeGatewayRealized, nil)
+				testMockBandwidths(t, map[string]int{edgeGatewayID: 2000})
+				// T0, update and bandwidth
+				clientCAV.EXPECT().R().DoAndReturn(clientcloudavenue.MockClient().R).Times(3)
 			},
+			bandwidth:     2000,
+			waitReady:     true,
+			expectedError: false,
+		},
+		{
+			name:          "error-no-id",
+			edgeID:        "",
+			mockFunc:      func() {},
 			bandwidth:     10,
 			expectedError: true,
 			err:           fmt.Errorf("Error:Field validation for 'ID' failed on the 'required' tag"),
 		},
 		{
-			name:   "error-update-edge-gateway",
+			name:   "error-bandwidth-not-allowed",
 			edgeID: edgeGatewayID,
 			mockFunc: func() {
-				clientCAV.EXPECT().Refresh().Return(nil)
-
-				// mock getBandwidth test-edge-gateway
-				clientCAV.EXPECT().R().DoAndReturn(func() *resty.Request {
-					httpmock.ActivateNonDefault(clientcloudavenue.MockClient().GetClient())
-
-					// * mock getBandwidth
-					responder := httpmock.NewErrorResponder(fmt.Errorf("error"))
-					httpmock.RegisterResponder("PUT", endpoints.InlineTemplate(endpoints.EdgeGatewayUpdate, map[string]string{testEdgeID: urn.ExtractUUID(edgeGatewayID)}), responder)
-					return clientcloudavenue.MockClient().R()
-				})
+				mockPlanner(v1.T0ClassServiceVRFStandard)
+				clientCAV.EXPECT().R().DoAndReturn(clientcloudavenue.MockClient().R).Times(3)
 			},
 			bandwidth:     10,
 			expectedError: true,
+			err:           errors.ErrEdgeGatewayBandwidthNotAllowed,
+		},
+		{
+			name:   "error-capacity-exceeded",
+			edgeID: edgeGatewayID,
+			mockFunc: func() {
+				mockPlanner(v1.T0ClassServiceVRFStandard)
+				clientCAV.EXPECT().R().DoAndReturn(clientcloudavenue.MockClient().R).Times(3)
+			},
+			bandwidth:     250,
+			expectedError: true,
+			err:           errors.ErrEdgeGatewayBandwidthCapacityExceeded,
+		},
+		{
+			name:   "error-update-edge-gateway",
+			edgeID: edgeGatewayID,
+			mockFunc: func() {
+				mockPlanner(v1.T0ClassServiceVRFStandard)
+				httpmock.RegisterResponder("PUT", endpoints.InlineTemplate(endpoints.EdgeGatewayUpdate, map[string]string{testEdgeID: urn.ExtractUUID(edgeGatewayID)}), httpmock.NewErrorResponder(fmt.Errorf("error")))
+				clientCAV.EXPECT().R().DoAndReturn(clientcloudavenue.MockClient().R).Times(4)
+			},
+			bandwidth:     100,
+			expectedError: true,
 			err:           fmt.Errorf("error"),
 		},
 		{
@@ -883,11 +1127,13 @@ func TestClient_UpdateEdgeGateway(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
+			httpmock.Reset()
 			test.mockFunc()
 
 			err := c.UpdateEdgeGateway(context.Background(), &EdgeGatewayModelUpdate{
 				ID:        test.edgeID,
 				Bandwidth: test.bandwidth,
+				WaitReady: test.waitReady,
 			})
 
 			if !test.expectedError {
@@ -900,6 +1146,137 @@ func TestClient_UpdateEdgeGateway(t *testing.T) {
 	}
 }
 
+func TestClient_WaitForStatus(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	defer httpmock.DeactivateAndReset()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	// Speed up the polling
+	defer func(minInterval, maxInterval time.Duration) {
+		utils.PollMinInterval, utils.PollMaxInterval = minInterval, maxInterval
+	}(utils.PollMinInterval, utils.PollMaxInterval)
+	utils.PollMinInterval, utils.PollMaxInterval = time.Millisecond, 2*time.Millisecond
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+
+	// mockStatuses mocks the successive statuses of the edge gateway.
+	mockStatuses := func(statuses ...string) {
+		for _, status := range statuses {
+			vcdEdgeGateway := testVCDEdgeGateway(edgeGatewayID, vdcID)
+			vcdEdgeGateway.EdgeGateway.Status = status
+			clientCAV.EXPECT().Refresh().Return(nil)
+			clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(vcdEdgeGateway, nil)
+		}
+	}
+
+	tests := []struct {
+		name          string
+		edgeID        string
+		status        string
+		mockFunc      func()
+		expectedError bool
+		err           error
+	}{
+		{
+			name:   testSuccess,
+			edgeID: edgeGatewayID,
+			status: EdgeGatewayStatusRealized,
+			mockFunc: func() {
+				mockStatuses(EdgeGatewayStatusPending, EdgeGatewayStatusConfiguring, EdgeGatewayStatusRealized)
+				testMockBandwidths(t, map[string]int{edgeGatewayID: 10})
+				clientCAV.EXPECT().R().DoAndReturn(clientcloudavenue.MockClient().R)
+			},
+		},
+		{
+			name:   "error-realization-failed",
+			edgeID: edgeGatewayID,
+			status: EdgeGatewayStatusRealized,
+			mockFunc: func() {
+				mockStatuses(EdgeGatewayStatusPending, EdgeGatewayStatusRealizationFailed)
+			},
+			expectedError: true,
+			err:           errors.ErrEdgeGatewayRealizationFailed,
+		},
+		{
+			name:   "error-get-edge-gateway",
+			edgeID: edgeGatewayID,
+			status: EdgeGatewayStatusRealized,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(nil, fmt.Errorf("error"))
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error"),
+		},
+		{
+			name:          "error-empty-status",
+			edgeID:        edgeGatewayID,
+			status:        "",
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           errors.ErrEmpty,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			httpmock.Reset()
+			httpmock.ActivateNonDefault(clientcloudavenue.MockClient().GetClient())
+			test.mockFunc()
+
+			edgeGateway, err := c.WaitForStatus(context.Background(), test.edgeID, test.status)
+			if !test.expectedError {
+				assert.NoError(t, err)
+				assert.Equal(t, test.status, edgeGateway.Status)
+				assert.Equal(t, 10, edgeGateway.Bandwidth)
+				return
+			}
+			assert.Error(t, err)
+			assert.ErrorContains(t, err, test.err.Error())
+		})
+	}
+
+	t.Run("realization-error-type", func(t *testing.T) {
+		mockStatuses(EdgeGatewayStatusRealizationFailed)
+
+		_, err := c.WaitForStatus(context.Background(), edgeGatewayID, EdgeGatewayStatusRealized)
+
+		var realizationErr *EdgeGatewayRealizationError
+		assert.ErrorAs(t, err, &realizationErr)
+		assert.Equal(t, edgeGatewayID, realizationErr.ID)
+		assert.Equal(t, EdgeGatewayStatusRealizationFailed, realizationErr.Status)
+	})
+
+	t.Run("error-timeout", func(t *testing.T) {
+		// The edge gateway is never realized, a dedicated mock is used
+		// because the polling calls are not bounded.
+		ctrlTimeout := gomock.NewController(t)
+		defer ctrlTimeout.Finish()
+
+		clientCAVTimeout := NewMockclientInterface(ctrlTimeout)
+		cTimeout, _ := NewFakeClient(clientCAVTimeout)
+
+		vcdEdgeGateway := testVCDEdgeGateway(edgeGatewayID, vdcID)
+		vcdEdgeGateway.EdgeGateway.Status = EdgeGatewayStatusPending
+		clientCAVTimeout.EXPECT().Refresh().Return(nil).MinTimes(1)
+		clientCAVTimeout.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(vcdEdgeGateway, nil).MinTimes(1)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := cTimeout.WaitForStatus(ctx, edgeGatewayID, EdgeGatewayStatusRealized)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.ErrorContains(t, err, EdgeGatewayStatusPending)
+	})
+}
+
 func TestClient_EnableNetworkService(t *testing.T) {
 	// Mock controller.
 	ctrl := gomock.NewController(t)
@@ -10,8 +10,14 @@
 package edgegateway
 
 import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
 	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
 
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
 )
 
@@ -59,6 +65,9 @@ type (
 
 		// Bandwidth defines the bandwidth of the edge gateway.
 		Bandwidth int `validate:"required,min=5"`
+
+		// WaitReady waits for the edge gateway to be in REALIZED status before returning.
+		WaitReady bool
 	}
 
 	// EdgeGatewayModelUpdate represents the update model for an edge gateway.
@@ -68,6 +77,54 @@ type (
 
 		// Bandwidth defines the bandwidth of the edge gateway.
 		Bandwidth int `validate:"required,min=5"`
+
+		// WaitReady waits for the edge gateway to be in REALIZED status before returning.
+		WaitReady bool
+	}
+
+	// EdgeGatewayRealizationError is returned when the edge gateway ends in
+	// a failed realization status while waiting for a status.
+	// It wraps errors.ErrEdgeGatewayRealizationFailed.
+	EdgeGatewayRealizationError struct { //nolint:revive
+		// ID of the edge gateway.
+		ID string
+
+		// Name of the edge gateway.
+		Name string
+
+		// Status is the failed status of the edge gateway.
+		Status string
+	}
+
+	// ListEdgeGatewayOptions defines the filters and the behavior of the edge gateway listing.
+	// Empty filters are ignored.
+	ListEdgeGatewayOptions struct { //nolint:revive
+		// OwnerNameOrID filters the edge gateways owned by the VDC or VDC Group (name or ID).
+		// The FIQL reserved characters ";", ",", "(" and ")" are not allowed.
+		OwnerNameOrID string `validate:"omitempty,excludesall=0x2C;()"`
+
+		// UplinkT0 filters the edge gateways connected to the T0 router name.
+		UplinkT0 string
+
+		// NamePattern filters the edge gateways by name.
+		// The wildcard "*" matches any sequence of characters (e.g. "prod-*").
+		// The FIQL reserved characters ";", ",", "(" and ")" are not allowed.
+		NamePattern string `validate:"omitempty,excludesall=0x2C;()"`
+
+		// Status filters the edge gateways by status (e.g. REALIZED).
+		Status string
+
+		// PageSize is the number of edge gateways retrieved per API call.
+		// Defaults to 25.
+		PageSize int `validate:"omitempty,min=1,max=128"`
+
+		// SkipBandwidth skips the retrieval of the bandwidth.
+		// The Bandwidth of the returned edge gateways is left to 0.
+		SkipBandwidth bool
+
+		// BandwidthConcurrency is the number of bandwidth retrievals running in parallel.
+		// Defaults to 5.
+		BandwidthConcurrency int `validate:"omitempty,min=1,max=20"`
 	}
 
 	// -----.
@@ -78,6 +135,29 @@ type (
 	}
 )
 
+const (
+	// EdgeGatewayStatusRealized is the status of an edge gateway ready to be used.
+	EdgeGatewayStatusRealized = "REALIZED"
+	// EdgeGatewayStatusPending is the status of an edge gateway being created.
+	EdgeGatewayStatusPending = "PENDING"
+	// EdgeGatewayStatusConfiguring is the status of an edge gateway being updated.
+	EdgeGatewayStatusConfiguring = "CONFIGURING"
+	// EdgeGatewayStatusRealizationFailed is the status of an edge gateway that failed to be realized.
+	EdgeGatewayStatusRealizationFailed = "REALIZATION_FAILED"
+	// EdgeGatewayStatusUnknown is the status of an edge gateway whose state can't be determined.
+	EdgeGatewayStatusUnknown = "UNKNOWN"
+)
+
+// Error implements the error interface.
+func (e *EdgeGatewayRealizationError) Error() string {
+	return fmt.Sprintf("edge gateway %s (%s) is in %s status: %s", e.Name, e.ID, e.Status, errors.ErrEdgeGatewayRealizationFailed)
+}
+
+// Unwrap returns errors.ErrEdgeGatewayRealizationFailed.
+func (e *EdgeGatewayRealizationError) Unwrap() error {
+	return errors.ErrEdgeGatewayRealizationFailed
+}
+
 // getUUID returns the UUID of the edge gateway.
 // It is a specialized function to extract the UUID from the ID(URN) to call cloudavenue API.
 func (m *EdgeGatewayModel) getUUID() string {
@@ -99,3 +179,34 @@ func (m *EdgeGatewayModel) fromVCD(vcdEdgeGateway *govcdtypes.OpenAPIEdgeGateway
 		m.UplinkT0 = vcdEdgeGateway.EdgeGatewayUplinks[0].UplinkName
 	}
 }
+
+// toQueryParameters converts the options to the OpenAPI query parameters.
+// Only the owner and the name are filtered by the API. The values are not escaped,
+// the validation of the options rejects the FIQL reserved characters.
+func (o ListEdgeGatewayOptions) toQueryParameters() url.Values {
+	queryParameters := url.Values{}
+
+	pageSize := o.PageSize
+	if pageSize == 0 {
+		pageSize = defaultListEdgeGatewayPageSize
+	}
+	queryParameters.Set("pageSize", strconv.Itoa(pageSize))
+
+	filters := make([]string, 0, 2)
+	if o.OwnerNameOrID != "" {
+		if urn.IsVDC(o.OwnerNameOrID) || urn.IsVDCGroup(o.OwnerNameOrID) {
+			filters = append(filters, "ownerRef.id=="+o.OwnerNameOrID)
+		} else {
+			filters = append(filters, "ownerRef.name=="+o.OwnerNameOrID)
+		}
+	}
+	if o.NamePattern != "" {
+		filters = append(filters, "name=="+o.NamePattern)
+	}
+
+	if len(filters) > 0 {
+		queryParameters.Set("filter", strings.Join(filters, ";"))
+	}
+
+	return queryParameters
+}
@@ -0,0 +1,359 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/common-go/validators"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/utils"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/edgeloadbalancer"
+)
+
+const defaultExposeApplicationHealthTimeout = 10 * time.Minute
+
+// ExposeApplication publishes an application behind the load balancer of an edge gateway.
+// It runs the following steps in order and records the objects created in the returned handle:
+//   - upload the certificate in the certificate library of the organization (if any),
+//   - create the pool and the virtual service,
+//   - set the HTTP policies of the virtual service (if any),
+//   - create the IP sets of the virtual IP addresses and of the source networks,
+//   - create the application port profile of the service ports,
+//   - add a firewall rule allowing the source networks to reach the virtual IP addresses,
+//   - wait for at least one member of the pool to be UP.
+//
+// On failure, the objects already created are deleted in reverse order and the error is returned
+// with the handle. If the rollback fails as well, the handle lists the objects left behind and
+// TeardownApplication can be called again to delete them.
+func (c *client) ExposeApplication(ctx context.Context, spec ExposeApplicationModelRequest) (*ExposedApplicationModel, error) {
+	if err := validators.New().StructCtx(ctx, &spec); err != nil {
+		return nil, err
+	}
+
+	for _, sourceNetwork := range spec.SourceNetworks {
+		if !isIPOrCIDR(sourceNetwork) {
+			return nil, fmt.Errorf("source network %s has %w. Please provide an IP address or a CIDR", sourceNetwork, errors.ErrInvalidFormat)
+		}
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return nil, err
+	}
+
+	vcdEdgeGateway, err := c.getVCDEdgeGateway(ctx, spec.EdgeGatewayNameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	app := &ExposedApplicationModel{
+		EdgeGatewayID: vcdEdgeGateway.EdgeGateway.ID,
+	}
+
+	if err := c.exposeApplication(ctx, vcdEdgeGateway, spec, app); err != nil {
+		// The rollback must run even if the context has been canceled
+		if rollbackErr := c.TeardownApplication(context.WithoutCancel(ctx), app); rollbackErr != nil {
+			return app, fmt.Errorf("error exposing application %s: %w (rollback failed: %w)", spec.Name, err, rollbackErr)
+		}
+		return app, fmt.Errorf("error exposing application %s: %w", spec.Name, err)
+	}
+
+	return app, nil
+}
+
+// TeardownApplication deletes the objects created by ExposeApplication in reverse order.
+// The field of each object is cleared once the object is deleted, so the handle can be given
+// again to TeardownApplication after a failure. The objects already removed are ignored.
+func (c *client) TeardownApplication(ctx context.Context, app *ExposedApplicationModel) error {
+	if app == nil {
+		return fmt.Errorf("app is %w. Please provide the handle returned by ExposeApplication", errors.ErrEmpty)
+	}
+
+	if app.EdgeGatewayID == "" {
+		return fmt.Errorf("app.EdgeGatewayID is %w. Please provide the handle returned by ExposeApplication", errors.ErrEmpty)
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return err
+	}
+
+	vcdEdgeGateway, err := c.getVCDEdgeGateway(ctx, app.EdgeGatewayID)
+	if err != nil {
+		return err
+	}
+
+	// * Firewall rule
+	if app.FirewallRuleID != "" {
+		if err := c.DeleteFirewallRule(ctx, app.EdgeGatewayID, app.FirewallRuleID); err != nil && !isObjectNotFound(err) {
+			return err
+		}
+		app.FirewallRuleID = ""
+	}
+
+	// * Application port profile
+	if app.AppPortProfileID != "" {
+		if err := c.deleteExposedAppPortProfile(vcdEdgeGateway, app.AppPortProfileID); err != nil {
+			return err
+		}
+		app.AppPortProfileID = ""
+	}
+
+	// * IP sets
+	if app.SourceIPSetID != "" || app.VIPIPSetID != "" {
+		groups, err := listFirewallGroups(vcdEdgeGateway)
+		if err != nil {
+			return fmt.Errorf("error retrieving firewall groups: %w", err)
+		}
+
+		for _, id := range []*string{&app.SourceIPSetID, &app.VIPIPSetID} {
+			if *id == "" {
+				continue
+			}
+			for _, group := range groups {
+				if group.NsxtFirewallGroup.ID != *id {
+					continue
+				}
+				if err := deleteFirewallGroup(group); err != nil {
+					return fmt.Errorf("error deleting IP set %s: %w", *id, err)
+				}
+			}
+			*id = ""
+		}
+	}
+
+	// * Load balancer
+	// The HTTP policies are deleted with the virtual service
+	if app.VirtualServiceID != "" {
+		if err := c.clientLoadBalancer.DeleteVirtualService(ctx, app.VirtualServiceID); err != nil && !isObjectNotFound(err) {
+			return fmt.Errorf("error deleting virtual service %s: %w", app.VirtualServiceID, err)
+		}
+		app.VirtualServiceID = ""
+	}
+
+	if app.PoolID != "" {
+		if err := c.clientLoadBalancer.DeletePool(ctx, app.PoolID); err != nil && !isObjectNotFound(err) {
+			return fmt.Errorf("error deleting pool %s: %w", app.PoolID, err)
+		}
+		app.PoolID = ""
+	}
+
+	// * Certificate
+	if app.CertificateID != "" {
+		if err := c.clientOrg.DeleteCertificateFromLibrary(ctx, app.CertificateID); err != nil && !isObjectNotFound(err) {
+			return fmt.Errorf("error deleting certificate %s: %w", app.CertificateID, err)
+		}
+		app.CertificateID = ""
+	}
+
+	return nil
+}
+
+// * Local functions
+
+// isObjectNotFound returns true if the error reports an object that does not exist (anymore).
+// The errors returned by govcd do not wrap errors.ErrNotFound, they are checked with govcd.ContainsNotFound.
+func isObjectNotFound(err error) bool {
+	return errors.IsNotFound(err) || govcd.ContainsNotFound(err)
+}
+
+// exposeApplication creates the objects of the application and records them in app.
+func (c *client) exposeApplication(ctx context.Context, vcdEdgeGateway *govcd.NsxtEdgeGateway, spec ExposeApplicationModelRequest, app *ExposedApplicationModel) error {
+	// * Certificate
+	if spec.Certificate != nil {
+		certificate, err := c.clientOrg.CreateCertificateInLibrary(ctx, spec.Certificate)
+		if err != nil {
+			return fmt.Errorf("error uploading certificate %s: %w", spec.Certificate.Name, err)
+		}
+		app.CertificateID = certificate.ID
+		spec.VirtualService.CertificateID = utils.ToPTR(certificate.ID)
+	}
+
+	// * Pool
+	spec.Pool.GatewayRef = govcdtypes.OpenApiReference{ID: app.EdgeGatewayID}
+
+	pool, err := c.clientLoadBalancer.CreatePool(ctx, spec.Pool)
+	if err != nil {
+		return fmt.Errorf("error creating pool %s: %w", spec.Pool.Name, err)
+	}
+	app.PoolID = pool.ID
+
+	// * Virtual service
+	spec.VirtualService.EdgeGatewayID = app.EdgeGatewayID
+	spec.VirtualService.PoolID = pool.ID
+
+	virtualService, err := c.clientLoadBalancer.CreateVirtualService(ctx, spec.VirtualService)
+	if err != nil {
+		return fmt.Errorf("error creating virtual service %s: %w", spec.VirtualService.Name, err)
+	}
+	app.VirtualServiceID = virtualService.ID
+
+	// * HTTP policies
+	if len(spec.PoliciesHTTPRequest) > 0 {
+		if _, err := c.clientLoadBalancer.UpdatePoliciesHTTPRequest(ctx, &edgeloadbalancer.PoliciesHTTPRequestModel{VirtualServiceID: virtualService.ID, Policies: spec.PoliciesHTTPRequest}); err != nil {
+			return fmt.Errorf("error setting HTTP request policies: %w", err)
+		}
+	}
+
+	if len(spec.PoliciesHTTPResponse) > 0 {
+		if _, err := c.clientLoadBalancer.UpdatePoliciesHTTPResponse(ctx, &edgeloadbalancer.PoliciesHTTPResponseModel{VirtualServiceID: virtualService.ID, Policies: spec.PoliciesHTTPResponse}); err != nil {
+			return fmt.Errorf("error setting HTTP response policies: %w", err)
+		}
+	}
+
+	if len(spec.PoliciesHTTPSecurity) > 0 {
+		if _, err := c.clientLoadBalancer.UpdatePoliciesHTTPSecurity(ctx, &edgeloadbalancer.PoliciesHTTPSecurityModel{VirtualServiceID: virtualService.ID, Policies: spec.PoliciesHTTPSecurity}); err != nil {
+			return fmt.Errorf("error setting HTTP security policies: %w", err)
+		}
+	}
+
+	// * IP sets
+	description := "Application " + spec.Name + " exposed by the load balancer"
+	rule := FirewallRuleModelRequest{
+		Name:       spec.Name,
+		Enabled:    utils.ToPTR(true),
+		Action:     FirewallRuleActionAllow,
+		Direction:  FirewallRuleDirectionIn,
+		IPProtocol: FirewallRuleIPProtocolIPv4,
+	}
+
+	vips := []string{spec.VirtualService.VirtualIPAddress}
+	if spec.VirtualService.IPv6VirtualIPAddress != "" {
+		vips = append(vips, spec.VirtualService.IPv6VirtualIPAddress)
+		rule.IPProtocol = FirewallRuleIPProtocolIPv4IPv6
+	}
+
+	vipIPSet := SnapshotModelIPSet{Name: exposeApplicationVIPIPSetName(spec.Name), Description: description, IPAddresses: vips}
+	vipGroup, err := createFirewallGroup(vcdEdgeGateway, vipIPSet.toVCD(app.EdgeGatewayID))
+	if err != nil {
+		return fmt.Errorf("error creating IP set %s: %w", vipIPSet.Name, err)
+	}
+	app.VIPIPSetID = vipGroup.NsxtFirewallGroup.ID
+	rule.DestinationIPSets = []string{app.VIPIPSetID}
+
+	if len(spec.SourceNetworks) > 0 {
+		sourceIPSet := SnapshotModelIPSet{Name: exposeApplicationSourceIPSetName(spec.Name), Description: description, IPAddresses: spec.SourceNetworks}
+		sourceGroup, err := createFirewallGroup(vcdEdgeGateway, sourceIPSet.toVCD(app.EdgeGatewayID))
+		if err != nil {
+			return fmt.Errorf("error creating IP set %s: %w", sourceIPSet.Name, err)
+		}
+		app.SourceIPSetID = sourceGroup.NsxtFirewallGroup.ID
+		rule.SourceIPSets = []string{app.SourceIPSetID}
+	}
+
+	// * Application port profile
+	profile := SnapshotModelAppPortProfile{
+		Name:        spec.Name,
+		Description: description,
+		Ports:       []SnapshotModelAppPortProfilePort{exposeApplicationPorts(spec.VirtualService)},
+	}
+
+	vcdProfile, err := c.clientGoVCDOrg.CreateNsxtAppPortProfile(profile.toVCD(vcdEdgeGateway.EdgeGateway.Org, vcdEdgeGatewayOwnerID(vcdEdgeGateway)))
+	if err != nil {
+		return fmt.Errorf("error creating application port profile %s: %w", profile.Name, err)
+	}
+	app.AppPortProfileID = vcdProfile.NsxtAppPortProfile.ID
+	rule.ApplicationPortProfiles = []string{app.AppPortProfileID}
+
+	// * Firewall rule
+	firewallRule, err := c.AddFirewallRule(ctx, app.EdgeGatewayID, rule, FirewallRulePosition{})
+	if err != nil {
+		return err
+	}
+	app.FirewallRuleID = firewallRule.ID
+
+	// * Health
+	return c.waitForPoolHealth(ctx, app.EdgeGatewayID, app.PoolID, spec.HealthTimeout)
+}
+
+// waitForPoolHealth polls the pool until at least one of its members is UP.
+func (c *client) waitForPoolHealth(ctx context.Context, edgeGatewayID, poolID string, timeout time.Duration) error {
+	if timeout <= 0 {
+		timeout = defaultExposeApplicationHealthTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	pool := &edgeloadbalancer.PoolModel{}
+
+	err := utils.Poll(ctx, func() (bool, error) {
+		p, err := c.clientLoadBalancer.GetPool(ctx, edgeGatewayID, poolID)
+		if err != nil {
+			return false, fmt.Errorf("error retrieving pool %s: %w", poolID, err)
+		}
+
+		pool = p
+		return pool.UpMemberCount > 0, nil
+	})
+	if err != nil && ctx.Err() != nil {
+		return fmt.Errorf("timeout reached waiting for a member of pool %s to be UP, %d/%d members UP (%w)", pool.Name, pool.UpMemberCount, pool.EnabledMemberCount, ctx.Err())
+	}
+
+	return err
+}
+
+// deleteExposedAppPortProfile deletes the application port profile of an application by ID.
+func (c *client) deleteExposedAppPortProfile(vcdEdgeGateway *govcd.NsxtEdgeGateway, id string) error {
+	queryParams := url.Values{}
+	queryParams.Add("filter", "_context=="+vcdEdgeGatewayOwnerID(vcdEdgeGateway))
+
+	profiles, err := c.clientGoVCDOrg.GetAllNsxtAppPortProfiles(queryParams, govcdtypes.ApplicationPortProfileScopeTenant)
+	if err != nil {
+		return fmt.Errorf("error retrieving application port profiles: %w", err)
+	}
+
+	for _, profile := range profiles {
+		if profile.NsxtAppPortProfile.ID != id {
+			continue
+		}
+		if err := deleteAppPortProfile(profile); err != nil {
+			return fmt.Errorf("error deleting application port profile %s: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// exposeApplicationPorts returns the ports of the application port profile of the virtual service.
+func exposeApplicationPorts(vs edgeloadbalancer.VirtualServiceModelRequest) SnapshotModelAppPortProfilePort {
+	port := SnapshotModelAppPortProfilePort{Protocol: "TCP"}
+	if vs.ApplicationProfile == edgeloadbalancer.VirtualServiceApplicationProfileL4UDP {
+		port.Protocol = "UDP"
+	}
+
+	for _, servicePort := range vs.ServicePorts {
+		if servicePort.Start == nil {
+			continue
+		}
+		value := strconv.Itoa(*servicePort.Start)
+		if servicePort.End != nil && *servicePort.End != *servicePort.Start {
+			value += "-" + strconv.Itoa(*servicePort.End)
+		}
+		port.DestinationPorts = append(port.DestinationPorts, value)
+	}
+
+	return port
+}
+
+// vcdEdgeGatewayOwnerID returns the ID of the VDC or VDC Group owning the edge gateway.
+func vcdEdgeGatewayOwnerID(vcdEdgeGateway *govcd.NsxtEdgeGateway) string {
+	if vcdEdgeGateway.EdgeGateway.OwnerRef == nil {
+		return ""
+	}
+	return vcdEdgeGateway.EdgeGateway.OwnerRef.ID
+}
@@ -0,0 +1,87 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"time"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/edgeloadbalancer"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/org"
+)
+
+type (
+	// ExposeApplicationModelRequest describes an application published behind the load balancer of an edge gateway.
+	ExposeApplicationModelRequest struct {
+		// EdgeGatewayNameOrID is the name or the ID of the edge gateway publishing the application.
+		EdgeGatewayNameOrID string `validate:"required"`
+
+		// Name of the application. The IP sets, the application port profile and the firewall
+		// rule created for the application are named after it.
+		Name string `validate:"required"`
+
+		// Certificate is uploaded in the certificate library of the organization and used by
+		// the virtual service. Leave nil to serve plain traffic or to use VirtualService.CertificateID.
+		Certificate *org.CertificateCreateRequest
+
+		// Pool is created on the edge gateway. GatewayRef is set by ExposeApplication.
+		Pool edgeloadbalancer.PoolModelRequest `validate:"-"`
+
+		// VirtualService is created on the edge gateway. EdgeGatewayID, PoolID and CertificateID
+		// (when Certificate is set) are set by ExposeApplication.
+		VirtualService edgeloadbalancer.VirtualServiceModelRequest `validate:"-"`
+
+		// PoliciesHTTPRequest, PoliciesHTTPResponse and PoliciesHTTPSecurity are the optional
+		// HTTP policies of the virtual service.
+		PoliciesHTTPRequest  []*edgeloadbalancer.PoliciesHTTPRequestModelPolicy
+		PoliciesHTTPResponse []*edgeloadbalancer.PoliciesHTTPResponseModelPolicy
+		PoliciesHTTPSecurity []*edgeloadbalancer.PoliciesHTTPSecurityModelPolicy
+
+		// SourceNetworks is the list of IP addresses or CIDRs allowed to reach the virtual service.
+		// An empty list allows any source.
+		SourceNetworks []string `validate:"omitempty,dive,required"`
+
+		// HealthTimeout is the maximum time to wait for a member of the pool to be UP.
+		// Default is 10 minutes.
+		HealthTimeout time.Duration
+	}
+
+	// ExposedApplicationModel records the objects created by ExposeApplication.
+	// It is the handle given to TeardownApplication to delete the application.
+	// An empty field means the object has not been created (or has already been deleted).
+	// The objects are recorded by ID, so objects of the same name created by others are never deleted.
+	ExposedApplicationModel struct {
+		EdgeGatewayID string
+
+		// CertificateID is the ID of the certificate uploaded in the certificate library.
+		CertificateID string
+
+		PoolID           string
+		VirtualServiceID string
+
+		// VIPIPSetID is the ID of the IP set containing the virtual IP addresses.
+		VIPIPSetID string
+
+		// SourceIPSetID is the ID of the IP set containing the source networks.
+		SourceIPSetID string
+
+		AppPortProfileID string
+		FirewallRuleID   string
+	}
+)
+
+// exposeApplicationVIPIPSetName returns the name of the IP set of the virtual IP addresses of the application.
+func exposeApplicationVIPIPSetName(name string) string {
+	return name + "-vip"
+}
+
+// exposeApplicationSourceIPSetName returns the name of the IP set of the source networks of the application.
+func exposeApplicationSourceIPSetName(name string) string {
+	return name + "-sources"
+}
@@ -0,0 +1,610 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/orange-cloudavenue/common-go/validators"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
+)
+
+// firewallRulesEndpoint is the endpoint of the firewall rules, %s contains the ID of the edge gateway.
+const firewallRulesEndpoint = govcdtypes.OpenApiPathVersion1_0_0 + govcdtypes.OpenApiEndpointNsxtFirewallRules
+
+// The firewall rules are read and written with the extended container since govcd
+// does not support the network context profiles of the rules.
+var (
+	getFirewall = func(vcdClient clientGoVCD, edgeGatewayID string) (*v1.NsxtFirewallRuleContainerExtended, error) {
+		firewall := &v1.NsxtFirewallRuleContainerExtended{}
+		if err := vcdClient.OpenAPIGetItem(fmt.Sprintf(firewallRulesEndpoint, edgeGatewayID), nil, firewall); err != nil {
+			return nil, err
+		}
+		return firewall, nil
+	}
+
+	updateFirewall = func(vcdClient clientGoVCD, edgeGatewayID string, rules []*v1.NsxtFirewallRuleExtended) (*v1.NsxtFirewallRuleContainerExtended, error) {
+		firewall := &v1.NsxtFirewallRuleContainerExtended{}
+		if err := vcdClient.OpenAPIPutItem(fmt.Sprintf(firewallRulesEndpoint, edgeGatewayID), &v1.NsxtFirewallRuleContainerExtended{UserDefinedRules: rules}, firewall); err != nil {
+			return nil, err
+		}
+		return firewall, nil
+	}
+
+	deleteFirewall = func(edgeClient fakeFirewallEdgeGatewayClient) error {
+		firewall, err := edgeClient.GetNsxtFirewall()
+		if err != nil {
+			return err
+		}
+		return firewall.DeleteAllRules()
+	}
+
+	listFirewallGroups = func(edgeClient fakeFirewallEdgeGatewayClient) ([]*govcd.NsxtFirewallGroup, error) {
+		return edgeClient.GetAllNsxtFirewallGroups(nil, "")
+	}
+)
+
+// GetFirewall retrieves the user-defined firewall rules of an edge gateway.
+func (c *client) GetFirewall(ctx context.Context, edgeGatewayNameOrID string) (*FirewallModel, error) {
+	if err := c.edgeGatewayNameOrIDValidation(edgeGatewayNameOrID); err != nil {
+		return nil, err
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return nil, err
+	}
+
+	edgeGateway, err := c.getVCDEdgeGateway(ctx, edgeGatewayNameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	firewall, err := getFirewall(c.clientGoVCD, edgeGateway.EdgeGateway.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving firewall of edge gateway %s: %w", edgeGatewayNameOrID, err)
+	}
+
+	fm := &FirewallModel{}
+	fm.fromVCD(firewall.UserDefinedRules)
+
+	return fm, nil
+}
+
+// GetFirewallRule retrieves a firewall rule of an edge gateway by name or ID.
+func (c *client) GetFirewallRule(ctx context.Context, edgeGatewayNameOrID, ruleNameOrID string) (*FirewallRuleModel, error) {
+	if ruleNameOrID == "" {
+		return nil, fmt.Errorf("ruleNameOrID is %w. Please provide a valid ruleNameOrID", errors.ErrEmpty)
+	}
+
+	firewall, err := c.GetFirewall(ctx, edgeGatewayNameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range firewall.Rules {
+		if rule.ID == ruleNameOrID || rule.Name == ruleNameOrID {
+			return rule, nil
+		}
+	}
+
+	return nil, fmt.Errorf("firewall rule %s %w", ruleNameOrID, errors.ErrNotFound)
+}
+
+// UpdateFirewall replaces all the user-defined firewall rules of an edge gateway.
+// The rules are applied in the given order.
+func (c *client) UpdateFirewall(ctx context.Context, edgeGatewayNameOrID string, rules []FirewallRuleModelRequest) (*FirewallModel, error) {
+	for i := range rules {
+		if err := validators.New().StructCtx(ctx, &rules[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.modifyFirewallRules(ctx, edgeGatewayNameOrID, func(_ []*v1.NsxtFirewallRuleExtended, resolver *firewallReferenceResolver) ([]*v1.NsxtFirewallRuleExtended, error) {
+		newRules := make([]*v1.NsxtFirewallRuleExtended, 0, len(rules))
+		for _, rule := range rules {
+			vcdRule, err := resolver.toVCD(rule)
+			if err != nil {
+				return nil, err
+			}
+			newRules = append(newRules, vcdRule)
+		}
+		return newRules, nil
+	})
+}
+
+// DeleteFirewall deletes all the user-defined firewall rules of an edge gateway.
+func (c *client) DeleteFirewall(ctx context.Context, edgeGatewayNameOrID string) error {
+	if err := c.edgeGatewayNameOrIDValidation(edgeGatewayNameOrID); err != nil {
+		return err
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return err
+	}
+
+	edgeGateway, err := c.getVCDEdgeGateway(ctx, edgeGatewayNameOrID)
+	if err != nil {
+		return err
+	}
+
+	if err := deleteFirewall(edgeGateway); err != nil {
+		return fmt.Errorf("error deleting firewall of edge gateway %s: %w", edgeGatewayNameOrID, err)
+	}
+
+	return nil
+}
+
+// AddFirewallRule adds a firewall rule to an edge gateway at the given position.
+// The other rules are left untouched. If the rules are modified concurrently,
+// errors.ErrEdgeGatewayFirewallConflict is returned and nothing is applied.
+func (c *client) AddFirewallRule(ctx context.Context, edgeGatewayNameOrID string, rule FirewallRuleModelRequest, position FirewallRulePosition) (*FirewallRuleModel, error) {
+	if err := validators.New().StructCtx(ctx, &rule); err != nil {
+		return nil, err
+	}
+
+	if err := validators.New().StructCtx(ctx, &position); err != nil {
+		return nil, err
+	}
+
+	firewall, err := c.modifyFirewallRules(ctx, edgeGatewayNameOrID, func(rules []*v1.NsxtFirewallRuleExtended, resolver *firewallReferenceResolver) ([]*v1.NsxtFirewallRuleExtended, error) {
+		vcdRule, err := resolver.toVCD(rule)
+		if err != nil {
+			return nil, err
+		}
+
+		return insertFirewallRule(rules, vcdRule, position)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return findAddedFirewallRule(firewall, rule.Name)
+}
+
+// UpdateFirewallRule updates a firewall rule of an edge gateway. Its position is kept.
+// If the rules are modified concurrently, errors.ErrEdgeGatewayFirewallConflict is returned and nothing is applied.
+func (c *client) UpdateFirewallRule(ctx context.Context, edgeGatewayNameOrID, ruleNameOrID string, rule FirewallRuleModelRequest) (*FirewallRuleModel, error) {
+	if ruleNameOrID == "" {
+		return nil, fmt.Errorf("ruleNameOrID is %w. Please provide a valid ruleNameOrID", errors.ErrEmpty)
+	}
+
+	if err := validators.New().StructCtx(ctx, &rule); err != nil {
+		return nil, err
+	}
+
+	var ruleID string
+
+	firewall, err := c.modifyFirewallRules(ctx, edgeGatewayNameOrID, func(rules []*v1.NsxtFirewallRuleExtended, resolver *firewallReferenceResolver) ([]*v1.NsxtFirewallRuleExtended, error) {
+		index, err := findFirewallRuleIndex(rules, ruleNameOrID)
+		if err != nil {
+			return nil, err
+		}
+
+		vcdRule, err := resolver.toVCD(rule)
+		if err != nil {
+			return nil, err
+		}
+
+		// Keep the ID and the version to update the existing rule
+		vcdRule.ID = rules[index].ID
+		vcdRule.Version = rules[index].Version
+		ruleID = vcdRule.ID
+
+		rules[index] = vcdRule
+		return rules, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range firewall.Rules {
+		if r.ID == ruleID {
+			return r, nil
+		}
+	}
+
+	return nil, fmt.Errorf("firewall rule %s %w after update", ruleNameOrID, errors.ErrNotFound)
+}
+
+// DeleteFirewallRule deletes a firewall rule of an edge gateway.
+// If the rules are modified concurrently, errors.ErrEdgeGatewayFirewallConflict is returned and nothing is applied.
+func (c *client) DeleteFirewallRule(ctx context.Context, edgeGatewayNameOrID, ruleNameOrID string) error {
+	if ruleNameOrID == "" {
+		return fmt.Errorf("ruleNameOrID is %w. Please provide a valid ruleNameOrID", errors.ErrEmpty)
+	}
+
+	_, err := c.modifyFirewallRules(ctx, edgeGatewayNameOrID, func(rules []*v1.NsxtFirewallRuleExtended, _ *firewallReferenceResolver) ([]*v1.NsxtFirewallRuleExtended, error) {
+		index, err := findFirewallRuleIndex(rules, ruleNameOrID)
+		if err != nil {
+			return nil, err
+		}
+
+		return slices.Delete(rules, index, index+1), nil
+	})
+
+	return err
+}
+
+// MoveFirewallRule moves a firewall rule of an edge gateway before or after another rule.
+// If the rules are modified concurrently, errors.ErrEdgeGatewayFirewallConflict is returned and nothing is applied.
+func (c *client) MoveFirewallRule(ctx context.Context, edgeGatewayNameOrID, ruleNameOrID string, position FirewallRulePosition) error {
+	if ruleNameOrID == "" {
+		return fmt.Errorf("ruleNameOrID is %w. Please provide a valid ruleNameOrID", errors.ErrEmpty)
+	}
+
+	if position.Before == "" && position.After == "" {
+		return fmt.Errorf("position is %w. Please provide the rule to move before or after", errors.ErrEmpty)
+	}
+
+	if err := validators.New().StructCtx(ctx, &position); err != nil {
+		return err
+	}
+
+	_, err := c.modifyFirewallRules(ctx, edgeGatewayNameOrID, func(rules []*v1.NsxtFirewallRuleExtended, _ *firewallReferenceResolver) ([]*v1.NsxtFirewallRuleExtended, error) {
+		index, err := findFirewallRuleIndex(rules, ruleNameOrID)
+		if err != nil {
+			return nil, err
+		}
+
+		rule := rules[index]
+		return insertFirewallRule(slices.Delete(rules, index, index+1), rule, position)
+	})
+
+	return err
+}
+
+// * Local functions
+
+// modifyFirewallRules applies a read-modify-write cycle on the user-defined firewall rules.
+// The rules are read again before being written, and errors.ErrEdgeGatewayFirewallConflict
+// is returned if they have been modified in the meantime. The rules carry their version
+// so the API also rejects a rule modified between the check and the write.
+func (c *client) modifyFirewallRules(ctx context.Context, edgeGatewayNameOrID string, modify func(rules []*v1.NsxtFirewallRuleExtended, resolver *firewallReferenceResolver) ([]*v1.NsxtFirewallRuleExtended, error)) (*FirewallModel, error) {
+	if err := c.edgeGatewayNameOrIDValidation(edgeGatewayNameOrID); err != nil {
+		return nil, err
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return nil, err
+	}
+
+	edgeGateway, err := c.getVCDEdgeGateway(ctx, edgeGatewayNameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	firewall, err := getFirewall(c.clientGoVCD, edgeGateway.EdgeGateway.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving firewall of edge gateway %s: %w", edgeGatewayNameOrID, err)
+	}
+
+	rules := slices.Clone(firewall.UserDefinedRules)
+	signature := firewallRulesSignature(rules)
+
+	resolver := &firewallReferenceResolver{
+		edgeClient: edgeGateway,
+		orgClient:  c.clientGoVCDOrg,
+		vcdClient:  c.clientGoVCD,
+	}
+	if edgeGateway.EdgeGateway != nil && edgeGateway.EdgeGateway.OwnerRef != nil {
+		resolver.ownerID = edgeGateway.EdgeGateway.OwnerRef.ID
+	}
+
+	newRules, err := modify(rules, resolver)
+	if err != nil {
+		return nil, err
+	}
+
+	// Detect a concurrent modification before writing
+	current, err := getFirewall(c.clientGoVCD, edgeGateway.EdgeGateway.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving firewall of edge gateway %s: %w", edgeGatewayNameOrID, err)
+	}
+
+	if firewallRulesSignature(current.UserDefinedRules) != signature {
+		return nil, errors.ErrEdgeGatewayFirewallConflict
+	}
+
+	firewallUpdated, err := updateFirewall(c.clientGoVCD, edgeGateway.EdgeGateway.ID, newRules)
+	if err != nil {
+		return nil, fmt.Errorf("error updating firewall of edge gateway %s: %w", edgeGatewayNameOrID, err)
+	}
+
+	fm := &FirewallModel{}
+	fm.fromVCD(firewallUpdated.UserDefinedRules)
+
+	return fm, nil
+}
+
+// firewallRulesSignature returns a string identifying the ordered rules and their versions.
+func firewallRulesSignature(rules []*v1.NsxtFirewallRuleExtended) string {
+	var sb strings.Builder
+	for _, rule := range rules {
+		version := 0
+		if rule.Version != nil && rule.Version.Version != nil {
+			version = *rule.Version.Version
+		}
+		fmt.Fprintf(&sb, "%s:%d;", rule.ID, version)
+	}
+	return sb.String()
+}
+
+// findFirewallRuleIndex returns the index of the rule matching the name or the ID.
+// A name matching several rules is rejected since the API does not enforce unique names.
+func findFirewallRuleIndex(rules []*v1.NsxtFirewallRuleExtended, ruleNameOrID string) (int, error) {
+	if urn.IsUUIDV4(ruleNameOrID) {
+		for i, rule := range rules {
+			if rule.ID == ruleNameOrID {
+				return i, nil
+			}
+		}
+	}
+
+	index := -1
+	for i, rule := range rules {
+		if rule.Name != ruleNameOrID {
+			continue
+		}
+		if index != -1 {
+			return -1, fmt.Errorf("firewall rule name %s is used by several rules. Please use the rule ID", ruleNameOrID)
+		}
+		index = i
+	}
+
+	if index == -1 {
+		return -1, fmt.Errorf("firewall rule %s %w", ruleNameOrID, errors.ErrNotFound)
+	}
+
+	return index, nil
+}
+
+// insertFirewallRule inserts the rule at the given position.
+func insertFirewallRule(rules []*v1.NsxtFirewallRuleExtended, rule *v1.NsxtFirewallRuleExtended, position FirewallRulePosition) ([]*v1.NsxtFirewallRuleExtended, error) {
+	switch {
+	case position.Before != "":
+		index, err := findFirewallRuleIndex(rules, position.Before)
+		if err != nil {
+			return nil, err
+		}
+		return slices.Insert(rules, index, rule), nil
+	case position.After != "":
+		index, err := findFirewallRuleIndex(rules, position.After)
+		if err != nil {
+			return nil, err
+		}
+		return slices.Insert(rules, index+1, rule), nil
+	default:
+		return append(rules, rule), nil
+	}
+}
+
+// findAddedFirewallRule returns the rule created by AddFirewallRule.
+// The API does not return the ID of the new rule, so it is found by its name.
+// If several rules share the name, the last one is returned.
+func findAddedFirewallRule(firewall *FirewallModel, ruleName string) (*FirewallRuleModel, error) {
+	for i := len(firewall.Rules) - 1; i >= 0; i-- {
+		if firewall.Rules[i].Name == ruleName {
+			return firewall.Rules[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("firewall rule %s %w after creation", ruleName, errors.ErrNotFound)
+}
+
+// firewallReferenceResolver resolves the names or IDs of the objects referenced by a firewall rule.
+// The objects are retrieved once, on first use.
+type firewallReferenceResolver struct {
+	edgeClient fakeFirewallEdgeGatewayClient
+	orgClient  clientGoVCDOrg
+	vcdClient  clientGoVCD
+	ownerID    string
+
+	firewallGroups            []*govcd.NsxtFirewallGroup
+	appPortProfiles           []*govcd.NsxtAppPortProfile
+	appPortProfilesSet        bool
+	networkContextProfiles    []*govcdtypes.NsxtNetworkContextProfile
+	networkContextProfilesSet bool
+}
+
+// firewallProfileScopePriority orders the scopes of the application port profiles
+// and of the network context profiles sharing a name.
+var firewallProfileScopePriority = map[string]int{
+	govcdtypes.ApplicationPortProfileScopeTenant:   0,
+	govcdtypes.ApplicationPortProfileScopeProvider: 1,
+	govcdtypes.ApplicationPortProfileScopeSystem:   2,
+}
+
+// toVCD converts a rule request to the VCD format, resolving all the references.
+func (r *firewallReferenceResolver) toVCD(rule FirewallRuleModelRequest) (*v1.NsxtFirewallRuleExtended, error) {
+	sourceIPSets, err := r.resolveFirewallGroups(rule.SourceIPSets, govcdtypes.FirewallGroupTypeIpSet)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceSecurityGroups, err := r.resolveFirewallGroups(rule.SourceSecurityGroups, govcdtypes.FirewallGroupTypeSecurityGroup, govcdtypes.FirewallGroupTypeVmCriteria)
+	if err != nil {
+		return nil, err
+	}
+
+	destinationIPSets, err := r.resolveFirewallGroups(rule.DestinationIPSets, govcdtypes.FirewallGroupTypeIpSet)
+	if err != nil {
+		return nil, err
+	}
+
+	destinationSecurityGroups, err := r.resolveFirewallGroups(rule.DestinationSecurityGroups, govcdtypes.FirewallGroupTypeSecurityGroup, govcdtypes.FirewallGroupTypeVmCriteria)
+	if err != nil {
+		return nil, err
+	}
+
+	appPortProfiles, err := r.resolveAppPortProfiles(rule.ApplicationPortProfiles)
+	if err != nil {
+		return nil, err
+	}
+
+	networkContextProfiles, err := r.resolveNetworkContextProfiles(rule.NetworkContextProfiles)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.NsxtFirewallRuleExtended{
+		Name:                      rule.Name,
+		ActionValue:               string(rule.Action),
+		Enabled:                   *rule.Enabled,
+		SourceFirewallGroups:      append(sourceIPSets, sourceSecurityGroups...),
+		DestinationFirewallGroups: append(destinationIPSets, destinationSecurityGroups...),
+		ApplicationPortProfiles:   appPortProfiles,
+		NetworkContextProfiles:    networkContextProfiles,
+		IPProtocol:                string(rule.IPProtocol),
+		Logging:                   rule.Logging,
+		Direction:                 string(rule.Direction),
+	}, nil
+}
+
+// resolveFirewallGroups resolves names or IDs of firewall groups of the given types.
+func (r *firewallReferenceResolver) resolveFirewallGroups(namesOrIDs []string, groupTypes ...string) ([]govcdtypes.OpenApiReference, error) {
+	if len(namesOrIDs) == 0 {
+		return nil, nil
+	}
+
+	if r.firewallGroups == nil {
+		groups, err := listFirewallGroups(r.edgeClient)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving firewall groups: %w", err)
+		}
+		r.firewallGroups = groups
+	}
+
+	refs := make([]govcdtypes.OpenApiReference, 0, len(namesOrIDs))
+	for _, nameOrID := range namesOrIDs {
+		idx := slices.IndexFunc(r.firewallGroups, func(g *govcd.NsxtFirewallGroup) bool {
+			return (g.NsxtFirewallGroup.ID == nameOrID || g.NsxtFirewallGroup.Name == nameOrID) &&
+				slices.Contains(groupTypes, firewallGroupType(g.NsxtFirewallGroup))
+		})
+		if idx == -1 {
+			return nil, fmt.Errorf("%s %s %w", strings.ToLower(strings.ReplaceAll(groupTypes[0], "_", " ")), nameOrID, errors.ErrNotFound)
+		}
+
+		refs = append(refs, govcdtypes.OpenApiReference{
+			ID:   r.firewallGroups[idx].NsxtFirewallGroup.ID,
+			Name: r.firewallGroups[idx].NsxtFirewallGroup.Name,
+		})
+	}
+
+	return refs, nil
+}
+
+// resolveAppPortProfiles resolves names or IDs of application port profiles available for the edge gateway.
+// When a name exists in several scopes, the TENANT profile takes precedence over the PROVIDER and SYSTEM ones.
+func (r *firewallReferenceResolver) resolveAppPortProfiles(namesOrIDs []string) ([]govcdtypes.OpenApiReference, error) {
+	if len(namesOrIDs) == 0 {
+		return nil, nil
+	}
+
+	if !r.appPortProfilesSet {
+		queryParams := url.Values{}
+		if r.ownerID != "" {
+			queryParams.Add("filter", "_context=="+r.ownerID)
+		}
+
+		profiles, err := r.orgClient.GetAllNsxtAppPortProfiles(queryParams, "")
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving application port profiles: %w", err)
+		}
+		r.appPortProfiles = profiles
+		r.appPortProfilesSet = true
+	}
+
+	refs := make([]govcdtypes.OpenApiReference, 0, len(namesOrIDs))
+	for _, nameOrID := range namesOrIDs {
+		var found *govcdtypes.NsxtAppPortProfile
+		for _, profile := range r.appPortProfiles {
+			p := profile.NsxtAppPortProfile
+			if p.ID != nameOrID && p.Name != nameOrID {
+				continue
+			}
+			if found == nil || firewallProfileScopePriority[p.Scope] < firewallProfileScopePriority[found.Scope] {
+				found = p
+			}
+		}
+
+		if found == nil {
+			return nil, fmt.Errorf("application port profile %s %w", nameOrID, errors.ErrNotFound)
+		}
+
+		refs = append(refs, govcdtypes.OpenApiReference{ID: found.ID, Name: found.Name})
+	}
+
+	return refs, nil
+}
+
+// resolveNetworkContextProfiles resolves names or IDs of network context profiles available for the edge gateway.
+// When a name exists in several scopes, the TENANT profile takes precedence over the PROVIDER and SYSTEM ones.
+func (r *firewallReferenceResolver) resolveNetworkContextProfiles(namesOrIDs []string) ([]govcdtypes.OpenApiReference, error) {
+	if len(namesOrIDs) == 0 {
+		return nil, nil
+	}
+
+	if !r.networkContextProfilesSet {
+		queryParams := url.Values{}
+		switch {
+		case urn.IsVDCGroup(r.ownerID):
+			queryParams.Add("filter", "vdcGroupId=="+r.ownerID)
+		case r.ownerID != "":
+			queryParams.Add("filter", "orgVdcId=="+r.ownerID)
+		}
+
+		profiles, err := r.vcdClient.GetAllNetworkContextProfiles(queryParams)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving network context profiles: %w", err)
+		}
+		r.networkContextProfiles = profiles
+		r.networkContextProfilesSet = true
+	}
+
+	refs := make([]govcdtypes.OpenApiReference, 0, len(namesOrIDs))
+	for _, nameOrID := range namesOrIDs {
+		var found *govcdtypes.NsxtNetworkContextProfile
+		for _, p := range r.networkContextProfiles {
+			if p.ID != nameOrID && p.Name != nameOrID {
+				continue
+			}
+			if found == nil || firewallProfileScopePriority[p.Scope] < firewallProfileScopePriority[found.Scope] {
+				found = p
+			}
+		}
+
+		if found == nil {
+			return nil, fmt.Errorf("network context profile %s %w", nameOrID, errors.ErrNotFound)
+		}
+
+		refs = append(refs, govcdtypes.OpenApiReference{ID: found.ID, Name: found.Name})
+	}
+
+	return refs, nil
+}
+
+// firewallGroupType returns the type of a firewall group.
+// TypeValue replaces the deprecated Type field.
+func firewallGroupType(group *govcdtypes.NsxtFirewallGroup) string {
+	if group.TypeValue != "" {
+		return group.TypeValue
+	}
+	return group.Type
+}
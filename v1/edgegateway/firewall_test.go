@@ -0,0 +1,666 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/utils"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
+)
+
+const (
+	testFirewallIPSetName         = "test-ip-set"
+	testFirewallSecurityGroupName = "test-security-group"
+	testFirewallAppPortProfile    = "HTTPS"
+)
+
+// testFirewallRules returns three rules named rule-1, rule-2 and rule-3.
+func testFirewallRules() []*v1.NsxtFirewallRuleExtended {
+	rules := make([]*v1.NsxtFirewallRuleExtended, 0, 3)
+	for i := 1; i <= 3; i++ {
+		rules = append(rules, &v1.NsxtFirewallRuleExtended{
+			ID:          uuid.New().String(),
+			Name:        fmt.Sprintf("rule-%d", i),
+			ActionValue: string(FirewallRuleActionAllow),
+			Enabled:     true,
+			IPProtocol:  string(FirewallRuleIPProtocolIPv4),
+			Direction:   string(FirewallRuleDirectionInOut),
+			Version:     &v1.NsxtFirewallRuleExtendedVersion{Version: utils.ToPTR(i)},
+		})
+	}
+	return rules
+}
+
+func testFirewallRuleRequest(name string) FirewallRuleModelRequest {
+	return FirewallRuleModelRequest{
+		Name:                      name,
+		Enabled:                   utils.ToPTR(true),
+		Action:                    FirewallRuleActionDrop,
+		Direction:                 FirewallRuleDirectionIn,
+		IPProtocol:                FirewallRuleIPProtocolIPv4,
+		SourceIPSets:              []string{testFirewallIPSetName},
+		DestinationSecurityGroups: []string{testFirewallSecurityGroupName},
+		ApplicationPortProfiles:   []string{testFirewallAppPortProfile},
+	}
+}
+
+// testFirewallState mocks the firewall of an edge gateway.
+// It returns the rules written by the last update.
+type testFirewallState struct {
+	rules   []*v1.NsxtFirewallRuleExtended
+	updated []*v1.NsxtFirewallRuleExtended
+}
+
+func (s *testFirewallState) mock(ipSetID, securityGroupID, appPortProfileID string) {
+	getFirewall = func(_ clientGoVCD, _ string) (*v1.NsxtFirewallRuleContainerExtended, error) {
+		return &v1.NsxtFirewallRuleContainerExtended{UserDefinedRules: s.rules}, nil
+	}
+	updateFirewall = func(_ clientGoVCD, _ string, rules []*v1.NsxtFirewallRuleExtended) (*v1.NsxtFirewallRuleContainerExtended, error) {
+		s.updated = rules
+		for _, rule := range rules {
+			if rule.ID == "" {
+				rule.ID = uuid.New().String()
+			}
+		}
+		return &v1.NsxtFirewallRuleContainerExtended{UserDefinedRules: rules}, nil
+	}
+	listFirewallGroups = func(_ fakeFirewallEdgeGatewayClient) ([]*govcd.NsxtFirewallGroup, error) {
+		return []*govcd.NsxtFirewallGroup{
+			{NsxtFirewallGroup: &govcdtypes.NsxtFirewallGroup{ID: ipSetID, Name: testFirewallIPSetName, TypeValue: govcdtypes.FirewallGroupTypeIpSet}},
+			{NsxtFirewallGroup: &govcdtypes.NsxtFirewallGroup{ID: securityGroupID, Name: testFirewallSecurityGroupName, TypeValue: govcdtypes.FirewallGroupTypeVmCriteria}},
+		}, nil
+	}
+}
+
+func (s *testFirewallState) updatedNames() []string {
+	names := make([]string, 0, len(s.updated))
+	for _, rule := range s.updated {
+		names = append(names, rule.Name)
+	}
+	return names
+}
+
+func testAppPortProfiles(tenantID string) []*govcd.NsxtAppPortProfile {
+	return []*govcd.NsxtAppPortProfile{
+		{NsxtAppPortProfile: &govcdtypes.NsxtAppPortProfile{ID: urn.AppPortProfile.String() + uuid.New().String(), Name: testFirewallAppPortProfile, Scope: govcdtypes.ApplicationPortProfileScopeSystem}},
+		{NsxtAppPortProfile: &govcdtypes.NsxtAppPortProfile{ID: tenantID, Name: testFirewallAppPortProfile, Scope: govcdtypes.ApplicationPortProfileScopeTenant}},
+	}
+}
+
+func TestClient_GetFirewall(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+
+	tests := []struct {
+		name          string
+		mockFunc      func()
+		expectedNames []string
+		expectedError bool
+		err           error
+	}{
+		{
+			name: testSuccess,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				state := &testFirewallState{rules: testFirewallRules()}
+				state.mock("", "", "")
+			},
+			expectedNames: []string{"rule-1", "rule-2", "rule-3"},
+		},
+		{
+			name: "error-get-firewall",
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getFirewall = func(_ clientGoVCD, _ string) (*v1.NsxtFirewallRuleContainerExtended, error) {
+					return nil, fmt.Errorf("error")
+				}
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error retrieving firewall"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mockFunc()
+
+			firewall, err := c.GetFirewall(context.Background(), edgeGatewayID)
+			if !test.expectedError {
+				assert.NoError(t, err)
+				names := make([]string, 0, len(firewall.Rules))
+				for _, rule := range firewall.Rules {
+					names = append(names, rule.Name)
+					assert.Equal(t, FirewallRuleActionAllow, rule.Action)
+				}
+				assert.Equal(t, test.expectedNames, names)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err.Error())
+		})
+	}
+}
+
+func TestClient_AddFirewallRule(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+	ipSetID := urn.SecurityGroup.String() + uuid.New().String()
+	securityGroupID := urn.SecurityGroup.String() + uuid.New().String()
+	appPortProfileID := urn.AppPortProfile.String() + uuid.New().String()
+
+	var state *testFirewallState
+
+	tests := []struct {
+		name          string
+		rule          FirewallRuleModelRequest
+		position      FirewallRulePosition
+		mockFunc      func()
+		expectedNames []string
+		expectedError bool
+		err           error
+	}{
+		{
+			name: "success-append",
+			rule: testFirewallRuleRequest("new-rule"),
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				clientCAV.EXPECT().GetAllNsxtAppPortProfiles(gomock.Any(), "").Return(testAppPortProfiles(appPortProfileID), nil)
+				state = &testFirewallState{rules: testFirewallRules()}
+				state.mock(ipSetID, securityGroupID, appPortProfileID)
+			},
+			expectedNames: []string{"rule-1", "rule-2", "rule-3", "new-rule"},
+		},
+		{
+			name:     "success-before",
+			rule:     testFirewallRuleRequest("new-rule"),
+			position: FirewallRulePosition{Before: "rule-1"},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				clientCAV.EXPECT().GetAllNsxtAppPortProfiles(gomock.Any(), "").Return(testAppPortProfiles(appPortProfileID), nil)
+				state = &testFirewallState{rules: testFirewallRules()}
+				state.mock(ipSetID, securityGroupID, appPortProfileID)
+			},
+			expectedNames: []string{"new-rule", "rule-1", "rule-2", "rule-3"},
+		},
+		{
+			name:     "success-after",
+			rule:     testFirewallRuleRequest("new-rule"),
+			position: FirewallRulePosition{After: "rule-2"},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				clientCAV.EXPECT().GetAllNsxtAppPortProfiles(gomock.Any(), "").Return(testAppPortProfiles(appPortProfileID), nil)
+				state = &testFirewallState{rules: testFirewallRules()}
+				state.mock(ipSetID, securityGroupID, appPortProfileID)
+			},
+			expectedNames: []string{"rule-1", "rule-2", "new-rule", "rule-3"},
+		},
+		{
+			name:          "error-both-before-and-after",
+			rule:          testFirewallRuleRequest("new-rule"),
+			position:      FirewallRulePosition{Before: "rule-1", After: "rule-2"},
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           fmt.Errorf("Before"),
+		},
+		{
+			name: "error-ip-set-not-found",
+			rule: func() FirewallRuleModelRequest {
+				r := testFirewallRuleRequest("new-rule")
+				r.SourceIPSets = []string{"unknown"}
+				return r
+			}(),
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				state = &testFirewallState{rules: testFirewallRules()}
+				state.mock(ipSetID, securityGroupID, appPortProfileID)
+			},
+			expectedError: true,
+			err:           fmt.Errorf("ip set unknown not found"),
+		},
+		{
+			name: "error-security-group-referenced-as-ip-set",
+			rule: func() FirewallRuleModelRequest {
+				r := testFirewallRuleRequest("new-rule")
+				r.SourceIPSets = []string{testFirewallSecurityGroupName}
+				return r
+			}(),
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				state = &testFirewallState{rules: testFirewallRules()}
+				state.mock(ipSetID, securityGroupID, appPortProfileID)
+			},
+			expectedError: true,
+			err:           fmt.Errorf("ip set %s not found", testFirewallSecurityGroupName),
+		},
+		{
+			name:     "error-reference-rule-not-found",
+			rule:     testFirewallRuleRequest("new-rule"),
+			position: FirewallRulePosition{After: "unknown"},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				clientCAV.EXPECT().GetAllNsxtAppPortProfiles(gomock.Any(), "").Return(testAppPortProfiles(appPortProfileID), nil)
+				state = &testFirewallState{rules: testFirewallRules()}
+				state.mock(ipSetID, securityGroupID, appPortProfileID)
+			},
+			expectedError: true,
+			err:           fmt.Errorf("firewall rule unknown not found"),
+		},
+		{
+			name: "error-conflict",
+			rule: testFirewallRuleRequest("new-rule"),
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				clientCAV.EXPECT().GetAllNsxtAppPortProfiles(gomock.Any(), "").Return(testAppPortProfiles(appPortProfileID), nil)
+				state = &testFirewallState{rules: testFirewallRules()}
+				state.mock(ipSetID, securityGroupID, appPortProfileID)
+
+				// The rules change between the read and the write
+				reads := 0
+				getFirewall = func(_ clientGoVCD, _ string) (*v1.NsxtFirewallRuleContainerExtended, error) {
+					reads++
+					if reads > 1 {
+						state.rules = state.rules[1:]
+					}
+					return &v1.NsxtFirewallRuleContainerExtended{UserDefinedRules: state.rules}, nil
+				}
+			},
+			expectedError: true,
+			err:           errors.ErrEdgeGatewayFirewallConflict,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mockFunc()
+
+			rule, err := c.AddFirewallRule(context.Background(), edgeGatewayID, test.rule, test.position)
+			if !test.expectedError {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expectedNames, state.updatedNames())
+				assert.Equal(t, "new-rule", rule.Name)
+				assert.NotEmpty(t, rule.ID)
+				assert.Equal(t, []FirewallRuleModelReference{{ID: ipSetID, Name: testFirewallIPSetName}}, rule.Sources)
+				assert.Equal(t, []FirewallRuleModelReference{{ID: securityGroupID, Name: testFirewallSecurityGroupName}}, rule.Destinations)
+				// The tenant profile takes precedence over the system one
+				assert.Equal(t, []FirewallRuleModelReference{{ID: appPortProfileID, Name: testFirewallAppPortProfile}}, rule.ApplicationPortProfiles)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err.Error())
+			if test.err == errors.ErrEdgeGatewayFirewallConflict {
+				assert.True(t, errors.IsConflict(err))
+			}
+		})
+	}
+}
+
+func TestClient_UpdateFirewallRule(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+	ipSetID := urn.SecurityGroup.String() + uuid.New().String()
+	securityGroupID := urn.SecurityGroup.String() + uuid.New().String()
+	appPortProfileID := urn.AppPortProfile.String() + uuid.New().String()
+
+	rules := testFirewallRules()
+	duplicated := testFirewallRules()
+	duplicated[1].Name = "rule-1"
+
+	var state *testFirewallState
+
+	tests := []struct {
+		name          string
+		ruleNameOrID  string
+		mockFunc      func()
+		expectedError bool
+		err           error
+	}{
+		{
+			name:         "success-by-id",
+			ruleNameOrID: rules[1].ID,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				clientCAV.EXPECT().GetAllNsxtAppPortProfiles(gomock.Any(), "").Return(testAppPortProfiles(appPortProfileID), nil)
+				state = &testFirewallState{rules: rules}
+				state.mock(ipSetID, securityGroupID, appPortProfileID)
+			},
+		},
+		{
+			name:          "empty-rule-name-or-id",
+			ruleNameOrID:  "",
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           fmt.Errorf("ruleNameOrID is empty"),
+		},
+		{
+			name:         "error-ambiguous-name",
+			ruleNameOrID: "rule-1",
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				state = &testFirewallState{rules: duplicated}
+				state.mock(ipSetID, securityGroupID, appPortProfileID)
+			},
+			expectedError: true,
+			err:           fmt.Errorf("is used by several rules"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mockFunc()
+
+			rule, err := c.UpdateFirewallRule(context.Background(), edgeGatewayID, test.ruleNameOrID, testFirewallRuleRequest("updated-rule"))
+			if !test.expectedError {
+				assert.NoError(t, err)
+				assert.Equal(t, rules[1].ID, rule.ID)
+				assert.Equal(t, "updated-rule", rule.Name)
+				assert.Equal(t, []string{"rule-1", "updated-rule", "rule-3"}, state.updatedNames())
+				// The version of the updated rule is sent for conflict detection
+				assert.Equal(t, 2, *state.updated[1].Version.Version)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err.Error())
+		})
+	}
+}
+
+func TestClient_DeleteFirewallRule(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+
+	clientCAV.EXPECT().Refresh().Return(nil)
+	clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+	state := &testFirewallState{rules: testFirewallRules()}
+	state.mock("", "", "")
+
+	assert.NoError(t, c.DeleteFirewallRule(context.Background(), edgeGatewayID, "rule-2"))
+	assert.Equal(t, []string{"rule-1", "rule-3"}, state.updatedNames())
+}
+
+func TestClient_MoveFirewallRule(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+
+	var state *testFirewallState
+
+	tests := []struct {
+		name          string
+		ruleNameOrID  string
+		position      FirewallRulePosition
+		mockFunc      func()
+		expectedNames []string
+		expectedError bool
+		err           error
+	}{
+		{
+			name:         "success-before",
+			ruleNameOrID: "rule-3",
+			position:     FirewallRulePosition{Before: "rule-1"},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				state = &testFirewallState{rules: testFirewallRules()}
+				state.mock("", "", "")
+			},
+			expectedNames: []string{"rule-3", "rule-1", "rule-2"},
+		},
+		{
+			name:         "success-after",
+			ruleNameOrID: "rule-1",
+			position:     FirewallRulePosition{After: "rule-3"},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				state = &testFirewallState{rules: testFirewallRules()}
+				state.mock("", "", "")
+			},
+			expectedNames: []string{"rule-2", "rule-3", "rule-1"},
+		},
+		{
+			name:          "error-empty-position",
+			ruleNameOrID:  "rule-1",
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           fmt.Errorf("position is empty"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mockFunc()
+
+			err := c.MoveFirewallRule(context.Background(), edgeGatewayID, test.ruleNameOrID, test.position)
+			if !test.expectedError {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expectedNames, state.updatedNames())
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err.Error())
+		})
+	}
+}
+
+func TestClient_UpdateFirewall(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+	appPortProfileID := urn.AppPortProfile.String() + uuid.New().String()
+
+	clientCAV.EXPECT().Refresh().Return(nil)
+	clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+	clientCAV.EXPECT().GetAllNsxtAppPortProfiles(gomock.Any(), "").Return(testAppPortProfiles(appPortProfileID), nil)
+	state := &testFirewallState{rules: testFirewallRules()}
+	state.mock(urn.SecurityGroup.String()+uuid.New().String(), urn.SecurityGroup.String()+uuid.New().String(), appPortProfileID)
+
+	firewall, err := c.UpdateFirewall(context.Background(), edgeGatewayID, []FirewallRuleModelRequest{
+		testFirewallRuleRequest("a"),
+		testFirewallRuleRequest("b"),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, firewall.Rules, 2)
+	assert.Equal(t, []string{"a", "b"}, state.updatedNames())
+}
+
+func TestClient_DeleteFirewall(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+
+	clientCAV.EXPECT().Refresh().Return(nil)
+	clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+	deleted := false
+	deleteFirewall = func(_ fakeFirewallEdgeGatewayClient) error {
+		deleted = true
+		return nil
+	}
+
+	assert.NoError(t, c.DeleteFirewall(context.Background(), edgeGatewayID))
+	assert.True(t, deleted)
+}
+
+func TestClient_FirewallNetworkContextProfiles(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+	tenantProfileID := urn.NetworkContextProfile.String() + uuid.New().String()
+	systemProfileID := urn.NetworkContextProfile.String() + uuid.New().String()
+
+	// rule-1 and rule-3 have layer 7 profiles which must be kept by the single-rule operations
+	newState := func() *testFirewallState {
+		state := &testFirewallState{rules: testFirewallRules()}
+		state.rules[0].NetworkContextProfiles = []govcdtypes.OpenApiReference{{ID: systemProfileID, Name: "SSL"}}
+		state.rules[2].NetworkContextProfiles = []govcdtypes.OpenApiReference{{ID: tenantProfileID, Name: "my-profile"}}
+		state.mock("", "", "")
+		return state
+	}
+
+	assertProfilesKept := func(t *testing.T, state *testFirewallState) {
+		t.Helper()
+		for _, rule := range state.updated {
+			switch rule.Name {
+			case "rule-1":
+				assert.Equal(t, []govcdtypes.OpenApiReference{{ID: systemProfileID, Name: "SSL"}}, rule.NetworkContextProfiles)
+			case "rule-3":
+				assert.Equal(t, []govcdtypes.OpenApiReference{{ID: tenantProfileID, Name: "my-profile"}}, rule.NetworkContextProfiles)
+			}
+		}
+	}
+
+	t.Run("add-rule", func(t *testing.T) {
+		clientCAV.EXPECT().Refresh().Return(nil)
+		clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+		clientCAV.EXPECT().GetAllNetworkContextProfiles(gomock.Any()).DoAndReturn(func(queryParameters url.Values) ([]*govcdtypes.NsxtNetworkContextProfile, error) {
+			assert.Equal(t, "orgVdcId=="+vdcID, queryParameters.Get("filter"))
+			return []*govcdtypes.NsxtNetworkContextProfile{
+				{ID: systemProfileID, Name: "my-profile", Scope: govcdtypes.ApplicationPortProfileScopeSystem},
+				{ID: tenantProfileID, Name: "my-profile", Scope: govcdtypes.ApplicationPortProfileScopeTenant},
+			}, nil
+		})
+		state := newState()
+
+		rule, err := c.AddFirewallRule(context.Background(), edgeGatewayID, FirewallRuleModelRequest{
+			Name:                   "new-rule",
+			Enabled:                utils.ToPTR(true),
+			Action:                 FirewallRuleActionAllow,
+			Direction:              FirewallRuleDirectionIn,
+			IPProtocol:             FirewallRuleIPProtocolIPv4,
+			NetworkContextProfiles: []string{"my-profile", systemProfileID},
+		}, FirewallRulePosition{})
+		assert.NoError(t, err)
+		// The tenant profile takes precedence over the system one
+		assert.Equal(t, []FirewallRuleModelReference{
+			{ID: tenantProfileID, Name: "my-profile"},
+			{ID: systemProfileID, Name: "my-profile"},
+		}, rule.NetworkContextProfiles)
+		assertProfilesKept(t, state)
+	})
+
+	t.Run("delete-rule", func(t *testing.T) {
+		clientCAV.EXPECT().Refresh().Return(nil)
+		clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+		state := newState()
+
+		assert.NoError(t, c.DeleteFirewallRule(context.Background(), edgeGatewayID, "rule-2"))
+		assert.Equal(t, []string{"rule-1", "rule-3"}, state.updatedNames())
+		assertProfilesKept(t, state)
+	})
+
+	t.Run("error-profile-not-found", func(t *testing.T) {
+		clientCAV.EXPECT().Refresh().Return(nil)
+		clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+		clientCAV.EXPECT().GetAllNetworkContextProfiles(gomock.Any()).Return(nil, nil)
+		state := newState()
+
+		_, err := c.AddFirewallRule(context.Background(), edgeGatewayID, FirewallRuleModelRequest{
+			Name:                   "new-rule",
+			Enabled:                utils.ToPTR(true),
+			Action:                 FirewallRuleActionAllow,
+			Direction:              FirewallRuleDirectionIn,
+			IPProtocol:             FirewallRuleIPProtocolIPv4,
+			NetworkContextProfiles: []string{"unknown"},
+		}, FirewallRulePosition{})
+		assert.ErrorIs(t, err, errors.ErrNotFound)
+		assert.Nil(t, state.updated)
+	})
+}
@@ -0,0 +1,193 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"net/url"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
+)
+
+type (
+	fakeFirewallEdgeGatewayClient interface {
+		GetNsxtFirewall() (*govcd.NsxtFirewall, error)
+		GetAllNsxtFirewallGroups(queryParameters url.Values, firewallGroupType string) ([]*govcd.NsxtFirewallGroup, error)
+	}
+
+	// FirewallModel represents the user-defined firewall rules of an edge gateway.
+	FirewallModel struct {
+		// Rules is the ordered list of user-defined rules.
+		// The first matching rule is applied.
+		Rules []*FirewallRuleModel
+	}
+
+	// FirewallRuleModel represents a firewall rule of an edge gateway.
+	FirewallRuleModel struct {
+		ID string
+
+		// Name of the firewall rule
+		Name string
+
+		// Enabled reports whether the rule is enabled.
+		Enabled bool
+
+		// Action applied to the traffic matching the rule.
+		Action FirewallRuleAction
+
+		// Direction of the traffic matched by the rule.
+		Direction FirewallRuleDirection
+
+		// IPProtocol of the traffic matched by the rule.
+		IPProtocol FirewallRuleIPProtocol
+
+		// Logging reports whether the logging is enabled for the rule.
+		Logging bool
+
+		// Sources is the list of source firewall groups (IP sets and security groups).
+		// An empty list means any source.
+		Sources []FirewallRuleModelReference
+
+		// Destinations is the list of destination firewall groups (IP sets and security groups).
+		// An empty list means any destination.
+		Destinations []FirewallRuleModelReference
+
+		// ApplicationPortProfiles is the list of application port profiles.
+		// An empty list means any application.
+		ApplicationPortProfiles []FirewallRuleModelReference
+
+		// NetworkContextProfiles is the list of network context profiles (layer 7).
+		// An empty list means any layer 7 context.
+		NetworkContextProfiles []FirewallRuleModelReference
+	}
+
+	// FirewallRuleModelReference represents an object referenced by a firewall rule.
+	FirewallRuleModelReference struct {
+		ID   string
+		Name string
+	}
+
+	// FirewallRuleModelRequest represents the request model for creating or updating a firewall rule.
+	// Every reference accepts either the name or the ID of the object.
+	FirewallRuleModelRequest struct {
+		// Name of the firewall rule
+		Name string `json:"name" validate:"required"`
+
+		// Enabled enables the rule.
+		Enabled *bool `json:"enabled" validate:"required"`
+
+		// Action applied to the traffic matching the rule (ALLOW, DROP, REJECT).
+		Action FirewallRuleAction `json:"action" validate:"required,oneof=ALLOW DROP REJECT"`
+
+		// Direction of the traffic matched by the rule (IN, OUT, IN_OUT).
+		Direction FirewallRuleDirection `json:"direction" validate:"required,oneof=IN OUT IN_OUT"`
+
+		// IPProtocol of the traffic matched by the rule (IPV4, IPV6, IPV4_IPV6).
+		IPProtocol FirewallRuleIPProtocol `json:"ipProtocol" validate:"required,oneof=IPV4 IPV6 IPV4_IPV6"`
+
+		// Logging enables the logging for the rule.
+		Logging bool `json:"logging"`
+
+		// SourceIPSets is the list of names or IDs of source IP sets.
+		SourceIPSets []string `json:"sourceIPSets" validate:"omitempty,dive,required"`
+
+		// SourceSecurityGroups is the list of names or IDs of source security groups (static or dynamic).
+		SourceSecurityGroups []string `json:"sourceSecurityGroups" validate:"omitempty,dive,required"`
+
+		// DestinationIPSets is the list of names or IDs of destination IP sets.
+		DestinationIPSets []string `json:"destinationIPSets" validate:"omitempty,dive,required"`
+
+		// DestinationSecurityGroups is the list of names or IDs of destination security groups (static or dynamic).
+		DestinationSecurityGroups []string `json:"destinationSecurityGroups" validate:"omitempty,dive,required"`
+
+		// ApplicationPortProfiles is the list of names or IDs of application port profiles.
+		ApplicationPortProfiles []string `json:"applicationPortProfiles" validate:"omitempty,dive,required"`
+
+		// NetworkContextProfiles is the list of names or IDs of network context profiles (layer 7).
+		NetworkContextProfiles []string `json:"networkContextProfiles" validate:"omitempty,dive,required"`
+	}
+
+	// FirewallRulePosition defines where a rule is placed relative to another rule.
+	// Before and After accept the name or the ID of the reference rule and are mutually exclusive.
+	// When both are empty, the rule is placed at the end of the list.
+	FirewallRulePosition struct {
+		// Before places the rule just before the reference rule.
+		Before string `validate:"excluded_with=After"`
+
+		// After places the rule just after the reference rule.
+		After string `validate:"excluded_with=Before"`
+	}
+
+	FirewallRuleAction     string
+	FirewallRuleDirection  string
+	FirewallRuleIPProtocol string
+)
+
+const (
+	// FirewallRuleActionAllow permits the traffic.
+	FirewallRuleActionAllow FirewallRuleAction = "ALLOW"
+	// FirewallRuleActionDrop blocks the traffic silently.
+	FirewallRuleActionDrop FirewallRuleAction = "DROP"
+	// FirewallRuleActionReject blocks the traffic and sends a response to the source.
+	FirewallRuleActionReject FirewallRuleAction = "REJECT"
+
+	// FirewallRuleDirectionIn matches the incoming traffic.
+	FirewallRuleDirectionIn FirewallRuleDirection = "IN"
+	// FirewallRuleDirectionOut matches the outgoing traffic.
+	FirewallRuleDirectionOut FirewallRuleDirection = "OUT"
+	// FirewallRuleDirectionInOut matches both the incoming and outgoing traffic.
+	FirewallRuleDirectionInOut FirewallRuleDirection = "IN_OUT"
+
+	// FirewallRuleIPProtocolIPv4 matches the IPv4 traffic.
+	FirewallRuleIPProtocolIPv4 FirewallRuleIPProtocol = "IPV4"
+	// FirewallRuleIPProtocolIPv6 matches the IPv6 traffic.
+	FirewallRuleIPProtocolIPv6 FirewallRuleIPProtocol = "IPV6"
+	// FirewallRuleIPProtocolIPv4IPv6 matches both the IPv4 and IPv6 traffic.
+	FirewallRuleIPProtocolIPv4IPv6 FirewallRuleIPProtocol = "IPV4_IPV6"
+)
+
+// fromVCD converts a VCD firewall rule to the internal FirewallRuleModel.
+func (m *FirewallRuleModel) fromVCD(vcdRule *v1.NsxtFirewallRuleExtended) {
+	if vcdRule == nil {
+		return
+	}
+
+	toReferences := func(refs []govcdtypes.OpenApiReference) []FirewallRuleModelReference {
+		x := make([]FirewallRuleModelReference, 0, len(refs))
+		for _, ref := range refs {
+			x = append(x, FirewallRuleModelReference{ID: ref.ID, Name: ref.Name})
+		}
+		return x
+	}
+
+	m.ID = vcdRule.ID
+	m.Name = vcdRule.Name
+	m.Enabled = vcdRule.Enabled
+	m.Action = FirewallRuleAction(vcdRule.ActionValue)
+	m.Direction = FirewallRuleDirection(vcdRule.Direction)
+	m.IPProtocol = FirewallRuleIPProtocol(vcdRule.IPProtocol)
+	m.Logging = vcdRule.Logging
+	m.Sources = toReferences(vcdRule.SourceFirewallGroups)
+	m.Destinations = toReferences(vcdRule.DestinationFirewallGroups)
+	m.ApplicationPortProfiles = toReferences(vcdRule.ApplicationPortProfiles)
+	m.NetworkContextProfiles = toReferences(vcdRule.NetworkContextProfiles)
+}
+
+// fromVCD converts VCD user-defined firewall rules to the internal FirewallModel.
+func (m *FirewallModel) fromVCD(vcdRules []*v1.NsxtFirewallRuleExtended) {
+	m.Rules = make([]*FirewallRuleModel, 0, len(vcdRules))
+	for _, rule := range vcdRules {
+		rm := &FirewallRuleModel{}
+		rm.fromVCD(rule)
+		m.Rules = append(m.Rules, rm)
+	}
+}
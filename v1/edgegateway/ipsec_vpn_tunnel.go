@@ -0,0 +1,399 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/orange-cloudavenue/common-go/validators"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+)
+
+var (
+	listIPSecVPNTunnels = func(edgeClient fakeIPSecVPNTunnelEdgeGatewayClient) ([]*govcd.NsxtIpSecVpnTunnel, error) {
+		return edgeClient.GetAllIpSecVpnTunnels(nil)
+	}
+
+	getIPSecVPNTunnel = func(edgeClient fakeIPSecVPNTunnelEdgeGatewayClient, tunnelNameOrID string) (*govcd.NsxtIpSecVpnTunnel, error) {
+		// IPsec VPN tunnel IDs are plain UUIDs (not URNs)
+		if urn.IsUUIDV4(tunnelNameOrID) {
+			return edgeClient.GetIpSecVpnTunnelById(tunnelNameOrID)
+		}
+		return edgeClient.GetIpSecVpnTunnelByName(tunnelNameOrID)
+	}
+
+	createIPSecVPNTunnel = func(edgeClient fakeIPSecVPNTunnelEdgeGatewayClient, tunnel *govcdtypes.NsxtIpSecVpnTunnel) (*govcd.NsxtIpSecVpnTunnel, error) {
+		return edgeClient.CreateIpSecVpnTunnel(tunnel)
+	}
+
+	updateIPSecVPNTunnel = func(tunnelClient fakeIPSecVPNTunnelClient, tunnel *govcdtypes.NsxtIpSecVpnTunnel) (*govcd.NsxtIpSecVpnTunnel, error) {
+		return tunnelClient.Update(tunnel)
+	}
+
+	deleteIPSecVPNTunnel = func(tunnelClient fakeIPSecVPNTunnelClient) error {
+		return tunnelClient.Delete()
+	}
+
+	getIPSecVPNTunnelStatus = func(tunnelClient fakeIPSecVPNTunnelClient) (*govcdtypes.NsxtIpSecVpnTunnelStatus, error) {
+		return tunnelClient.GetStatus()
+	}
+
+	getIPSecVPNTunnelSecurityProfile = func(tunnelClient fakeIPSecVPNTunnelClient) (*govcdtypes.NsxtIpSecVpnTunnelSecurityProfile, error) {
+		return tunnelClient.GetTunnelConnectionProperties()
+	}
+
+	updateIPSecVPNTunnelSecurityProfile = func(tunnelClient fakeIPSecVPNTunnelClient, securityProfile *govcdtypes.NsxtIpSecVpnTunnelSecurityProfile) (*govcdtypes.NsxtIpSecVpnTunnelSecurityProfile, error) {
+		return tunnelClient.UpdateTunnelConnectionProperties(securityProfile)
+	}
+)
+
+// ListIPSecVPNTunnels retrieves all IPsec VPN tunnels of an edge gateway.
+func (c *client) ListIPSecVPNTunnels(ctx context.Context, edgeGatewayNameOrID string) ([]*IPSecVPNTunnelModel, error) {
+	if err := c.edgeGatewayNameOrIDValidation(edgeGatewayNameOrID); err != nil {
+		return nil, err
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return nil, err
+	}
+
+	edgeGateway, err := c.getVCDEdgeGateway(ctx, edgeGatewayNameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	tunnels, err := listIPSecVPNTunnels(edgeGateway)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving IPsec VPN tunnels: %w", err)
+	}
+
+	tunnelModels := make([]*IPSecVPNTunnelModel, 0, len(tunnels))
+	for _, tunnel := range tunnels {
+		tm, err := ipSecVPNTunnelToModel(tunnel)
+		if err != nil {
+			return nil, err
+		}
+
+		tunnelModels = append(tunnelModels, tm)
+	}
+
+	return tunnelModels, nil
+}
+
+// GetIPSecVPNTunnel retrieves an IPsec VPN tunnel of an edge gateway by name or ID.
+func (c *client) GetIPSecVPNTunnel(ctx context.Context, edgeGatewayNameOrID, tunnelNameOrID string) (*IPSecVPNTunnelModel, error) {
+	if err := c.edgeGatewayNameOrIDValidation(edgeGatewayNameOrID); err != nil {
+		return nil, err
+	}
+
+	if tunnelNameOrID == "" {
+		return nil, fmt.Errorf("tunnelNameOrID is %w. Please provide a valid tunnelNameOrID", errors.ErrEmpty)
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return nil, err
+	}
+
+	edgeGateway, err := c.getVCDEdgeGateway(ctx, edgeGatewayNameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	tunnel, err := getIPSecVPNTunnel(edgeGateway, tunnelNameOrID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving IPsec VPN tunnel %s: %w", tunnelNameOrID, err)
+	}
+
+	return ipSecVPNTunnelToModel(tunnel)
+}
+
+// CreateIPSecVPNTunnel creates a new IPsec VPN tunnel on an edge gateway.
+// When the AuthenticationMode is CERTIFICATE, the certificates are retrieved from the organization library.
+// When a SecurityProfile is provided, it is applied to the tunnel after its creation.
+// If the SecurityProfile cannot be applied, the tunnel is deleted.
+func (c *client) CreateIPSecVPNTunnel(ctx context.Context, edgeGatewayNameOrID string, tunnel IPSecVPNTunnelModelRequest) (*IPSecVPNTunnelModel, error) {
+	if err := c.edgeGatewayNameOrIDValidation(edgeGatewayNameOrID); err != nil {
+		return nil, err
+	}
+
+	if err := validators.New().StructCtx(ctx, &tunnel); err != nil {
+		return nil, err
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return nil, err
+	}
+
+	edgeGateway, err := c.getVCDEdgeGateway(ctx, edgeGatewayNameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	vcdTunnel, err := c.ipSecVPNTunnelRequestToVCD(ctx, tunnel)
+	if err != nil {
+		return nil, err
+	}
+
+	tunnelCreated, err := createIPSecVPNTunnel(edgeGateway, vcdTunnel)
+	if err != nil {
+		return nil, fmt.Errorf("error creating IPsec VPN tunnel: %w", err)
+	}
+
+	if tunnel.SecurityProfile != nil {
+		if _, err := updateIPSecVPNTunnelSecurityProfile(tunnelCreated, tunnel.SecurityProfile.toVCD()); err != nil {
+			if deleteErr := deleteIPSecVPNTunnel(tunnelCreated); deleteErr != nil {
+				return nil, fmt.Errorf("error customizing security profile of IPsec VPN tunnel %s: %w (deletion of the tunnel %s failed: %w)", tunnel.Name, err, tunnelCreated.NsxtIpSecVpn.ID, deleteErr)
+			}
+			return nil, fmt.Errorf("error customizing security profile of IPsec VPN tunnel %s, the tunnel has been deleted: %w", tunnel.Name, err)
+		}
+
+		// Refresh the tunnel to get the updated security type
+		if tunnelCreated, err = getIPSecVPNTunnel(edgeGateway, tunnelCreated.NsxtIpSecVpn.ID); err != nil {
+			return nil, fmt.Errorf("error retrieving IPsec VPN tunnel %s: %w", tunnel.Name, err)
+		}
+	}
+
+	return ipSecVPNTunnelToModel(tunnelCreated)
+}
+
+// UpdateIPSecVPNTunnel updates an existing IPsec VPN tunnel of an edge gateway.
+// If the SecurityProfile is nil, the tunnel is reverted to the default security profile.
+// The security profile is applied by a second call. If it fails, the previous settings of the tunnel
+// are restored; if the restore fails too, the tunnel keeps the new settings with its previous security profile.
+func (c *client) UpdateIPSecVPNTunnel(ctx context.Context, edgeGatewayNameOrID, tunnelID string, tunnel IPSecVPNTunnelModelRequest) (*IPSecVPNTunnelModel, error) {
+	if err := c.edgeGatewayNameOrIDValidation(edgeGatewayNameOrID); err != nil {
+		return nil, err
+	}
+
+	if err := ipSecVPNTunnelIDValidation(tunnelID); err != nil {
+		return nil, err
+	}
+
+	if err := validators.New().StructCtx(ctx, &tunnel); err != nil {
+		return nil, err
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return nil, err
+	}
+
+	edgeGateway, err := c.getVCDEdgeGateway(ctx, edgeGatewayNameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	tunnelToUpdate, err := getIPSecVPNTunnel(edgeGateway, tunnelID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving IPsec VPN tunnel %s: %w", tunnelID, err)
+	}
+
+	vcdTunnel, err := c.ipSecVPNTunnelRequestToVCD(ctx, tunnel)
+	if err != nil {
+		return nil, err
+	}
+
+	vcdTunnel.ID = tunnelToUpdate.NsxtIpSecVpn.ID
+	// Version is required to prevent overwriting a concurrent change
+	vcdTunnel.Version = tunnelToUpdate.NsxtIpSecVpn.Version
+	// The security profile is only changed by the dedicated call below
+	vcdTunnel.SecurityType = tunnelToUpdate.NsxtIpSecVpn.SecurityType
+
+	tunnelUpdated, err := updateIPSecVPNTunnel(tunnelToUpdate, vcdTunnel)
+	if err != nil {
+		return nil, fmt.Errorf("error updating IPsec VPN tunnel: %w", err)
+	}
+
+	securityProfile := &govcdtypes.NsxtIpSecVpnTunnelSecurityProfile{
+		SecurityType: string(IPSecVPNTunnelSecurityTypeDefault),
+	}
+	if tunnel.SecurityProfile != nil {
+		securityProfile = tunnel.SecurityProfile.toVCD()
+	}
+
+	// Only call the API if the security profile has to be changed
+	if tunnel.SecurityProfile != nil || tunnelToUpdate.NsxtIpSecVpn.SecurityType == string(IPSecVPNTunnelSecurityTypeCustom) {
+		if _, err := updateIPSecVPNTunnelSecurityProfile(tunnelUpdated, securityProfile); err != nil {
+			tunnelToRestore := *tunnelToUpdate.NsxtIpSecVpn
+			tunnelToRestore.Version = tunnelUpdated.NsxtIpSecVpn.Version
+			if _, restoreErr := updateIPSecVPNTunnel(tunnelUpdated, &tunnelToRestore); restoreErr != nil {
+				return nil, fmt.Errorf("error updating security profile of IPsec VPN tunnel %s: %w (restore of the previous settings of the tunnel failed, the new settings are applied with the previous security profile: %w)", tunnel.Name, err, restoreErr)
+			}
+			return nil, fmt.Errorf("error updating security profile of IPsec VPN tunnel %s, the previous settings of the tunnel have been restored: %w", tunnel.Name, err)
+		}
+
+		if tunnelUpdated, err = getIPSecVPNTunnel(edgeGateway, tunnelToUpdate.NsxtIpSecVpn.ID); err != nil {
+			return nil, fmt.Errorf("error retrieving IPsec VPN tunnel %s: %w", tunnel.Name, err)
+		}
+	}
+
+	return ipSecVPNTunnelToModel(tunnelUpdated)
+}
+
+// DeleteIPSecVPNTunnel deletes an IPsec VPN tunnel of an edge gateway.
+func (c *client) DeleteIPSecVPNTunnel(ctx context.Context, edgeGatewayNameOrID, tunnelID string) error {
+	if err := c.edgeGatewayNameOrIDValidation(edgeGatewayNameOrID); err != nil {
+		return err
+	}
+
+	if err := ipSecVPNTunnelIDValidation(tunnelID); err != nil {
+		return err
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return err
+	}
+
+	edgeGateway, err := c.getVCDEdgeGateway(ctx, edgeGatewayNameOrID)
+	if err != nil {
+		return err
+	}
+
+	tunnelToDelete, err := getIPSecVPNTunnel(edgeGateway, tunnelID)
+	if err != nil {
+		return fmt.Errorf("error retrieving IPsec VPN tunnel %s: %w", tunnelID, err)
+	}
+
+	return deleteIPSecVPNTunnel(tunnelToDelete)
+}
+
+// GetTunnelStatus retrieves the IKE and tunnel status of an IPsec VPN tunnel.
+// The status is not immediately available after the creation of the tunnel.
+func (c *client) GetTunnelStatus(ctx context.Context, edgeGatewayNameOrID, tunnelNameOrID string) (*IPSecVPNTunnelStatusModel, error) {
+	if err := c.edgeGatewayNameOrIDValidation(edgeGatewayNameOrID); err != nil {
+		return nil, err
+	}
+
+	if tunnelNameOrID == "" {
+		return nil, fmt.Errorf("tunnelNameOrID is %w. Please provide a valid tunnelNameOrID", errors.ErrEmpty)
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return nil, err
+	}
+
+	edgeGateway, err := c.getVCDEdgeGateway(ctx, edgeGatewayNameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	tunnel, err := getIPSecVPNTunnel(edgeGateway, tunnelNameOrID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving IPsec VPN tunnel %s: %w", tunnelNameOrID, err)
+	}
+
+	status, err := getIPSecVPNTunnelStatus(tunnel)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving status of IPsec VPN tunnel %s: %w", tunnelNameOrID, err)
+	}
+
+	return &IPSecVPNTunnelStatusModel{
+		TunnelStatus:  IPSecVPNTunnelStatus(status.TunnelStatus),
+		IKEStatus:     IPSecVPNTunnelStatus(status.IkeStatus.IkeServiceStatus),
+		IKEFailReason: status.IkeStatus.FailReason,
+	}, nil
+}
+
+// * Local functions
+
+func ipSecVPNTunnelIDValidation(tunnelID string) error {
+	if tunnelID == "" {
+		return fmt.Errorf("tunnelID is %w. Please provide a valid tunnelID", errors.ErrEmpty)
+	}
+
+	if !urn.IsUUIDV4(tunnelID) {
+		return fmt.Errorf("tunnelID has %w. Please provide a valid tunnelID", errors.ErrInvalidFormat)
+	}
+
+	return nil
+}
+
+// ipSecVPNTunnelToModel converts a govcd IPsec VPN tunnel to the IPSecVPNTunnelModel.
+// The security profile is only retrieved when it has been customized.
+func ipSecVPNTunnelToModel(tunnel *govcd.NsxtIpSecVpnTunnel) (*IPSecVPNTunnelModel, error) {
+	tm := &IPSecVPNTunnelModel{}
+	tm.fromVCD(tunnel.NsxtIpSecVpn)
+
+	if tm.SecurityType != IPSecVPNTunnelSecurityTypeCustom {
+		return tm, nil
+	}
+
+	securityProfile, err := getIPSecVPNTunnelSecurityProfile(tunnel)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving security profile of IPsec VPN tunnel %s: %w", tm.Name, err)
+	}
+
+	tm.SecurityProfile = &IPSecVPNTunnelModelSecurityProfile{}
+	tm.SecurityProfile.fromVCD(securityProfile)
+
+	return tm, nil
+}
+
+// ipSecVPNTunnelRequestToVCD converts the request to the VCD format.
+// Certificates are resolved by name or ID from the organization library.
+func (c *client) ipSecVPNTunnelRequestToVCD(ctx context.Context, tunnel IPSecVPNTunnelModelRequest) (*govcdtypes.NsxtIpSecVpnTunnel, error) {
+	vcdTunnel := &govcdtypes.NsxtIpSecVpnTunnel{
+		Name:        tunnel.Name,
+		Description: tunnel.Description,
+		Enabled:     *tunnel.Enabled,
+		LocalEndpoint: govcdtypes.NsxtIpSecVpnTunnelLocalEndpoint{
+			LocalId:       tunnel.LocalEndpoint.LocalID,
+			LocalAddress:  tunnel.LocalEndpoint.LocalAddress,
+			LocalNetworks: tunnel.LocalEndpoint.LocalNetworks,
+		},
+		RemoteEndpoint: govcdtypes.NsxtIpSecVpnTunnelRemoteEndpoint{
+			RemoteId:       tunnel.RemoteEndpoint.RemoteID,
+			RemoteAddress:  tunnel.RemoteEndpoint.RemoteAddress,
+			RemoteNetworks: tunnel.RemoteEndpoint.RemoteNetworks,
+		},
+		AuthenticationMode:      string(tunnel.AuthenticationMode),
+		PreSharedKey:            tunnel.PreSharedKey,
+		ConnectorInitiationMode: string(tunnel.ConnectorInitiationMode),
+		Logging:                 tunnel.Logging,
+		SecurityType:            string(IPSecVPNTunnelSecurityTypeDefault),
+	}
+
+	if vcdTunnel.ConnectorInitiationMode == "" {
+		vcdTunnel.ConnectorInitiationMode = string(IPSecVPNTunnelConnectorInitiationModeInitiator)
+	}
+
+	if tunnel.AuthenticationMode != IPSecVPNTunnelAuthenticationModeCertificate {
+		return vcdTunnel, nil
+	}
+
+	certificates, err := c.clientOrg.ListCertificatesInLibrary(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving certificates from the library: %w", err)
+	}
+
+	findCertificate := func(nameOrID string) (*govcdtypes.OpenApiReference, error) {
+		for _, certificate := range certificates {
+			if certificate.ID == nameOrID || certificate.Name == nameOrID {
+				return &govcdtypes.OpenApiReference{ID: certificate.ID, Name: certificate.Name}, nil
+			}
+		}
+		return nil, fmt.Errorf("certificate %s %w in the library", nameOrID, errors.ErrNotFound)
+	}
+
+	if vcdTunnel.CertificateRef, err = findCertificate(tunnel.CertificateNameOrID); err != nil {
+		return nil, err
+	}
+
+	if vcdTunnel.CaCertificateRef, err = findCertificate(tunnel.CACertificateNameOrID); err != nil {
+		return nil, err
+	}
+
+	return vcdTunnel, nil
+}
@@ -0,0 +1,853 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/utils"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/org"
+)
+
+const (
+	testIPSecVPNTunnelName = "test-ipsec-vpn-tunnel"
+	testIPSecVPNLocalIP    = "203.0.113.10"
+	testIPSecVPNRemoteIP   = "198.51.100.20"
+	testIPSecVPNLocalNet   = "192.168.1.0/24"
+	testIPSecVPNRemoteNet  = "10.20.0.0/16"
+	testIPSecVPNPSK        = "my-pre-shared-key"
+	testCertificateName    = "test-certificate"
+	testCACertificateName  = "test-ca-certificate"
+)
+
+func testVCDIPSecVPNTunnel(tunnelID string, securityType IPSecVPNTunnelSecurityType) *govcd.NsxtIpSecVpnTunnel {
+	return &govcd.NsxtIpSecVpnTunnel{
+		NsxtIpSecVpn: &govcdtypes.NsxtIpSecVpnTunnel{
+			ID:      tunnelID,
+			Name:    testIPSecVPNTunnelName,
+			Enabled: true,
+			LocalEndpoint: govcdtypes.NsxtIpSecVpnTunnelLocalEndpoint{
+				LocalAddress:  testIPSecVPNLocalIP,
+				LocalNetworks: []string{testIPSecVPNLocalNet},
+			},
+			RemoteEndpoint: govcdtypes.NsxtIpSecVpnTunnelRemoteEndpoint{
+				RemoteId:       testIPSecVPNRemoteIP,
+				RemoteAddress:  testIPSecVPNRemoteIP,
+				RemoteNetworks: []string{testIPSecVPNRemoteNet},
+			},
+			AuthenticationMode:      string(IPSecVPNTunnelAuthenticationModePSK),
+			PreSharedKey:            testIPSecVPNPSK,
+			ConnectorInitiationMode: string(IPSecVPNTunnelConnectorInitiationModeInitiator),
+			SecurityType:            string(securityType),
+			Version: &struct {
+				Version *int `json:"version,omitempty"`
+			}{Version: utils.ToPTR(2)},
+		},
+	}
+}
+
+func testIPSecVPNTunnelRequest() IPSecVPNTunnelModelRequest {
+	return IPSecVPNTunnelModelRequest{
+		Name:    testIPSecVPNTunnelName,
+		Enabled: utils.ToPTR(true),
+		LocalEndpoint: IPSecVPNTunnelModelRequestLocalEndpoint{
+			LocalAddress:  testIPSecVPNLocalIP,
+			LocalNetworks: []string{testIPSecVPNLocalNet},
+		},
+		RemoteEndpoint: IPSecVPNTunnelModelRequestRemoteEndpoint{
+			RemoteAddress:  testIPSecVPNRemoteIP,
+			RemoteNetworks: []string{testIPSecVPNRemoteNet},
+		},
+		AuthenticationMode: IPSecVPNTunnelAuthenticationModePSK,
+		PreSharedKey:       testIPSecVPNPSK,
+	}
+}
+
+func testIPSecVPNTunnelSecurityProfile() *IPSecVPNTunnelModelSecurityProfile {
+	return &IPSecVPNTunnelModelSecurityProfile{
+		IKE: IPSecVPNTunnelModelSecurityProfileIKE{
+			Version:             "IKE_V2",
+			EncryptionAlgorithm: "AES_256",
+			DigestAlgorithm:     "SHA2_256",
+			DHGroup:             "GROUP14",
+			SALifeTime:          86400,
+		},
+		Tunnel: IPSecVPNTunnelModelSecurityProfileTunnel{
+			PerfectForwardSecrecyEnabled: true,
+			DFPolicy:                     "COPY",
+			EncryptionAlgorithm:          "AES_GCM_128",
+			DHGroup:                      "GROUP14",
+			SALifeTime:                   3600,
+		},
+		DPDProbeInterval: 30,
+	}
+}
+
+func TestClient_ListIPSecVPNTunnels(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+	tunnelID := uuid.New().String()
+	customTunnelID := uuid.New().String()
+
+	tests := []struct {
+		name                string
+		edgeGatewayNameOrID string
+		mockFunc            func()
+		expectedLen         int
+		expectedError       bool
+		err                 error
+	}{
+		{
+			name:                testSuccess,
+			edgeGatewayNameOrID: edgeGatewayID,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				listIPSecVPNTunnels = func(_ fakeIPSecVPNTunnelEdgeGatewayClient) ([]*govcd.NsxtIpSecVpnTunnel, error) {
+					return []*govcd.NsxtIpSecVpnTunnel{
+						testVCDIPSecVPNTunnel(tunnelID, IPSecVPNTunnelSecurityTypeDefault),
+						testVCDIPSecVPNTunnel(customTunnelID, IPSecVPNTunnelSecurityTypeCustom),
+					}, nil
+				}
+				getIPSecVPNTunnelSecurityProfile = func(_ fakeIPSecVPNTunnelClient) (*govcdtypes.NsxtIpSecVpnTunnelSecurityProfile, error) {
+					return testIPSecVPNTunnelSecurityProfile().toVCD(), nil
+				}
+			},
+			expectedLen: 2,
+		},
+		{
+			name:                "empty-edge-gateway-name-or-id",
+			edgeGatewayNameOrID: "",
+			mockFunc:            func() {},
+			expectedError:       true,
+			err:                 fmt.Errorf("edgeGatewayNameOrID is empty"),
+		},
+		{
+			name:                "refresh-error",
+			edgeGatewayNameOrID: edgeGatewayID,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(fmt.Errorf("error"))
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error"),
+		},
+		{
+			name:                "error-get-edge-gateway",
+			edgeGatewayNameOrID: testEdgeGatewayName,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayByName(testEdgeGatewayName).Return(nil, fmt.Errorf("error"))
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error retrieving edge gateway"),
+		},
+		{
+			name:                "error-list-tunnels",
+			edgeGatewayNameOrID: edgeGatewayID,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				listIPSecVPNTunnels = func(_ fakeIPSecVPNTunnelEdgeGatewayClient) ([]*govcd.NsxtIpSecVpnTunnel, error) {
+					return nil, fmt.Errorf("error")
+				}
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error retrieving IPsec VPN tunnels"),
+		},
+		{
+			name:                "error-get-security-profile",
+			edgeGatewayNameOrID: edgeGatewayID,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				listIPSecVPNTunnels = func(_ fakeIPSecVPNTunnelEdgeGatewayClient) ([]*govcd.NsxtIpSecVpnTunnel, error) {
+					return []*govcd.NsxtIpSecVpnTunnel{
+						testVCDIPSecVPNTunnel(customTunnelID, IPSecVPNTunnelSecurityTypeCustom),
+					}, nil
+				}
+				getIPSecVPNTunnelSecurityProfile = func(_ fakeIPSecVPNTunnelClient) (*govcdtypes.NsxtIpSecVpnTunnelSecurityProfile, error) {
+					return nil, fmt.Errorf("error")
+				}
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error retrieving security profile of IPsec VPN tunnel"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mockFunc()
+
+			tunnels, err := c.ListIPSecVPNTunnels(context.Background(), test.edgeGatewayNameOrID)
+			if !test.expectedError {
+				assert.NoError(t, err)
+				assert.Len(t, tunnels, test.expectedLen)
+				assert.Equal(t, tunnelID, tunnels[0].ID)
+				assert.Nil(t, tunnels[0].SecurityProfile)
+				assert.Equal(t, IPSecVPNTunnelSecurityTypeCustom, tunnels[1].SecurityType)
+				assert.Equal(t, testIPSecVPNTunnelSecurityProfile(), tunnels[1].SecurityProfile)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err.Error())
+		})
+	}
+}
+
+func TestClient_GetIPSecVPNTunnel(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+	tunnelID := uuid.New().String()
+
+	tests := []struct {
+		name           string
+		tunnelNameOrID string
+		mockFunc       func()
+		expectedError  bool
+		err            error
+	}{
+		{
+			name:           "success-by-id",
+			tunnelNameOrID: tunnelID,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelEdgeGatewayClient, tunnelNameOrID string) (*govcd.NsxtIpSecVpnTunnel, error) {
+					return testVCDIPSecVPNTunnel(tunnelNameOrID, IPSecVPNTunnelSecurityTypeDefault), nil
+				}
+			},
+		},
+		{
+			name:           "empty-tunnel-name-or-id",
+			tunnelNameOrID: "",
+			mockFunc:       func() {},
+			expectedError:  true,
+			err:            fmt.Errorf("tunnelNameOrID is empty"),
+		},
+		{
+			name:           "error-get-tunnel",
+			tunnelNameOrID: testIPSecVPNTunnelName,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelEdgeGatewayClient, _ string) (*govcd.NsxtIpSecVpnTunnel, error) {
+					return nil, fmt.Errorf("error")
+				}
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error retrieving IPsec VPN tunnel"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mockFunc()
+
+			tunnel, err := c.GetIPSecVPNTunnel(context.Background(), edgeGatewayID, test.tunnelNameOrID)
+			if !test.expectedError {
+				assert.NoError(t, err)
+				assert.Equal(t, test.tunnelNameOrID, tunnel.ID)
+				assert.Equal(t, IPSecVPNTunnelAuthenticationModePSK, tunnel.AuthenticationMode)
+				assert.Equal(t, []string{testIPSecVPNRemoteNet}, tunnel.RemoteEndpoint.RemoteNetworks)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err.Error())
+		})
+	}
+}
+
+func TestClient_CreateIPSecVPNTunnel(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+	tunnelID := uuid.New().String()
+	certificateID := urn.CertificateLibraryItem.String() + uuid.New().String()
+	caCertificateID := urn.CertificateLibraryItem.String() + uuid.New().String()
+
+	certificates := org.CertificatesModel{
+		{ID: certificateID, Name: testCertificateName},
+		{ID: caCertificateID, Name: testCACertificateName},
+	}
+
+	tests := []struct {
+		name          string
+		request       func() IPSecVPNTunnelModelRequest
+		mockFunc      func()
+		expectedError bool
+		err           error
+	}{
+		{
+			name:    "success-psk",
+			request: testIPSecVPNTunnelRequest,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				createIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelEdgeGatewayClient, tunnel *govcdtypes.NsxtIpSecVpnTunnel) (*govcd.NsxtIpSecVpnTunnel, error) {
+					if tunnel.ConnectorInitiationMode != string(IPSecVPNTunnelConnectorInitiationModeInitiator) {
+						return nil, fmt.Errorf("unexpected connector initiation mode %s", tunnel.ConnectorInitiationMode)
+					}
+					return testVCDIPSecVPNTunnel(tunnelID, IPSecVPNTunnelSecurityTypeDefault), nil
+				}
+			},
+		},
+		{
+			name: "success-certificate",
+			request: func() IPSecVPNTunnelModelRequest {
+				r := testIPSecVPNTunnelRequest()
+				r.AuthenticationMode = IPSecVPNTunnelAuthenticationModeCertificate
+				r.PreSharedKey = ""
+				r.CertificateNameOrID = testCertificateName
+				r.CACertificateNameOrID = caCertificateID
+				return r
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				clientCAV.EXPECT().ListCertificatesInLibrary(gomock.Any()).Return(certificates, nil)
+				createIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelEdgeGatewayClient, tunnel *govcdtypes.NsxtIpSecVpnTunnel) (*govcd.NsxtIpSecVpnTunnel, error) {
+					if tunnel.CertificateRef == nil || tunnel.CertificateRef.ID != certificateID {
+						return nil, fmt.Errorf("unexpected certificate")
+					}
+					if tunnel.CaCertificateRef == nil || tunnel.CaCertificateRef.Name != testCACertificateName {
+						return nil, fmt.Errorf("unexpected CA certificate")
+					}
+					return testVCDIPSecVPNTunnel(tunnelID, IPSecVPNTunnelSecurityTypeDefault), nil
+				}
+			},
+		},
+		{
+			name: "success-custom-security-profile",
+			request: func() IPSecVPNTunnelModelRequest {
+				r := testIPSecVPNTunnelRequest()
+				r.SecurityProfile = testIPSecVPNTunnelSecurityProfile()
+				return r
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				createIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelEdgeGatewayClient, _ *govcdtypes.NsxtIpSecVpnTunnel) (*govcd.NsxtIpSecVpnTunnel, error) {
+					return testVCDIPSecVPNTunnel(tunnelID, IPSecVPNTunnelSecurityTypeDefault), nil
+				}
+				updateIPSecVPNTunnelSecurityProfile = func(_ fakeIPSecVPNTunnelClient, securityProfile *govcdtypes.NsxtIpSecVpnTunnelSecurityProfile) (*govcdtypes.NsxtIpSecVpnTunnelSecurityProfile, error) {
+					if securityProfile.SecurityType != string(IPSecVPNTunnelSecurityTypeCustom) {
+						return nil, fmt.Errorf("unexpected security type %s", securityProfile.SecurityType)
+					}
+					return securityProfile, nil
+				}
+				getIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelEdgeGatewayClient, tunnelNameOrID string) (*govcd.NsxtIpSecVpnTunnel, error) {
+					return testVCDIPSecVPNTunnel(tunnelNameOrID, IPSecVPNTunnelSecurityTypeCustom), nil
+				}
+				getIPSecVPNTunnelSecurityProfile = func(_ fakeIPSecVPNTunnelClient) (*govcdtypes.NsxtIpSecVpnTunnelSecurityProfile, error) {
+					return testIPSecVPNTunnelSecurityProfile().toVCD(), nil
+				}
+			},
+		},
+		{
+			name: "error-validation-missing-psk",
+			request: func() IPSecVPNTunnelModelRequest {
+				r := testIPSecVPNTunnelRequest()
+				r.PreSharedKey = ""
+				return r
+			},
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           fmt.Errorf("PreSharedKey"),
+		},
+		{
+			name: "error-validation-missing-certificate",
+			request: func() IPSecVPNTunnelModelRequest {
+				r := testIPSecVPNTunnelRequest()
+				r.AuthenticationMode = IPSecVPNTunnelAuthenticationModeCertificate
+				r.PreSharedKey = ""
+				return r
+			},
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           fmt.Errorf("CertificateNameOrID"),
+		},
+		{
+			name: "error-validation-security-profile",
+			request: func() IPSecVPNTunnelModelRequest {
+				r := testIPSecVPNTunnelRequest()
+				r.SecurityProfile = testIPSecVPNTunnelSecurityProfile()
+				r.SecurityProfile.IKE.Version = "IKE_V3"
+				return r
+			},
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           fmt.Errorf("Version"),
+		},
+		{
+			name: "error-certificate-not-found",
+			request: func() IPSecVPNTunnelModelRequest {
+				r := testIPSecVPNTunnelRequest()
+				r.AuthenticationMode = IPSecVPNTunnelAuthenticationModeCertificate
+				r.PreSharedKey = ""
+				r.CertificateNameOrID = "unknown"
+				r.CACertificateNameOrID = testCACertificateName
+				return r
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				clientCAV.EXPECT().ListCertificatesInLibrary(gomock.Any()).Return(certificates, nil)
+			},
+			expectedError: true,
+			err:           fmt.Errorf("certificate unknown not found in the library"),
+		},
+		{
+			name: "error-security-profile-tunnel-deleted",
+			request: func() IPSecVPNTunnelModelRequest {
+				r := testIPSecVPNTunnelRequest()
+				r.SecurityProfile = testIPSecVPNTunnelSecurityProfile()
+				return r
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				createIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelEdgeGatewayClient, _ *govcdtypes.NsxtIpSecVpnTunnel) (*govcd.NsxtIpSecVpnTunnel, error) {
+					return testVCDIPSecVPNTunnel(tunnelID, IPSecVPNTunnelSecurityTypeDefault), nil
+				}
+				updateIPSecVPNTunnelSecurityProfile = func(_ fakeIPSecVPNTunnelClient, _ *govcdtypes.NsxtIpSecVpnTunnelSecurityProfile) (*govcdtypes.NsxtIpSecVpnTunnelSecurityProfile, error) {
+					return nil, fmt.Errorf("error")
+				}
+				deleteIPSecVPNTunnel = func(tunnelClient fakeIPSecVPNTunnelClient) error {
+					if tunnelClient.(*govcd.NsxtIpSecVpnTunnel).NsxtIpSecVpn.ID != tunnelID {
+						return fmt.Errorf("unexpected tunnel")
+					}
+					return nil
+				}
+			},
+			expectedError: true,
+			err:           fmt.Errorf("the tunnel has been deleted"),
+		},
+		{
+			name: "error-security-profile-deletion-failed",
+			request: func() IPSecVPNTunnelModelRequest {
+				r := testIPSecVPNTunnelRequest()
+				r.SecurityProfile = testIPSecVPNTunnelSecurityProfile()
+				return r
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				createIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelEdgeGatewayClient, _ *govcdtypes.NsxtIpSecVpnTunnel) (*govcd.NsxtIpSecVpnTunnel, error) {
+					return testVCDIPSecVPNTunnel(tunnelID, IPSecVPNTunnelSecurityTypeDefault), nil
+				}
+				updateIPSecVPNTunnelSecurityProfile = func(_ fakeIPSecVPNTunnelClient, _ *govcdtypes.NsxtIpSecVpnTunnelSecurityProfile) (*govcdtypes.NsxtIpSecVpnTunnelSecurityProfile, error) {
+					return nil, fmt.Errorf("error")
+				}
+				deleteIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelClient) error {
+					return fmt.Errorf("error")
+				}
+			},
+			expectedError: true,
+			err:           fmt.Errorf("deletion of the tunnel %s failed", tunnelID),
+		},
+		{
+			name:    "error-create-tunnel",
+			request: testIPSecVPNTunnelRequest,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				createIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelEdgeGatewayClient, _ *govcdtypes.NsxtIpSecVpnTunnel) (*govcd.NsxtIpSecVpnTunnel, error) {
+					return nil, fmt.Errorf("error")
+				}
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error creating IPsec VPN tunnel"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mockFunc()
+
+			tunnel, err := c.CreateIPSecVPNTunnel(context.Background(), edgeGatewayID, test.request())
+			if !test.expectedError {
+				assert.NoError(t, err)
+				assert.Equal(t, tunnelID, tunnel.ID)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err.Error())
+		})
+	}
+}
+
+func TestClient_UpdateIPSecVPNTunnel(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+	tunnelID := uuid.New().String()
+
+	tests := []struct {
+		name          string
+		tunnelID      string
+		mockFunc      func()
+		expectedError bool
+		err           error
+	}{
+		{
+			name:     testSuccess,
+			tunnelID: tunnelID,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelEdgeGatewayClient, tunnelNameOrID string) (*govcd.NsxtIpSecVpnTunnel, error) {
+					return testVCDIPSecVPNTunnel(tunnelNameOrID, IPSecVPNTunnelSecurityTypeDefault), nil
+				}
+				updateIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelClient, tunnel *govcdtypes.NsxtIpSecVpnTunnel) (*govcd.NsxtIpSecVpnTunnel, error) {
+					if tunnel.Version == nil || *tunnel.Version.Version != 2 {
+						return nil, fmt.Errorf("version is not set")
+					}
+					return &govcd.NsxtIpSecVpnTunnel{NsxtIpSecVpn: tunnel}, nil
+				}
+				updateIPSecVPNTunnelSecurityProfile = func(_ fakeIPSecVPNTunnelClient, _ *govcdtypes.NsxtIpSecVpnTunnelSecurityProfile) (*govcdtypes.NsxtIpSecVpnTunnelSecurityProfile, error) {
+					return nil, fmt.Errorf("security profile must not be updated")
+				}
+			},
+		},
+		{
+			name:     "success-revert-to-default-security-profile",
+			tunnelID: tunnelID,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				custom := true
+				getIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelEdgeGatewayClient, tunnelNameOrID string) (*govcd.NsxtIpSecVpnTunnel, error) {
+					if custom {
+						return testVCDIPSecVPNTunnel(tunnelNameOrID, IPSecVPNTunnelSecurityTypeCustom), nil
+					}
+					return testVCDIPSecVPNTunnel(tunnelNameOrID, IPSecVPNTunnelSecurityTypeDefault), nil
+				}
+				updateIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelClient, tunnel *govcdtypes.NsxtIpSecVpnTunnel) (*govcd.NsxtIpSecVpnTunnel, error) {
+					// The security profile of the live tunnel is kept until the dedicated call
+					if tunnel.SecurityType != string(IPSecVPNTunnelSecurityTypeCustom) {
+						return nil, fmt.Errorf("unexpected security type %s", tunnel.SecurityType)
+					}
+					return &govcd.NsxtIpSecVpnTunnel{NsxtIpSecVpn: tunnel}, nil
+				}
+				updateIPSecVPNTunnelSecurityProfile = func(_ fakeIPSecVPNTunnelClient, securityProfile *govcdtypes.NsxtIpSecVpnTunnelSecurityProfile) (*govcdtypes.NsxtIpSecVpnTunnelSecurityProfile, error) {
+					if securityProfile.SecurityType != string(IPSecVPNTunnelSecurityTypeDefault) {
+						return nil, fmt.Errorf("unexpected security type %s", securityProfile.SecurityType)
+					}
+					custom = false
+					return securityProfile, nil
+				}
+			},
+		},
+		{
+			name:          "invalid-tunnel-id",
+			tunnelID:      testIPSecVPNTunnelName,
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           fmt.Errorf("tunnelID has invalid format"),
+		},
+		{
+			name:     "error-update-tunnel",
+			tunnelID: tunnelID,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelEdgeGatewayClient, tunnelNameOrID string) (*govcd.NsxtIpSecVpnTunnel, error) {
+					return testVCDIPSecVPNTunnel(tunnelNameOrID, IPSecVPNTunnelSecurityTypeDefault), nil
+				}
+				updateIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelClient, _ *govcdtypes.NsxtIpSecVpnTunnel) (*govcd.NsxtIpSecVpnTunnel, error) {
+					return nil, fmt.Errorf("error")
+				}
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error updating IPsec VPN tunnel"),
+		},
+		{
+			name:     "error-security-profile-tunnel-restored",
+			tunnelID: tunnelID,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				previous := testVCDIPSecVPNTunnel(tunnelID, IPSecVPNTunnelSecurityTypeCustom)
+				getIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelEdgeGatewayClient, _ string) (*govcd.NsxtIpSecVpnTunnel, error) {
+					return previous, nil
+				}
+				updates := 0
+				updateIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelClient, tunnel *govcdtypes.NsxtIpSecVpnTunnel) (*govcd.NsxtIpSecVpnTunnel, error) {
+					updates++
+					// The second update restores the previous settings
+					if updates == 2 {
+						assert.Equal(t, *previous.NsxtIpSecVpn, *tunnel)
+					}
+					return &govcd.NsxtIpSecVpnTunnel{NsxtIpSecVpn: tunnel}, nil
+				}
+				updateIPSecVPNTunnelSecurityProfile = func(_ fakeIPSecVPNTunnelClient, _ *govcdtypes.NsxtIpSecVpnTunnelSecurityProfile) (*govcdtypes.NsxtIpSecVpnTunnelSecurityProfile, error) {
+					return nil, fmt.Errorf("error")
+				}
+			},
+			expectedError: true,
+			err:           fmt.Errorf("the previous settings of the tunnel have been restored"),
+		},
+		{
+			name:     "error-security-profile-restore-failed",
+			tunnelID: tunnelID,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelEdgeGatewayClient, tunnelNameOrID string) (*govcd.NsxtIpSecVpnTunnel, error) {
+					return testVCDIPSecVPNTunnel(tunnelNameOrID, IPSecVPNTunnelSecurityTypeCustom), nil
+				}
+				updates := 0
+				updateIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelClient, tunnel *govcdtypes.NsxtIpSecVpnTunnel) (*govcd.NsxtIpSecVpnTunnel, error) {
+					updates++
+					if updates == 2 {
+						return nil, fmt.Errorf("error")
+					}
+					return &govcd.NsxtIpSecVpnTunnel{NsxtIpSecVpn: tunnel}, nil
+				}
+				updateIPSecVPNTunnelSecurityProfile = func(_ fakeIPSecVPNTunnelClient, _ *govcdtypes.NsxtIpSecVpnTunnelSecurityProfile) (*govcdtypes.NsxtIpSecVpnTunnelSecurityProfile, error) {
+					return nil, fmt.Errorf("error")
+				}
+			},
+			expectedError: true,
+			err:           fmt.Errorf("restore of the previous settings of the tunnel failed"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mockFunc()
+
+			tunnel, err := c.UpdateIPSecVPNTunnel(context.Background(), edgeGatewayID, test.tunnelID, testIPSecVPNTunnelRequest())
+			if !test.expectedError {
+				assert.NoError(t, err)
+				assert.Equal(t, test.tunnelID, tunnel.ID)
+				assert.Equal(t, IPSecVPNTunnelSecurityTypeDefault, tunnel.SecurityType)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err.Error())
+		})
+	}
+}
+
+func TestClient_DeleteIPSecVPNTunnel(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+	tunnelID := uuid.New().String()
+
+	tests := []struct {
+		name          string
+		tunnelID      string
+		mockFunc      func()
+		expectedError bool
+		err           error
+	}{
+		{
+			name:     testSuccess,
+			tunnelID: tunnelID,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelEdgeGatewayClient, tunnelNameOrID string) (*govcd.NsxtIpSecVpnTunnel, error) {
+					return testVCDIPSecVPNTunnel(tunnelNameOrID, IPSecVPNTunnelSecurityTypeDefault), nil
+				}
+				deleteIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelClient) error {
+					return nil
+				}
+			},
+		},
+		{
+			name:          "empty-tunnel-id",
+			tunnelID:      "",
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           fmt.Errorf("tunnelID is empty"),
+		},
+		{
+			name:     "error-delete-tunnel",
+			tunnelID: tunnelID,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelEdgeGatewayClient, tunnelNameOrID string) (*govcd.NsxtIpSecVpnTunnel, error) {
+					return testVCDIPSecVPNTunnel(tunnelNameOrID, IPSecVPNTunnelSecurityTypeDefault), nil
+				}
+				deleteIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelClient) error {
+					return fmt.Errorf("error")
+				}
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mockFunc()
+
+			err := c.DeleteIPSecVPNTunnel(context.Background(), edgeGatewayID, test.tunnelID)
+			if !test.expectedError {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err.Error())
+		})
+	}
+}
+
+func TestClient_GetTunnelStatus(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+
+	tests := []struct {
+		name           string
+		mockFunc       func()
+		expectedStatus *IPSecVPNTunnelStatusModel
+		expectedUp     bool
+		expectedError  bool
+		err            error
+	}{
+		{
+			name: testSuccess,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelEdgeGatewayClient, _ string) (*govcd.NsxtIpSecVpnTunnel, error) {
+					return testVCDIPSecVPNTunnel(uuid.New().String(), IPSecVPNTunnelSecurityTypeDefault), nil
+				}
+				getIPSecVPNTunnelStatus = func(_ fakeIPSecVPNTunnelClient) (*govcdtypes.NsxtIpSecVpnTunnelStatus, error) {
+					status := &govcdtypes.NsxtIpSecVpnTunnelStatus{TunnelStatus: "UP"}
+					status.IkeStatus.IkeServiceStatus = "UP"
+					return status, nil
+				}
+			},
+			expectedStatus: &IPSecVPNTunnelStatusModel{
+				TunnelStatus: IPSecVPNTunnelStatusUp,
+				IKEStatus:    IPSecVPNTunnelStatusUp,
+			},
+			expectedUp: true,
+		},
+		{
+			name: "success-ike-down",
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelEdgeGatewayClient, _ string) (*govcd.NsxtIpSecVpnTunnel, error) {
+					return testVCDIPSecVPNTunnel(uuid.New().String(), IPSecVPNTunnelSecurityTypeDefault), nil
+				}
+				getIPSecVPNTunnelStatus = func(_ fakeIPSecVPNTunnelClient) (*govcdtypes.NsxtIpSecVpnTunnelStatus, error) {
+					status := &govcdtypes.NsxtIpSecVpnTunnelStatus{TunnelStatus: "DOWN"}
+					status.IkeStatus.IkeServiceStatus = "DOWN"
+					status.IkeStatus.FailReason = "Negotiation timeout"
+					return status, nil
+				}
+			},
+			expectedStatus: &IPSecVPNTunnelStatusModel{
+				TunnelStatus:  IPSecVPNTunnelStatusDown,
+				IKEStatus:     IPSecVPNTunnelStatusDown,
+				IKEFailReason: "Negotiation timeout",
+			},
+		},
+		{
+			name: "error-get-status",
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getIPSecVPNTunnel = func(_ fakeIPSecVPNTunnelEdgeGatewayClient, _ string) (*govcd.NsxtIpSecVpnTunnel, error) {
+					return testVCDIPSecVPNTunnel(uuid.New().String(), IPSecVPNTunnelSecurityTypeDefault), nil
+				}
+				getIPSecVPNTunnelStatus = func(_ fakeIPSecVPNTunnelClient) (*govcdtypes.NsxtIpSecVpnTunnelStatus, error) {
+					return nil, fmt.Errorf("error")
+				}
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error retrieving status of IPsec VPN tunnel"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mockFunc()
+
+			status, err := c.GetTunnelStatus(context.Background(), edgeGatewayID, testIPSecVPNTunnelName)
+			if !test.expectedError {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expectedStatus, status)
+				assert.Equal(t, test.expectedUp, status.IsUp())
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err.Error())
+		})
+	}
+}
@@ -0,0 +1,378 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"net/url"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/utils"
+)
+
+type (
+	fakeIPSecVPNTunnelEdgeGatewayClient interface {
+		GetAllIpSecVpnTunnels(queryParameters url.Values) ([]*govcd.NsxtIpSecVpnTunnel, error)
+		GetIpSecVpnTunnelById(id string) (*govcd.NsxtIpSecVpnTunnel, error)
+		GetIpSecVpnTunnelByName(name string) (*govcd.NsxtIpSecVpnTunnel, error)
+		CreateIpSecVpnTunnel(ipSecVPNConfig *govcdtypes.NsxtIpSecVpnTunnel) (*govcd.NsxtIpSecVpnTunnel, error)
+	}
+
+	fakeIPSecVPNTunnelClient interface {
+		Update(ipSecVPNConfig *govcdtypes.NsxtIpSecVpnTunnel) (*govcd.NsxtIpSecVpnTunnel, error)
+		Delete() error
+		GetStatus() (*govcdtypes.NsxtIpSecVpnTunnelStatus, error)
+		GetTunnelConnectionProperties() (*govcdtypes.NsxtIpSecVpnTunnelSecurityProfile, error)
+		UpdateTunnelConnectionProperties(securityProfile *govcdtypes.NsxtIpSecVpnTunnelSecurityProfile) (*govcdtypes.NsxtIpSecVpnTunnelSecurityProfile, error)
+	}
+
+	// IPSecVPNTunnelModel represents an IPsec VPN tunnel of an edge gateway.
+	IPSecVPNTunnelModel struct {
+		ID string
+
+		// Name of the IPsec VPN tunnel
+		Name string
+
+		// Description of the IPsec VPN tunnel
+		Description string
+
+		// Enabled reports whether the tunnel is enabled.
+		Enabled bool
+
+		// LocalEndpoint is the edge gateway side of the tunnel.
+		LocalEndpoint IPSecVPNTunnelModelLocalEndpoint
+
+		// RemoteEndpoint is the peer side of the tunnel.
+		RemoteEndpoint IPSecVPNTunnelModelRemoteEndpoint
+
+		// AuthenticationMode used by the tunnel.
+		// * IPSecVPNTunnelAuthenticationModePSK - The tunnel uses a pre-shared key.
+		// * IPSecVPNTunnelAuthenticationModeCertificate - The tunnel uses certificates from the organization library.
+		AuthenticationMode IPSecVPNTunnelAuthenticationMode
+
+		// PreSharedKey used when the AuthenticationMode is PSK.
+		PreSharedKey string
+
+		// Certificate is the server certificate used when the AuthenticationMode is CERTIFICATE.
+		Certificate *IPSecVPNTunnelModelCertificateRef
+
+		// CACertificate is the CA certificate used when the AuthenticationMode is CERTIFICATE.
+		CACertificate *IPSecVPNTunnelModelCertificateRef
+
+		// ConnectorInitiationMode defines how the local endpoint initiates the connection.
+		ConnectorInitiationMode IPSecVPNTunnelConnectorInitiationMode
+
+		// Logging reports whether the logging is enabled for the tunnel.
+		Logging bool
+
+		// SecurityType is DEFAULT when the platform security profile is used
+		// and CUSTOM when SecurityProfile has been customized.
+		SecurityType IPSecVPNTunnelSecurityType
+
+		// SecurityProfile is the IKE/IPsec security profile of the tunnel.
+		// Only set when the SecurityType is CUSTOM.
+		SecurityProfile *IPSecVPNTunnelModelSecurityProfile
+	}
+
+	// IPSecVPNTunnelModelLocalEndpoint represents the local endpoint of an IPsec VPN tunnel.
+	IPSecVPNTunnelModelLocalEndpoint struct {
+		// LocalID is the local identifier of the endpoint.
+		LocalID string
+
+		// LocalAddress is the public IP address of the edge gateway used by the tunnel.
+		LocalAddress string
+
+		// LocalNetworks is the list of local networks in CIDR format.
+		LocalNetworks []string
+	}
+
+	// IPSecVPNTunnelModelRemoteEndpoint represents the remote endpoint of an IPsec VPN tunnel.
+	IPSecVPNTunnelModelRemoteEndpoint struct {
+		// RemoteID is the identifier of the peer.
+		RemoteID string
+
+		// RemoteAddress is the public IP address of the peer.
+		RemoteAddress string
+
+		// RemoteNetworks is the list of remote networks in CIDR format.
+		RemoteNetworks []string
+	}
+
+	// IPSecVPNTunnelModelCertificateRef represents a certificate of the organization library.
+	IPSecVPNTunnelModelCertificateRef struct {
+		ID   string
+		Name string
+	}
+
+	// IPSecVPNTunnelModelSecurityProfile represents the IKE/IPsec security profile of a tunnel.
+	IPSecVPNTunnelModelSecurityProfile struct {
+		// IKE contains the Internet Key Exchange settings.
+		IKE IPSecVPNTunnelModelSecurityProfileIKE `validate:"required"`
+
+		// Tunnel contains the IPsec tunnel settings.
+		Tunnel IPSecVPNTunnelModelSecurityProfileTunnel `validate:"required"`
+
+		// DPDProbeInterval is the Dead Peer Detection probe interval in seconds.
+		DPDProbeInterval int `validate:"omitempty,min=3,max=60"`
+	}
+
+	// IPSecVPNTunnelModelSecurityProfileIKE represents the IKE settings of a security profile.
+	IPSecVPNTunnelModelSecurityProfileIKE struct {
+		// Version of the IKE protocol (IKE_V1, IKE_V2, IKE_FLEX).
+		Version string `validate:"required,oneof=IKE_V1 IKE_V2 IKE_FLEX"`
+
+		// EncryptionAlgorithm (AES_128, AES_256, AES_GCM_128, AES_GCM_192, AES_GCM_256).
+		EncryptionAlgorithm string `validate:"required,oneof=AES_128 AES_256 AES_GCM_128 AES_GCM_192 AES_GCM_256"`
+
+		// DigestAlgorithm (SHA1, SHA2_256, SHA2_384, SHA2_512).
+		// Must be empty with an AES_GCM_* encryption algorithm.
+		DigestAlgorithm string `validate:"omitempty,oneof=SHA1 SHA2_256 SHA2_384 SHA2_512"`
+
+		// DHGroup is the Diffie-Hellman group (GROUP2, GROUP5, GROUP14, GROUP15, GROUP16, GROUP19, GROUP20, GROUP21).
+		DHGroup string `validate:"required,oneof=GROUP2 GROUP5 GROUP14 GROUP15 GROUP16 GROUP19 GROUP20 GROUP21"`
+
+		// SALifeTime is the Security Association life time in seconds.
+		SALifeTime int `validate:"omitempty,min=21600,max=31536000"`
+	}
+
+	// IPSecVPNTunnelModelSecurityProfileTunnel represents the IPsec tunnel settings of a security profile.
+	IPSecVPNTunnelModelSecurityProfileTunnel struct {
+		// PerfectForwardSecrecyEnabled enables the Perfect Forward Secrecy.
+		PerfectForwardSecrecyEnabled bool
+
+		// DFPolicy is the policy applied to the defragmentation bit (COPY, CLEAR).
+		DFPolicy string `validate:"omitempty,oneof=COPY CLEAR"`
+
+		// EncryptionAlgorithm (AES_128, AES_256, AES_GCM_128, AES_GCM_192, AES_GCM_256,
+		// NO_ENCRYPTION_AUTH_AES_GMAC_128, NO_ENCRYPTION_AUTH_AES_GMAC_192, NO_ENCRYPTION_AUTH_AES_GMAC_256, NO_ENCRYPTION).
+		EncryptionAlgorithm string `validate:"required,oneof=AES_128 AES_256 AES_GCM_128 AES_GCM_192 AES_GCM_256 NO_ENCRYPTION_AUTH_AES_GMAC_128 NO_ENCRYPTION_AUTH_AES_GMAC_192 NO_ENCRYPTION_AUTH_AES_GMAC_256 NO_ENCRYPTION"`
+
+		// DigestAlgorithm (SHA1, SHA2_256, SHA2_384, SHA2_512).
+		// Must be empty with an AES_GCM_* or NO_ENCRYPTION_AUTH_* encryption algorithm.
+		DigestAlgorithm string `validate:"omitempty,oneof=SHA1 SHA2_256 SHA2_384 SHA2_512"`
+
+		// DHGroup is the Diffie-Hellman group used when the Perfect Forward Secrecy is enabled.
+		DHGroup string `validate:"omitempty,oneof=GROUP2 GROUP5 GROUP14 GROUP15 GROUP16 GROUP19 GROUP20 GROUP21"`
+
+		// SALifeTime is the Security Association life time in seconds.
+		SALifeTime int `validate:"omitempty,min=900,max=31536000"`
+	}
+
+	// IPSecVPNTunnelModelRequest represents the request model for creating or updating an IPsec VPN tunnel.
+	IPSecVPNTunnelModelRequest struct {
+		// Name of the IPsec VPN tunnel
+		Name string `validate:"required"`
+
+		// Description of the IPsec VPN tunnel
+		Description string `validate:"omitempty"`
+
+		// Enabled enables the tunnel.
+		Enabled *bool `validate:"required"`
+
+		// LocalEndpoint is the edge gateway side of the tunnel.
+		LocalEndpoint IPSecVPNTunnelModelRequestLocalEndpoint `validate:"required"`
+
+		// RemoteEndpoint is the peer side of the tunnel.
+		RemoteEndpoint IPSecVPNTunnelModelRequestRemoteEndpoint `validate:"required"`
+
+		// AuthenticationMode used by the tunnel (PSK or CERTIFICATE).
+		AuthenticationMode IPSecVPNTunnelAuthenticationMode `validate:"required,oneof=PSK CERTIFICATE"`
+
+		// PreSharedKey is required when the AuthenticationMode is PSK.
+		PreSharedKey string `validate:"required_if=AuthenticationMode PSK,excluded_unless=AuthenticationMode PSK"`
+
+		// CertificateNameOrID is the name or the ID of the server certificate in the organization library.
+		// Required when the AuthenticationMode is CERTIFICATE.
+		CertificateNameOrID string `validate:"required_if=AuthenticationMode CERTIFICATE,excluded_unless=AuthenticationMode CERTIFICATE"`
+
+		// CACertificateNameOrID is the name or the ID of the CA certificate in the organization library.
+		// Required when the AuthenticationMode is CERTIFICATE.
+		CACertificateNameOrID string `validate:"required_if=AuthenticationMode CERTIFICATE,excluded_unless=AuthenticationMode CERTIFICATE"`
+
+		// ConnectorInitiationMode defines how the local endpoint initiates the connection.
+		// Default value is INITIATOR.
+		ConnectorInitiationMode IPSecVPNTunnelConnectorInitiationMode `validate:"omitempty,oneof=INITIATOR RESPOND_ONLY ON_DEMAND"`
+
+		// Logging enables the logging for the tunnel.
+		Logging bool
+
+		// SecurityProfile customizes the IKE/IPsec security profile of the tunnel.
+		// If nil, the default security profile of the platform is used.
+		SecurityProfile *IPSecVPNTunnelModelSecurityProfile `validate:"omitempty"`
+	}
+
+	// IPSecVPNTunnelModelRequestLocalEndpoint represents the local endpoint of an IPsec VPN tunnel request.
+	IPSecVPNTunnelModelRequestLocalEndpoint struct {
+		// LocalID is the local identifier of the endpoint.
+		// If not set, the LocalAddress is used.
+		LocalID string `validate:"omitempty"`
+
+		// LocalAddress is the public IP address of the edge gateway used by the tunnel.
+		LocalAddress string `validate:"required,ipv4"`
+
+		// LocalNetworks is the list of local networks in CIDR format.
+		LocalNetworks []string `validate:"required,min=1,dive,cidrv4"`
+	}
+
+	// IPSecVPNTunnelModelRequestRemoteEndpoint represents the remote endpoint of an IPsec VPN tunnel request.
+	IPSecVPNTunnelModelRequestRemoteEndpoint struct {
+		// RemoteID is the identifier of the peer.
+		// If not set, the RemoteAddress is used.
+		RemoteID string `validate:"omitempty"`
+
+		// RemoteAddress is the public IP address of the peer.
+		RemoteAddress string `validate:"required,ipv4"`
+
+		// RemoteNetworks is the list of remote networks in CIDR format.
+		// If empty, any remote network is allowed.
+		RemoteNetworks []string `validate:"omitempty,dive,cidrv4"`
+	}
+
+	// IPSecVPNTunnelStatusModel represents the runtime status of an IPsec VPN tunnel.
+	IPSecVPNTunnelStatusModel struct {
+		// TunnelStatus is the status of the IPsec tunnel.
+		TunnelStatus IPSecVPNTunnelStatus
+
+		// IKEStatus is the status of the IKE session.
+		IKEStatus IPSecVPNTunnelStatus
+
+		// IKEFailReason contains the reason of the IKE failure if any.
+		IKEFailReason string
+	}
+
+	IPSecVPNTunnelAuthenticationMode      string
+	IPSecVPNTunnelConnectorInitiationMode string
+	IPSecVPNTunnelSecurityType            string
+	IPSecVPNTunnelStatus                  string
+)
+
+const (
+	// IPSecVPNTunnelAuthenticationModePSK is the authentication mode using a pre-shared key.
+	IPSecVPNTunnelAuthenticationModePSK IPSecVPNTunnelAuthenticationMode = govcdtypes.NsxtIpSecVpnAuthenticationModePSK
+	// IPSecVPNTunnelAuthenticationModeCertificate is the authentication mode using certificates.
+	IPSecVPNTunnelAuthenticationModeCertificate IPSecVPNTunnelAuthenticationMode = govcdtypes.NsxtIpSecVpnAuthenticationModeCertificate
+
+	// IPSecVPNTunnelConnectorInitiationModeInitiator is the mode where the local endpoint initiates the connection.
+	IPSecVPNTunnelConnectorInitiationModeInitiator IPSecVPNTunnelConnectorInitiationMode = "INITIATOR"
+	// IPSecVPNTunnelConnectorInitiationModeRespondOnly is the mode where the local endpoint only responds to the peer.
+	IPSecVPNTunnelConnectorInitiationModeRespondOnly IPSecVPNTunnelConnectorInitiationMode = "RESPOND_ONLY"
+	// IPSecVPNTunnelConnectorInitiationModeOnDemand is the mode where the connection is initiated on traffic.
+	IPSecVPNTunnelConnectorInitiationModeOnDemand IPSecVPNTunnelConnectorInitiationMode = "ON_DEMAND"
+
+	// IPSecVPNTunnelSecurityTypeDefault is the security type of a tunnel using the platform security profile.
+	IPSecVPNTunnelSecurityTypeDefault IPSecVPNTunnelSecurityType = "DEFAULT"
+	// IPSecVPNTunnelSecurityTypeCustom is the security type of a tunnel using a customized security profile.
+	IPSecVPNTunnelSecurityTypeCustom IPSecVPNTunnelSecurityType = "CUSTOM"
+
+	// IPSecVPNTunnelStatusUp is the status of an established tunnel or IKE session.
+	IPSecVPNTunnelStatusUp IPSecVPNTunnelStatus = "UP"
+	// IPSecVPNTunnelStatusDown is the status of a tunnel or IKE session which is not established.
+	IPSecVPNTunnelStatusDown IPSecVPNTunnelStatus = "DOWN"
+	// IPSecVPNTunnelStatusUnknown is the status returned before the first negotiation.
+	IPSecVPNTunnelStatusUnknown IPSecVPNTunnelStatus = "UNKNOWN"
+)
+
+// IsUp reports whether both the IKE session and the IPsec tunnel are established.
+func (s *IPSecVPNTunnelStatusModel) IsUp() bool {
+	return s.IKEStatus == IPSecVPNTunnelStatusUp && s.TunnelStatus == IPSecVPNTunnelStatusUp
+}
+
+// fromVCD converts a VCD IPsec VPN tunnel to the internal IPSecVPNTunnelModel.
+func (m *IPSecVPNTunnelModel) fromVCD(vcdTunnel *govcdtypes.NsxtIpSecVpnTunnel) {
+	if vcdTunnel == nil {
+		return
+	}
+
+	m.ID = vcdTunnel.ID
+	m.Name = vcdTunnel.Name
+	m.Description = vcdTunnel.Description
+	m.Enabled = vcdTunnel.Enabled
+	m.LocalEndpoint = IPSecVPNTunnelModelLocalEndpoint{
+		LocalID:       vcdTunnel.LocalEndpoint.LocalId,
+		LocalAddress:  vcdTunnel.LocalEndpoint.LocalAddress,
+		LocalNetworks: vcdTunnel.LocalEndpoint.LocalNetworks,
+	}
+	m.RemoteEndpoint = IPSecVPNTunnelModelRemoteEndpoint{
+		RemoteID:       vcdTunnel.RemoteEndpoint.RemoteId,
+		RemoteAddress:  vcdTunnel.RemoteEndpoint.RemoteAddress,
+		RemoteNetworks: vcdTunnel.RemoteEndpoint.RemoteNetworks,
+	}
+	m.AuthenticationMode = IPSecVPNTunnelAuthenticationMode(vcdTunnel.AuthenticationMode)
+	m.PreSharedKey = vcdTunnel.PreSharedKey
+	m.ConnectorInitiationMode = IPSecVPNTunnelConnectorInitiationMode(vcdTunnel.ConnectorInitiationMode)
+	m.Logging = vcdTunnel.Logging
+	m.SecurityType = IPSecVPNTunnelSecurityType(vcdTunnel.SecurityType)
+
+	if vcdTunnel.CertificateRef != nil {
+		m.Certificate = &IPSecVPNTunnelModelCertificateRef{
+			ID:   vcdTunnel.CertificateRef.ID,
+			Name: vcdTunnel.CertificateRef.Name,
+		}
+	}
+
+	if vcdTunnel.CaCertificateRef != nil {
+		m.CACertificate = &IPSecVPNTunnelModelCertificateRef{
+			ID:   vcdTunnel.CaCertificateRef.ID,
+			Name: vcdTunnel.CaCertificateRef.Name,
+		}
+	}
+}
+
+// fromVCD converts a VCD IPsec VPN tunnel security profile to the internal IPSecVPNTunnelModelSecurityProfile.
+func (m *IPSecVPNTunnelModelSecurityProfile) fromVCD(vcdProfile *govcdtypes.NsxtIpSecVpnTunnelSecurityProfile) {
+	if vcdProfile == nil {
+		return
+	}
+
+	// The API accepts lists but only supports a single value.
+	m.IKE = IPSecVPNTunnelModelSecurityProfileIKE{
+		Version:             vcdProfile.IkeConfiguration.IkeVersion,
+		EncryptionAlgorithm: utils.First(vcdProfile.IkeConfiguration.EncryptionAlgorithms),
+		DigestAlgorithm:     utils.First(vcdProfile.IkeConfiguration.DigestAlgorithms),
+		DHGroup:             utils.First(vcdProfile.IkeConfiguration.DhGroups),
+		SALifeTime:          utils.FromPTR(vcdProfile.IkeConfiguration.SaLifeTime),
+	}
+	m.Tunnel = IPSecVPNTunnelModelSecurityProfileTunnel{
+		PerfectForwardSecrecyEnabled: vcdProfile.TunnelConfiguration.PerfectForwardSecrecyEnabled,
+		DFPolicy:                     vcdProfile.TunnelConfiguration.DfPolicy,
+		EncryptionAlgorithm:          utils.First(vcdProfile.TunnelConfiguration.EncryptionAlgorithms),
+		DigestAlgorithm:              utils.First(vcdProfile.TunnelConfiguration.DigestAlgorithms),
+		DHGroup:                      utils.First(vcdProfile.TunnelConfiguration.DhGroups),
+		SALifeTime:                   utils.FromPTR(vcdProfile.TunnelConfiguration.SaLifeTime),
+	}
+	m.DPDProbeInterval = vcdProfile.DpdConfiguration.ProbeInterval
+}
+
+// toVCD converts the IPSecVPNTunnelModelSecurityProfile to a VCD custom security profile.
+func (m *IPSecVPNTunnelModelSecurityProfile) toVCD() *govcdtypes.NsxtIpSecVpnTunnelSecurityProfile {
+	// Empty values are sent as empty lists to let the platform apply its defaults.
+	profile := &govcdtypes.NsxtIpSecVpnTunnelSecurityProfile{
+		SecurityType: string(IPSecVPNTunnelSecurityTypeCustom),
+		IkeConfiguration: govcdtypes.NsxtIpSecVpnTunnelProfileIkeConfiguration{
+			IkeVersion:           m.IKE.Version,
+			EncryptionAlgorithms: utils.ToSliceOrNil(m.IKE.EncryptionAlgorithm),
+			DigestAlgorithms:     utils.ToSliceOrNil(m.IKE.DigestAlgorithm),
+			DhGroups:             utils.ToSliceOrNil(m.IKE.DHGroup),
+			SaLifeTime:           utils.ToPTROrNil(m.IKE.SALifeTime),
+		},
+		TunnelConfiguration: govcdtypes.NsxtIpSecVpnTunnelProfileTunnelConfiguration{
+			PerfectForwardSecrecyEnabled: m.Tunnel.PerfectForwardSecrecyEnabled,
+			DfPolicy:                     m.Tunnel.DFPolicy,
+			EncryptionAlgorithms:         utils.ToSliceOrNil(m.Tunnel.EncryptionAlgorithm),
+			DigestAlgorithms:             utils.ToSliceOrNil(m.Tunnel.DigestAlgorithm),
+			DhGroups:                     utils.ToSliceOrNil(m.Tunnel.DHGroup),
+			SaLifeTime:                   utils.ToPTROrNil(m.Tunnel.SALifeTime),
+		},
+	}
+	profile.DpdConfiguration.ProbeInterval = m.DPDProbeInterval
+
+	return profile
+}
@@ -0,0 +1,193 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/endpoints"
+	commoncloudavenue "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/common/cloudavenue"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+)
+
+var listNATRules = func(edgeClient fakeNATRuleEdgeGatewayClient) ([]*govcd.NsxtNatRule, error) {
+	return edgeClient.GetAllNatRules(nil)
+}
+
+// ListPublicIPs retrieves the public IPs attached to the edge gateway.
+func (e *EdgeGateway) ListPublicIPs(ctx context.Context) ([]*PublicIPModel, error) {
+	if err := e.getNetworkServices(ctx); err != nil {
+		return nil, err
+	}
+
+	publicIPs := make([]*PublicIPModel, 0, len(e.EdgeGatewayModel.Services.PublicIP))
+	for _, svc := range e.EdgeGatewayModel.Services.PublicIP {
+		publicIP := &PublicIPModel{}
+		publicIP.fromNetworkService(svc)
+		publicIPs = append(publicIPs, publicIP)
+	}
+
+	return publicIPs, nil
+}
+
+// AllocatePublicIP allocates a new public IP on the edge gateway.
+// It waits for the allocation job to finish and returns the new public IP.
+func (e *EdgeGateway) AllocatePublicIP(ctx context.Context) (*PublicIPModel, error) {
+	// Get the list of public IPs before the allocation. It's used to retrieve the new public IP.
+	publicIPs, err := e.ListPublicIPs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := e.R().
+		SetContext(ctx).
+		SetBody(publicIPCreationRequest{
+			NetworkType: "internet",
+			EdgeGateway: urn.ExtractUUID(e.ID),
+		}).
+		SetResult(&commoncloudavenue.JobStatus{}).
+		SetError(&commoncloudavenue.APIErrorResponse{}).
+		Post(endpoints.NetworkServiceCreate)
+	if err != nil {
+		return nil, fmt.Errorf("error allocating public IP on edge gateway %s: %w", e.Name, err)
+	}
+
+	if r.IsError() {
+		return nil, fmt.Errorf("error allocating public IP on edge gateway %s: %w", e.Name, commoncloudavenue.ToError(r))
+	}
+
+	job := r.Result().(*commoncloudavenue.JobStatus)
+
+	// Wait for the job to finish
+	if err := job.WaitWithContext(ctx, 2); err != nil {
+		return nil, fmt.Errorf("error allocating public IP on edge gateway %s: %w", e.Name, err)
+	}
+
+	publicIPsRefreshed, err := e.ListPublicIPs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, publicIPRefreshed := range publicIPsRefreshed {
+		found := false
+		for _, publicIP := range publicIPs {
+			if publicIPRefreshed.ID == publicIP.ID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return publicIPRefreshed, nil
+		}
+	}
+
+	return nil, fmt.Errorf("allocated public IP %w on edge gateway %s", errors.ErrNotFound, e.Name)
+}
+
+// ReleasePublicIP releases a public IP of the edge gateway.
+// It refuses to release a public IP still referenced by NAT rules and
+// waits for the release job to finish.
+func (e *EdgeGateway) ReleasePublicIP(ctx context.Context, ip string) error {
+	if ip == "" {
+		return fmt.Errorf("ip is %w. Please provide a valid public IP", errors.ErrEmpty)
+	}
+
+	if net.ParseIP(ip) == nil {
+		return fmt.Errorf("ip %s has %w. Please provide a valid public IP", ip, errors.ErrInvalidFormat)
+	}
+
+	publicIPs, err := e.ListPublicIPs(ctx)
+	if err != nil {
+		return err
+	}
+
+	var publicIP *PublicIPModel
+	for _, p := range publicIPs {
+		if p.IP == ip {
+			publicIP = p
+			break
+		}
+	}
+
+	if publicIP == nil {
+		return fmt.Errorf("public IP %s %w on edge gateway %s", ip, errors.ErrNotFound, e.Name)
+	}
+
+	edgeGateway, err := e.getVCDEdgeGateway(ctx)
+	if err != nil {
+		return err
+	}
+
+	natRules, err := listNATRules(edgeGateway)
+	if err != nil {
+		return fmt.Errorf("error retrieving NAT rules of edge gateway %s: %w", e.Name, err)
+	}
+
+	if names := natRulesReferencingIP(natRules, ip); len(names) > 0 {
+		return fmt.Errorf("public IP %s is used by %s: %w", ip, strings.Join(names, ", "), errors.ErrEdgeGatewayPublicIPInUse)
+	}
+
+	r, err := e.R().
+		SetContext(ctx).
+		SetResult(&commoncloudavenue.JobStatus{}).
+		SetError(&commoncloudavenue.APIErrorResponse{}).
+		Delete(endpoints.InlineTemplate(endpoints.NetworkServiceDelete, map[string]string{
+			serviceIDKey: publicIP.ID,
+		}))
+	if err != nil {
+		return fmt.Errorf("error releasing public IP %s: %w", ip, err)
+	}
+
+	if r.IsError() {
+		return fmt.Errorf("error releasing public IP %s: %w", ip, commoncloudavenue.ToError(r))
+	}
+
+	job := r.Result().(*commoncloudavenue.JobStatus)
+
+	// Wait for the job to finish
+	if err := job.WaitWithContext(ctx, 2); err != nil {
+		return fmt.Errorf("error releasing public IP %s: %w", ip, err)
+	}
+
+	return e.getNetworkServices(ctx)
+}
+
+// natRulesReferencingIP returns the names of the NAT rules whose external addresses
+// contain the IP. External addresses can be a single IP or a CIDR.
+func natRulesReferencingIP(natRules []*govcd.NsxtNatRule, ip string) []string {
+	parsedIP := net.ParseIP(ip)
+
+	names := make([]string, 0)
+	for _, natRule := range natRules {
+		if natRule == nil || natRule.NsxtNatRule == nil {
+			continue
+		}
+
+		externalAddresses := natRule.NsxtNatRule.ExternalAddresses
+		if _, network, err := net.ParseCIDR(externalAddresses); err == nil {
+			if network.Contains(parsedIP) {
+				names = append(names, natRule.NsxtNatRule.Name)
+			}
+			continue
+		}
+
+		if externalIP := net.ParseIP(externalAddresses); externalIP != nil && externalIP.Equal(parsedIP) {
+			names = append(names, natRule.NsxtNatRule.Name)
+		}
+	}
+
+	return names
+}
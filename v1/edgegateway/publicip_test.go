@@ -0,0 +1,350 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/google/uuid"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/endpoints"
+	clientcloudavenue "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/clients/cloudavenue"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+)
+
+const testPublicIP2 = "12.123.123.13"
+
+// testNetworkServicesPublicIPs returns a network services response
+// where the edge gateway has the given public IPs.
+func testNetworkServicesPublicIPs(edgeGatewayID string, ips ...string) json.RawMessage {
+	services := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		services = append(services, fmt.Sprintf(`{
+			"type": "service",
+			"name": "internet",
+			"displayName": "internet",
+			"properties": {"ip": "%s", "announced": true},
+			"children": [],
+			"serviceId": "ip-%s"
+		}`, ip, strings.ReplaceAll(ip, ".", "-")))
+	}
+
+	return json.RawMessage(fmt.Sprintf(`[{
+		"type": "tier-0-vrf",
+		"name": "%s",
+		"children": [{
+			"type": "edge-gateway",
+			"name": "%s",
+			"properties": {"rateLimit": 5, "edgeUUID": "%s"},
+			"children": [%s]
+		}]
+	}]`, testVRFName, testEdgeGatewayName, urn.ExtractUUID(edgeGatewayID), strings.Join(services, ",")))
+}
+
+// testMockPublicIPJob registers the responders of a public IP job.
+func testMockPublicIPJob(t *testing.T, method, url, status string) {
+	t.Helper()
+
+	jobStatusID := uuid.New().String()
+
+	responder, err := httpmock.NewJsonResponder(200, json.RawMessage(`{"jobId":"`+jobStatusID+`"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpmock.RegisterResponder(method, url, responder)
+
+	responderJob, err := httpmock.NewJsonResponder(200, json.RawMessage(`[{"actions":[{"name":"internet","status":"`+status+`","details":"job details"}],"description":"string","name":"string","status":"`+status+`"}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpmock.RegisterResponder("GET", endpoints.InlineTemplate(endpoints.JobStatusGet, map[string]string{testJobID: jobStatusID}), responderJob)
+}
+
+func TestEdgeGateway_ListPublicIPs(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	defer httpmock.DeactivateAndReset()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	e := newFakeEdgeGatewayClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+
+	clientCAV.EXPECT().R().DoAndReturn(func() *resty.Request {
+		httpmock.ActivateNonDefault(clientcloudavenue.MockClient().GetClient())
+		responder, err := httpmock.NewJsonResponder(200, testNetworkServicesPublicIPs(edgeGatewayID, testIPAddress, testPublicIP2))
+		if err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", endpoints.NetworkServiceGet, responder)
+		return clientcloudavenue.MockClient().R()
+	})
+
+	e.EdgeGatewayModel = &EdgeGatewayModel{
+		ID:       edgeGatewayID,
+		Name:     testEdgeGatewayName,
+		UplinkT0: testVRFName,
+	}
+
+	publicIPs, err := e.ListPublicIPs(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []*PublicIPModel{
+		{ID: "ip-12-123-123-12", IP: testIPAddress, Announced: true},
+		{ID: "ip-12-123-123-13", IP: testPublicIP2, Announced: true},
+	}, publicIPs)
+}
+
+func TestEdgeGateway_AllocatePublicIP(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	defer httpmock.DeactivateAndReset()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	e := newFakeEdgeGatewayClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+
+	tests := []struct {
+		name          string
+		mockFunc      func()
+		expected      *PublicIPModel
+		expectedError bool
+		err           error
+	}{
+		{
+			name: testSuccess,
+			mockFunc: func() {
+				httpmock.ActivateNonDefault(clientcloudavenue.MockClient().GetClient())
+
+				// * mock getNetworkServices, the second call returns the new public IP
+				calls := 0
+				httpmock.RegisterResponder("GET", endpoints.NetworkServiceGet, func(_ *http.Request) (*http.Response, error) {
+					calls++
+					if calls == 1 {
+						return httpmock.NewJsonResponse(200, testNetworkServicesPublicIPs(edgeGatewayID, testIPAddress))
+					}
+					return httpmock.NewJsonResponse(200, testNetworkServicesPublicIPs(edgeGatewayID, testIPAddress, testPublicIP2))
+				})
+
+				// * mock allocation job
+				testMockPublicIPJob(t, "POST", endpoints.NetworkServiceCreate, "DONE")
+
+				clientCAV.EXPECT().R().DoAndReturn(clientcloudavenue.MockClient().R).Times(3)
+			},
+			expected: &PublicIPModel{ID: "ip-12-123-123-13", IP: testPublicIP2, Announced: true},
+		},
+		{
+			name: "error-job-failed",
+			mockFunc: func() {
+				clientCAV.EXPECT().R().DoAndReturn(func() *resty.Request {
+					httpmock.ActivateNonDefault(clientcloudavenue.MockClient().GetClient())
+					responder, err := httpmock.NewJsonResponder(200, testNetworkServicesPublicIPs(edgeGatewayID, testIPAddress))
+					if err != nil {
+						t.Fatal(err)
+					}
+					httpmock.RegisterResponder("GET", endpoints.NetworkServiceGet, responder)
+
+					// * mock allocation job
+					testMockPublicIPJob(t, "POST", endpoints.NetworkServiceCreate, "FAILED")
+					return clientcloudavenue.MockClient().R()
+				}).Times(2)
+			},
+			expectedError: true,
+			err:           errors.ErrJobFailed,
+		},
+		{
+			name: "error-allocate",
+			mockFunc: func() {
+				clientCAV.EXPECT().R().DoAndReturn(func() *resty.Request {
+					httpmock.ActivateNonDefault(clientcloudavenue.MockClient().GetClient())
+					responder, err := httpmock.NewJsonResponder(200, testNetworkServicesPublicIPs(edgeGatewayID, testIPAddress))
+					if err != nil {
+						t.Fatal(err)
+					}
+					httpmock.RegisterResponder("GET", endpoints.NetworkServiceGet, responder)
+					httpmock.RegisterResponder("POST", endpoints.NetworkServiceCreate, httpmock.NewErrorResponder(fmt.Errorf("error")))
+					return clientcloudavenue.MockClient().R()
+				}).Times(2)
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error allocating public IP"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			httpmock.Reset()
+			test.mockFunc()
+
+			e.EdgeGatewayModel = &EdgeGatewayModel{
+				ID:       edgeGatewayID,
+				Name:     testEdgeGatewayName,
+				UplinkT0: testVRFName,
+			}
+
+			publicIP, err := e.AllocatePublicIP(context.Background())
+			if !test.expectedError {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, publicIP)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err.Error())
+		})
+	}
+}
+
+func TestEdgeGateway_ReleasePublicIP(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	defer httpmock.DeactivateAndReset()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	e := newFakeEdgeGatewayClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+
+	mockNetworkServices := func() *resty.Request {
+		httpmock.ActivateNonDefault(clientcloudavenue.MockClient().GetClient())
+		responder, err := httpmock.NewJsonResponder(200, testNetworkServicesPublicIPs(edgeGatewayID, testIPAddress))
+		if err != nil {
+			t.Fatal(err)
+		}
+		httpmock.RegisterResponder("GET", endpoints.NetworkServiceGet, responder)
+		return clientcloudavenue.MockClient().R()
+	}
+
+	natRule := func(name, externalAddresses string) *govcd.NsxtNatRule {
+		return &govcd.NsxtNatRule{NsxtNatRule: &govcdtypes.NsxtNatRule{Name: name, ExternalAddresses: externalAddresses}}
+	}
+
+	tests := []struct {
+		name          string
+		ip            string
+		mockFunc      func()
+		expectedError bool
+		err           error
+	}{
+		{
+			name: testSuccess,
+			ip:   testIPAddress,
+			mockFunc: func() {
+				clientCAV.EXPECT().R().DoAndReturn(func() *resty.Request {
+					r := mockNetworkServices()
+					testMockPublicIPJob(t, "DELETE", endpoints.InlineTemplate(endpoints.NetworkServiceDelete, map[string]string{testServiceID: "ip-12-123-123-12"}), "DONE")
+					return r
+				}).Times(3)
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				listNATRules = func(_ fakeNATRuleEdgeGatewayClient) ([]*govcd.NsxtNatRule, error) {
+					return []*govcd.NsxtNatRule{natRule("other-ip", testPublicIP2)}, nil
+				}
+			},
+		},
+		{
+			name:          "error-empty-ip",
+			ip:            "",
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           errors.ErrEmpty,
+		},
+		{
+			name:          "error-invalid-ip",
+			ip:            "not-an-ip",
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           errors.ErrInvalidFormat,
+		},
+		{
+			name: "error-not-found",
+			ip:   testPublicIP2,
+			mockFunc: func() {
+				clientCAV.EXPECT().R().DoAndReturn(mockNetworkServices)
+			},
+			expectedError: true,
+			err:           errors.ErrNotFound,
+		},
+		{
+			name: "error-used-by-dnat-rule",
+			ip:   testIPAddress,
+			mockFunc: func() {
+				clientCAV.EXPECT().R().DoAndReturn(mockNetworkServices)
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				listNATRules = func(_ fakeNATRuleEdgeGatewayClient) ([]*govcd.NsxtNatRule, error) {
+					return []*govcd.NsxtNatRule{natRule("dnat-web", testIPAddress)}, nil
+				}
+			},
+			expectedError: true,
+			err:           errors.ErrEdgeGatewayPublicIPInUse,
+		},
+		{
+			name: "error-used-by-snat-rule-cidr",
+			ip:   testIPAddress,
+			mockFunc: func() {
+				clientCAV.EXPECT().R().DoAndReturn(mockNetworkServices)
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				listNATRules = func(_ fakeNATRuleEdgeGatewayClient) ([]*govcd.NsxtNatRule, error) {
+					return []*govcd.NsxtNatRule{natRule("snat-all", testIPAddress+"/32")}, nil
+				}
+			},
+			expectedError: true,
+			err:           errors.ErrEdgeGatewayPublicIPInUse,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			httpmock.Reset()
+			test.mockFunc()
+
+			e.EdgeGatewayModel = &EdgeGatewayModel{
+				ID:       edgeGatewayID,
+				Name:     testEdgeGatewayName,
+				UplinkT0: testVRFName,
+			}
+
+			err := e.ReleasePublicIP(context.Background(), test.ip)
+			if !test.expectedError {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			assert.ErrorIs(t, err, test.err)
+			if test.err == errors.ErrEdgeGatewayPublicIPInUse {
+				assert.True(t, errors.IsConflict(err))
+			}
+		})
+	}
+}
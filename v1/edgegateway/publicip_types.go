@@ -0,0 +1,51 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"net/url"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+type (
+	fakeNATRuleEdgeGatewayClient interface {
+		GetAllNatRules(queryParameters url.Values) ([]*govcd.NsxtNatRule, error)
+	}
+
+	// PublicIPModel represents a public IP address attached to an edge gateway.
+	PublicIPModel struct {
+		// ID is the identifier of the internet service providing the public IP.
+		ID string
+
+		// IP is the public IP address.
+		IP string
+
+		// Announced reports whether the public IP address is announced.
+		Announced bool
+	}
+
+	// publicIPCreationRequest is the body sent to the network services API to allocate a public IP.
+	publicIPCreationRequest struct {
+		NetworkType string `json:"networkType"`
+		EdgeGateway string `json:"edgeGateway"`
+	}
+)
+
+// fromNetworkService converts a network service public IP to the PublicIPModel.
+func (m *PublicIPModel) fromNetworkService(svc *NetworkServicesModelSvcPublicIP) {
+	if svc == nil {
+		return
+	}
+
+	m.ID = svc.ID
+	m.IP = svc.IP
+	m.Announced = svc.Announced
+}
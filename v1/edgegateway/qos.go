@@ -0,0 +1,196 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+)
+
+var (
+	getQoS = func(edgeClient fakeQoSEdgeGatewayClient) (*govcdtypes.NsxtEdgeGatewayQos, error) {
+		return edgeClient.GetQoS()
+	}
+
+	updateQoS = func(edgeClient fakeQoSEdgeGatewayClient, qos *govcdtypes.NsxtEdgeGatewayQos) (*govcdtypes.NsxtEdgeGatewayQos, error) {
+		return edgeClient.UpdateQoS(qos)
+	}
+)
+
+// ListQoSProfiles retrieves the gateway QoS profiles available for the edge gateway.
+func (e *EdgeGateway) ListQoSProfiles(_ context.Context) ([]*QoSProfileModel, error) {
+	if err := e.Refresh(); err != nil {
+		return nil, err
+	}
+
+	queryParameters := url.Values{}
+	queryParameters.Set("filter", "_context=="+e.ID)
+
+	profiles := make([]*QoSProfileModel, 0)
+	for page := 1; ; page++ {
+		queryParameters.Set("page", strconv.Itoa(page))
+
+		result, err := e.OpenAPIGetPage(govcdtypes.OpenApiPathVersion1_0_0+govcdtypes.OpenApiEndpointQosProfiles, queryParameters)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving QoS profiles of edge gateway %s: %w", e.Name, err)
+		}
+
+		vcdProfiles := make([]*govcdtypes.NsxtEdgeGatewayQosProfile, 0)
+		if len(result.Values) > 0 {
+			if err := json.Unmarshal(result.Values, &vcdProfiles); err != nil {
+				return nil, fmt.Errorf("error decoding QoS profiles: %w", err)
+			}
+		}
+
+		for _, vcdProfile := range vcdProfiles {
+			profile := &QoSProfileModel{}
+			profile.fromVCD(vcdProfile)
+			profiles = append(profiles, profile)
+		}
+
+		if page >= result.PageCount {
+			return profiles, nil
+		}
+	}
+}
+
+// GetQoS retrieves the QoS configuration of the edge gateway.
+func (e *EdgeGateway) GetQoS(ctx context.Context) (*QoSModel, error) {
+	edgeGateway, err := e.getVCDEdgeGateway(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	qos, err := getQoS(edgeGateway)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving QoS of edge gateway %s: %w", e.Name, err)
+	}
+
+	// Nothing to resolve when the traffic is unlimited in both directions
+	if qos.IngressProfile == nil && qos.EgressProfile == nil {
+		return &QoSModel{}, nil
+	}
+
+	profiles, err := e.ListQoSProfiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QoSModel{
+		IngressProfile: findQoSProfileByRef(profiles, qos.IngressProfile),
+		EgressProfile:  findQoSProfileByRef(profiles, qos.EgressProfile),
+	}, nil
+}
+
+// UpdateQoS updates the QoS configuration of the edge gateway.
+// The profiles are referenced by name or ID, an empty profile removes the rate limiting in that direction.
+func (e *EdgeGateway) UpdateQoS(ctx context.Context, qos QoSModelRequest) (*QoSModel, error) {
+	var (
+		ingress, egress *QoSProfileModel
+		err             error
+	)
+
+	if qos.IngressProfile != "" || qos.EgressProfile != "" {
+		profiles, err := e.ListQoSProfiles(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if ingress, err = findQoSProfile(profiles, qos.IngressProfile); err != nil {
+			return nil, err
+		}
+
+		if egress, err = findQoSProfile(profiles, qos.EgressProfile); err != nil {
+			return nil, err
+		}
+	}
+
+	edgeGateway, err := e.getVCDEdgeGateway(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := updateQoS(edgeGateway, &govcdtypes.NsxtEdgeGatewayQos{
+		IngressProfile: ingress.toRef(),
+		EgressProfile:  egress.toRef(),
+	}); err != nil {
+		return nil, fmt.Errorf("error updating QoS of edge gateway %s: %w", e.Name, err)
+	}
+
+	return &QoSModel{
+		IngressProfile: ingress,
+		EgressProfile:  egress,
+	}, nil
+}
+
+// DeleteQoS removes the rate limiting of the edge gateway in both directions.
+func (e *EdgeGateway) DeleteQoS(ctx context.Context) error {
+	edgeGateway, err := e.getVCDEdgeGateway(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Null profiles reset the QoS to unlimited
+	if _, err := updateQoS(edgeGateway, &govcdtypes.NsxtEdgeGatewayQos{}); err != nil {
+		return fmt.Errorf("error deleting QoS of edge gateway %s: %w", e.Name, err)
+	}
+
+	return nil
+}
+
+// * Local functions
+
+// findQoSProfile returns the QoS profile matching the name or the ID.
+// It returns nil when nameOrID is empty.
+func findQoSProfile(profiles []*QoSProfileModel, nameOrID string) (*QoSProfileModel, error) {
+	if nameOrID == "" {
+		return nil, nil
+	}
+
+	for _, profile := range profiles {
+		if profile.ID == nameOrID || profile.Name == nameOrID {
+			return profile, nil
+		}
+	}
+
+	return nil, fmt.Errorf("QoS profile %s %w", nameOrID, errors.ErrNotFound)
+}
+
+// findQoSProfileByRef returns the QoS profile of the reference.
+// A profile missing from the list is returned with the ID and the name of the reference only.
+func findQoSProfileByRef(profiles []*QoSProfileModel, ref *govcdtypes.OpenApiReference) *QoSProfileModel {
+	if ref == nil {
+		return nil
+	}
+
+	for _, profile := range profiles {
+		if profile.ID == ref.ID {
+			return profile
+		}
+	}
+
+	return &QoSProfileModel{ID: ref.ID, Name: ref.Name}
+}
+
+// toRef returns the reference of the QoS profile, nil for no profile.
+func (m *QoSProfileModel) toRef() *govcdtypes.OpenApiReference {
+	if m == nil {
+		return nil
+	}
+
+	return &govcdtypes.OpenApiReference{ID: m.ID, Name: m.Name}
+}
@@ -0,0 +1,290 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+)
+
+const (
+	testQoSProfileName  = "test-qos-100M"
+	testQoSProfileName2 = "test-qos-200M"
+)
+
+// testQoSProfilesPage returns a page of two QoS profiles.
+func testQoSProfilesPage(t *testing.T, profileID, profileID2 string) *govcdtypes.OpenApiPages {
+	t.Helper()
+
+	values, err := json.Marshal([]*govcdtypes.NsxtEdgeGatewayQosProfile{
+		{ID: profileID, DisplayName: testQoSProfileName, CommittedBandwidth: 100, BurstSize: 1000000, ExcessAction: "DROP"},
+		{ID: profileID2, DisplayName: testQoSProfileName2, CommittedBandwidth: 200, BurstSize: 2000000, ExcessAction: "DROP"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &govcdtypes.OpenApiPages{Page: 1, PageCount: 1, Values: values}
+}
+
+func TestEdgeGateway_ListQoSProfiles(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	e := newFakeEdgeGatewayClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	profileID := uuid.New().String()
+	profileID2 := uuid.New().String()
+
+	e.EdgeGatewayModel = &EdgeGatewayModel{
+		ID:   edgeGatewayID,
+		Name: testEdgeGatewayName,
+	}
+
+	clientCAV.EXPECT().Refresh().Return(nil)
+	clientCAV.EXPECT().OpenAPIGetPage(govcdtypes.OpenApiPathVersion1_0_0+govcdtypes.OpenApiEndpointQosProfiles, gomock.Any()).DoAndReturn(func(_ string, queryParameters url.Values) (*govcdtypes.OpenApiPages, error) {
+		assert.Equal(t, "_context=="+edgeGatewayID, queryParameters.Get("filter"))
+		return testQoSProfilesPage(t, profileID, profileID2), nil
+	})
+
+	profiles, err := e.ListQoSProfiles(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []*QoSProfileModel{
+		{ID: profileID, Name: testQoSProfileName, CommittedBandwidth: 100, BurstSize: 1000000, ExcessAction: "DROP"},
+		{ID: profileID2, Name: testQoSProfileName2, CommittedBandwidth: 200, BurstSize: 2000000, ExcessAction: "DROP"},
+	}, profiles)
+}
+
+func TestEdgeGateway_GetQoS(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	e := newFakeEdgeGatewayClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+	profileID := uuid.New().String()
+	profileID2 := uuid.New().String()
+
+	tests := []struct {
+		name          string
+		mockFunc      func()
+		expected      *QoSModel
+		expectedError bool
+		err           error
+	}{
+		{
+			name: testSuccess,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil).Times(2)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getQoS = func(_ fakeQoSEdgeGatewayClient) (*govcdtypes.NsxtEdgeGatewayQos, error) {
+					return &govcdtypes.NsxtEdgeGatewayQos{
+						IngressProfile: &govcdtypes.OpenApiReference{ID: profileID, Name: testQoSProfileName},
+					}, nil
+				}
+				clientCAV.EXPECT().OpenAPIGetPage(gomock.Any(), gomock.Any()).Return(testQoSProfilesPage(t, profileID, profileID2), nil)
+			},
+			expected: &QoSModel{
+				IngressProfile: &QoSProfileModel{ID: profileID, Name: testQoSProfileName, CommittedBandwidth: 100, BurstSize: 1000000, ExcessAction: "DROP"},
+			},
+		},
+		{
+			name: "success-unlimited",
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getQoS = func(_ fakeQoSEdgeGatewayClient) (*govcdtypes.NsxtEdgeGatewayQos, error) {
+					return &govcdtypes.NsxtEdgeGatewayQos{}, nil
+				}
+			},
+			expected: &QoSModel{},
+		},
+		{
+			name: "error-get-qos",
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getQoS = func(_ fakeQoSEdgeGatewayClient) (*govcdtypes.NsxtEdgeGatewayQos, error) {
+					return nil, fmt.Errorf("error")
+				}
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error retrieving QoS"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mockFunc()
+
+			e.EdgeGatewayModel = &EdgeGatewayModel{
+				ID:   edgeGatewayID,
+				Name: testEdgeGatewayName,
+			}
+
+			qos, err := e.GetQoS(context.Background())
+			if !test.expectedError {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, qos)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err.Error())
+		})
+	}
+}
+
+func TestEdgeGateway_UpdateQoS(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	e := newFakeEdgeGatewayClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+	profileID := uuid.New().String()
+	profileID2 := uuid.New().String()
+
+	var updated *govcdtypes.NsxtEdgeGatewayQos
+	updateQoS = func(_ fakeQoSEdgeGatewayClient, qos *govcdtypes.NsxtEdgeGatewayQos) (*govcdtypes.NsxtEdgeGatewayQos, error) {
+		updated = qos
+		return qos, nil
+	}
+
+	tests := []struct {
+		name          string
+		request       QoSModelRequest
+		mockFunc      func()
+		expected      *govcdtypes.NsxtEdgeGatewayQos
+		expectedError bool
+		err           error
+	}{
+		{
+			name: testSuccess,
+			request: QoSModelRequest{
+				IngressProfile: testQoSProfileName,
+				EgressProfile:  profileID2,
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil).Times(2)
+				clientCAV.EXPECT().OpenAPIGetPage(gomock.Any(), gomock.Any()).Return(testQoSProfilesPage(t, profileID, profileID2), nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+			},
+			expected: &govcdtypes.NsxtEdgeGatewayQos{
+				IngressProfile: &govcdtypes.OpenApiReference{ID: profileID, Name: testQoSProfileName},
+				EgressProfile:  &govcdtypes.OpenApiReference{ID: profileID2, Name: testQoSProfileName2},
+			},
+		},
+		{
+			name: "success-ingress-only",
+			request: QoSModelRequest{
+				IngressProfile: testQoSProfileName,
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil).Times(2)
+				clientCAV.EXPECT().OpenAPIGetPage(gomock.Any(), gomock.Any()).Return(testQoSProfilesPage(t, profileID, profileID2), nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+			},
+			expected: &govcdtypes.NsxtEdgeGatewayQos{
+				IngressProfile: &govcdtypes.OpenApiReference{ID: profileID, Name: testQoSProfileName},
+			},
+		},
+		{
+			name: "error-unknown-profile",
+			request: QoSModelRequest{
+				EgressProfile: "unknown",
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().OpenAPIGetPage(gomock.Any(), gomock.Any()).Return(testQoSProfilesPage(t, profileID, profileID2), nil)
+			},
+			expectedError: true,
+			err:           errors.ErrNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			updated = nil
+			test.mockFunc()
+
+			e.EdgeGatewayModel = &EdgeGatewayModel{
+				ID:   edgeGatewayID,
+				Name: testEdgeGatewayName,
+			}
+
+			_, err := e.UpdateQoS(context.Background(), test.request)
+			if !test.expectedError {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, updated)
+				return
+			}
+			assert.ErrorIs(t, err, test.err)
+			assert.Nil(t, updated)
+		})
+	}
+}
+
+func TestEdgeGateway_DeleteQoS(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	e := newFakeEdgeGatewayClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+
+	e.EdgeGatewayModel = &EdgeGatewayModel{
+		ID:   edgeGatewayID,
+		Name: testEdgeGatewayName,
+	}
+
+	clientCAV.EXPECT().Refresh().Return(nil)
+	clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+
+	var updated *govcdtypes.NsxtEdgeGatewayQos
+	updateQoS = func(_ fakeQoSEdgeGatewayClient, qos *govcdtypes.NsxtEdgeGatewayQos) (*govcdtypes.NsxtEdgeGatewayQos, error) {
+		updated = qos
+		return qos, nil
+	}
+
+	assert.NoError(t, e.DeleteQoS(context.Background()))
+	assert.Equal(t, &govcdtypes.NsxtEdgeGatewayQos{}, updated)
+}
@@ -0,0 +1,77 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+type (
+	fakeQoSEdgeGatewayClient interface {
+		GetQoS() (*govcdtypes.NsxtEdgeGatewayQos, error)
+		UpdateQoS(qosConfig *govcdtypes.NsxtEdgeGatewayQos) (*govcdtypes.NsxtEdgeGatewayQos, error)
+	}
+
+	// QoSProfileModel represents a gateway QoS profile defined in NSX-T.
+	// The profiles are read-only, they are selected per direction on the edge gateway.
+	QoSProfileModel struct {
+		ID string
+
+		// Name of the QoS profile.
+		Name string
+
+		// Description of the QoS profile.
+		Description string
+
+		// CommittedBandwidth is the committed bandwidth in Mbps.
+		CommittedBandwidth int
+
+		// BurstSize is the burst size in bytes.
+		BurstSize int
+
+		// ExcessAction is the action applied on the traffic exceeding the committed bandwidth.
+		ExcessAction string
+	}
+
+	// QoSModel represents the QoS (rate limiting) configuration of an edge gateway.
+	QoSModel struct {
+		// IngressProfile is the QoS profile applied on the incoming traffic.
+		// Nil when the incoming traffic is unlimited.
+		IngressProfile *QoSProfileModel
+
+		// EgressProfile is the QoS profile applied on the outgoing traffic.
+		// Nil when the outgoing traffic is unlimited.
+		EgressProfile *QoSProfileModel
+	}
+
+	// QoSModelRequest represents the request model for updating the QoS of an edge gateway.
+	// An empty profile removes the rate limiting in that direction.
+	QoSModelRequest struct {
+		// IngressProfile is the name or ID of the QoS profile applied on the incoming traffic.
+		IngressProfile string
+
+		// EgressProfile is the name or ID of the QoS profile applied on the outgoing traffic.
+		EgressProfile string
+	}
+)
+
+// fromVCD converts a VCD gateway QoS profile to the internal QoSProfileModel.
+func (m *QoSProfileModel) fromVCD(vcdQoSProfile *govcdtypes.NsxtEdgeGatewayQosProfile) {
+	if vcdQoSProfile == nil {
+		return
+	}
+
+	m.ID = vcdQoSProfile.ID
+	m.Name = vcdQoSProfile.DisplayName
+	m.Description = vcdQoSProfile.Description
+	m.CommittedBandwidth = vcdQoSProfile.CommittedBandwidth
+	m.BurstSize = vcdQoSProfile.BurstSize
+	m.ExcessAction = vcdQoSProfile.ExcessAction
+}
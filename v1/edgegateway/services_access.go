@@ -0,0 +1,433 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/utils"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+)
+
+var (
+	deleteFirewallGroup = func(groupClient fakeFirewallGroupClient) error {
+		return groupClient.Delete()
+	}
+
+	deleteAppPortProfile = func(profileClient fakeAppPortProfileClient) error {
+		return profileClient.Delete()
+	}
+
+	deleteNATRule = func(natRuleClient fakeNATRuleClient) error {
+		return natRuleClient.Delete()
+	}
+)
+
+// GrantServiceAccess allows the source networks to reach services of the catalog (see ListOfServices).
+// For each service, it creates or updates an IP set for the service, an IP set for the source networks,
+// an application port profile, a firewall rule and one SNAT rule per source network translating
+// the source network to the dedicated IP of the network service.
+// The source networks are added to the networks already granted, which keep their access.
+// RevokeServiceAccess removes the access of all the networks of a service.
+// The network service must have been enabled with EnableNetworkService.
+// All the objects are named after the service with the "cav-svc-" prefix.
+func (c *client) GrantServiceAccess(ctx context.Context, edgeGatewayNameOrID string, serviceNames, sourceNetworks []string) ([]*ServiceAccessModel, error) {
+	if len(serviceNames) == 0 {
+		return nil, fmt.Errorf("serviceNames is %w. Please provide at least one service name", errors.ErrEmpty)
+	}
+
+	if len(sourceNetworks) == 0 {
+		return nil, fmt.Errorf("sourceNetworks is %w. Please provide at least one source network", errors.ErrEmpty)
+	}
+
+	for _, sourceNetwork := range sourceNetworks {
+		if !isIPOrCIDR(sourceNetwork) {
+			return nil, fmt.Errorf("source network %s has %w. Please provide an IP address or a CIDR", sourceNetwork, errors.ErrInvalidFormat)
+		}
+	}
+
+	state, err := c.newServiceAccessState(ctx, edgeGatewayNameOrID, serviceNames)
+	if err != nil {
+		return nil, err
+	}
+
+	accesses := make([]*ServiceAccessModel, 0, len(serviceNames))
+	for _, serviceName := range serviceNames {
+		access, err := c.grantServiceAccess(ctx, state, serviceName, sourceNetworks)
+		if err != nil {
+			return nil, fmt.Errorf("error granting access to service %s on edge gateway %s: %w", serviceName, state.edgeGateway.Name, err)
+		}
+		accesses = append(accesses, access)
+	}
+
+	return accesses, nil
+}
+
+// RevokeServiceAccess removes the objects created by GrantServiceAccess for the services.
+// The objects already removed are ignored.
+func (c *client) RevokeServiceAccess(ctx context.Context, edgeGatewayNameOrID string, serviceNames []string) error {
+	if len(serviceNames) == 0 {
+		return fmt.Errorf("serviceNames is %w. Please provide at least one service name", errors.ErrEmpty)
+	}
+
+	state, err := c.newServiceAccessState(ctx, edgeGatewayNameOrID, serviceNames)
+	if err != nil {
+		return err
+	}
+
+	for _, serviceName := range serviceNames {
+		if err := c.revokeServiceAccess(ctx, state, serviceName); err != nil {
+			return fmt.Errorf("error revoking access to service %s on edge gateway %s: %w", serviceName, state.edgeGateway.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// * Local functions
+
+// newServiceAccessState retrieves the objects of the edge gateway managed by GrantServiceAccess.
+func (c *client) newServiceAccessState(ctx context.Context, edgeGatewayNameOrID string, serviceNames []string) (*serviceAccessState, error) {
+	for _, serviceName := range serviceNames {
+		if _, err := GetServiceByName(serviceName); err != nil {
+			return nil, err
+		}
+	}
+
+	edgeGateway, err := c.GetEdgeGateway(ctx, edgeGatewayNameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !edgeGateway.NetworkServiceIsEnabled() {
+		return nil, fmt.Errorf("edge gateway %s: %w. Please enable it with EnableNetworkService", edgeGateway.Name, errors.ErrEdgeGatewayNetworkServiceNotEnabled)
+	}
+
+	vcdEdgeGateway, err := c.getVCDEdgeGateway(ctx, edgeGateway.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &serviceAccessState{
+		edgeGateway:     edgeGateway,
+		vcdEdgeGateway:  vcdEdgeGateway,
+		ipSets:          make(map[string]*govcd.NsxtFirewallGroup),
+		appPortProfiles: make(map[string]*govcd.NsxtAppPortProfile),
+		natRules:        make(map[string]*govcd.NsxtNatRule),
+	}
+
+	groups, err := listFirewallGroups(vcdEdgeGateway)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving firewall groups: %w", err)
+	}
+
+	for _, group := range groups {
+		if firewallGroupType(group.NsxtFirewallGroup) == govcdtypes.FirewallGroupTypeIpSet && strings.HasPrefix(group.NsxtFirewallGroup.Name, serviceAccessPrefix) {
+			state.ipSets[group.NsxtFirewallGroup.Name] = group
+		}
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("filter", "_context=="+state.ownerID())
+
+	profiles, err := c.clientGoVCDOrg.GetAllNsxtAppPortProfiles(queryParams, govcdtypes.ApplicationPortProfileScopeTenant)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving application port profiles: %w", err)
+	}
+
+	for _, profile := range profiles {
+		if strings.HasPrefix(profile.NsxtAppPortProfile.Name, serviceAccessPrefix) {
+			state.appPortProfiles[profile.NsxtAppPortProfile.Name] = profile
+		}
+	}
+
+	natRules, err := listNATRules(vcdEdgeGateway)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving NAT rules: %w", err)
+	}
+
+	for _, natRule := range natRules {
+		if natRule != nil && natRule.NsxtNatRule != nil && strings.HasPrefix(natRule.NsxtNatRule.Name, serviceAccessPrefix) {
+			state.natRules[natRule.NsxtNatRule.Name] = natRule
+		}
+	}
+
+	firewall, err := getFirewall(c.clientGoVCD, vcdEdgeGateway.EdgeGateway.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving firewall: %w", err)
+	}
+
+	for _, rule := range firewall.UserDefinedRules {
+		if strings.HasPrefix(rule.Name, serviceAccessPrefix) {
+			state.firewallRules = append(state.firewallRules, rule.Name)
+		}
+	}
+
+	return state, nil
+}
+
+// grantServiceAccess creates or updates the objects giving access to the service.
+// The source networks are added to the networks of the source IP set.
+func (c *client) grantServiceAccess(ctx context.Context, state *serviceAccessState, serviceName string, sourceNetworks []string) (*ServiceAccessModel, error) {
+	service, err := GetServiceByName(serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := getServiceCategory(serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	description := "Access to the CloudAvenue service " + serviceName
+	access := &ServiceAccessModel{
+		ServiceName:        serviceName,
+		IPSetName:          serviceAccessName(serviceName),
+		SourceIPSetName:    serviceAccessSourceIPSetName(serviceName),
+		AppPortProfileName: serviceAccessName(serviceName),
+		FirewallRuleName:   serviceAccessName(serviceName),
+	}
+
+	// * IP sets
+	if err := state.upsertIPSet(SnapshotModelIPSet{Name: access.IPSetName, Description: description, IPAddresses: service.IP}); err != nil {
+		return nil, err
+	}
+
+	// The source networks are merged with the networks already granted
+	if current, ok := state.ipSets[access.SourceIPSetName]; ok {
+		granted := slices.Clone(current.NsxtFirewallGroup.IpAddresses)
+		for _, sourceNetwork := range sourceNetworks {
+			if !slices.Contains(granted, sourceNetwork) {
+				granted = append(granted, sourceNetwork)
+			}
+		}
+		sourceNetworks = granted
+	}
+
+	if err := state.upsertIPSet(SnapshotModelIPSet{Name: access.SourceIPSetName, Description: description, IPAddresses: sourceNetworks}); err != nil {
+		return nil, err
+	}
+
+	// * Application port profile
+	if err := c.upsertServiceAppPortProfile(state, access.AppPortProfileName, description, service.Ports); err != nil {
+		return nil, err
+	}
+
+	// * Firewall rule
+	rule := FirewallRuleModelRequest{
+		Name:                    access.FirewallRuleName,
+		Enabled:                 utils.ToPTR(true),
+		Action:                  FirewallRuleActionAllow,
+		Direction:               FirewallRuleDirectionInOut,
+		IPProtocol:              FirewallRuleIPProtocolIPv4,
+		SourceIPSets:            []string{access.SourceIPSetName},
+		DestinationIPSets:       []string{access.IPSetName},
+		ApplicationPortProfiles: []string{access.AppPortProfileName},
+	}
+
+	if slices.Contains(state.firewallRules, rule.Name) {
+		_, err = c.UpdateFirewallRule(ctx, state.edgeGateway.ID, rule.Name, rule)
+	} else {
+		_, err = c.AddFirewallRule(ctx, state.edgeGateway.ID, rule, FirewallRulePosition{})
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(state.firewallRules, rule.Name) {
+		state.firewallRules = append(state.firewallRules, rule.Name)
+	}
+
+	// * SNAT rules
+	for _, sourceNetwork := range sourceNetworks {
+		natRule := SnapshotModelNATRule{
+			Name:                     serviceAccessNATRuleName(serviceName, sourceNetwork),
+			Description:              description,
+			Enabled:                  true,
+			Type:                     govcdtypes.NsxtNatRuleTypeSnat,
+			ExternalAddresses:        state.edgeGateway.Services.Service.DedicatedIPForService,
+			InternalAddresses:        sourceNetwork,
+			SnatDestinationAddresses: category.Network,
+		}
+
+		if err := state.upsertNATRule(natRule); err != nil {
+			return nil, err
+		}
+		access.NATRuleNames = append(access.NATRuleNames, natRule.Name)
+	}
+
+	return access, nil
+}
+
+// revokeServiceAccess deletes the objects giving access to the service.
+// The objects referencing the others are deleted first.
+func (c *client) revokeServiceAccess(ctx context.Context, state *serviceAccessState, serviceName string) error {
+	if ruleName := serviceAccessName(serviceName); slices.Contains(state.firewallRules, ruleName) {
+		if err := c.DeleteFirewallRule(ctx, state.edgeGateway.ID, ruleName); err != nil {
+			return err
+		}
+		state.firewallRules = slices.DeleteFunc(state.firewallRules, func(name string) bool { return name == ruleName })
+	}
+
+	for _, name := range state.serviceNATRuleNames(serviceName) {
+		if err := deleteNATRule(state.natRules[name]); err != nil {
+			return fmt.Errorf("error deleting NAT rule %s: %w", name, err)
+		}
+		delete(state.natRules, name)
+	}
+
+	if profile, ok := state.appPortProfiles[serviceAccessName(serviceName)]; ok {
+		if err := deleteAppPortProfile(profile); err != nil {
+			return fmt.Errorf("error deleting application port profile %s: %w", profile.NsxtAppPortProfile.Name, err)
+		}
+		delete(state.appPortProfiles, serviceAccessName(serviceName))
+	}
+
+	for _, name := range []string{serviceAccessName(serviceName), serviceAccessSourceIPSetName(serviceName)} {
+		group, ok := state.ipSets[name]
+		if !ok {
+			continue
+		}
+		if err := deleteFirewallGroup(group); err != nil {
+			return fmt.Errorf("error deleting IP set %s: %w", name, err)
+		}
+		delete(state.ipSets, name)
+	}
+
+	return nil
+}
+
+// upsertServiceAppPortProfile creates or updates the application port profile of a service.
+// The ports of the service are grouped by protocol.
+func (c *client) upsertServiceAppPortProfile(state *serviceAccessState, name, description string, ports []NetworkServicesModelSvcServiceDetailsPorts) error {
+	profile := SnapshotModelAppPortProfile{
+		Name:        name,
+		Description: description,
+	}
+
+	for _, port := range ports {
+		protocol := strings.ToUpper(port.Protocol)
+		idx := slices.IndexFunc(profile.Ports, func(p SnapshotModelAppPortProfilePort) bool { return p.Protocol == protocol })
+		if idx == -1 {
+			profile.Ports = append(profile.Ports, SnapshotModelAppPortProfilePort{Protocol: protocol})
+			idx = len(profile.Ports) - 1
+		}
+		profile.Ports[idx].DestinationPorts = append(profile.Ports[idx].DestinationPorts, strconv.Itoa(port.Port))
+	}
+
+	config := profile.toVCD(state.vcdEdgeGateway.EdgeGateway.Org, state.ownerID())
+
+	if current, ok := state.appPortProfiles[name]; ok {
+		config.ID = current.NsxtAppPortProfile.ID
+		updated, err := updateAppPortProfile(current, config)
+		if err != nil {
+			return fmt.Errorf("error updating application port profile %s: %w", name, err)
+		}
+		state.appPortProfiles[name] = updated
+		return nil
+	}
+
+	created, err := c.clientGoVCDOrg.CreateNsxtAppPortProfile(config)
+	if err != nil {
+		return fmt.Errorf("error creating application port profile %s: %w", name, err)
+	}
+	state.appPortProfiles[name] = created
+
+	return nil
+}
+
+// upsertIPSet creates or updates an IP set of the edge gateway.
+func (s *serviceAccessState) upsertIPSet(ipSet SnapshotModelIPSet) error {
+	if current, ok := s.ipSets[ipSet.Name]; ok {
+		config := *current.NsxtFirewallGroup
+		config.Description = ipSet.Description
+		config.IpAddresses = ipSet.IPAddresses
+
+		updated, err := updateFirewallGroup(current, &config)
+		if err != nil {
+			return fmt.Errorf("error updating IP set %s: %w", ipSet.Name, err)
+		}
+		s.ipSets[ipSet.Name] = updated
+		return nil
+	}
+
+	created, err := createFirewallGroup(s.vcdEdgeGateway, ipSet.toVCD(s.edgeGateway.ID))
+	if err != nil {
+		return fmt.Errorf("error creating IP set %s: %w", ipSet.Name, err)
+	}
+	s.ipSets[ipSet.Name] = created
+
+	return nil
+}
+
+// upsertNATRule creates or updates a NAT rule of the edge gateway.
+func (s *serviceAccessState) upsertNATRule(natRule SnapshotModelNATRule) error {
+	config := natRule.toVCD(nil)
+
+	if current, ok := s.natRules[natRule.Name]; ok {
+		config.ID = current.NsxtNatRule.ID
+		config.Version = current.NsxtNatRule.Version
+
+		updated, err := updateNATRule(current, config)
+		if err != nil {
+			return fmt.Errorf("error updating NAT rule %s: %w", natRule.Name, err)
+		}
+		s.natRules[natRule.Name] = updated
+		return nil
+	}
+
+	created, err := createNATRule(s.vcdEdgeGateway, config)
+	if err != nil {
+		return fmt.Errorf("error creating NAT rule %s: %w", natRule.Name, err)
+	}
+	s.natRules[natRule.Name] = created
+
+	return nil
+}
+
+// serviceNATRuleNames returns the sorted names of the SNAT rules managed for the service.
+func (s *serviceAccessState) serviceNATRuleNames(serviceName string) []string {
+	names := make([]string, 0)
+	for name := range s.natRules {
+		sourceNetwork, ok := strings.CutPrefix(name, serviceAccessName(serviceName)+"-")
+		// The suffix must be a network, so the rules of "dns-resolver" do not match a service named "dns"
+		if ok && isIPOrCIDR(sourceNetwork) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// ownerID returns the ID of the VDC or VDC Group owning the edge gateway.
+func (s *serviceAccessState) ownerID() string {
+	if s.edgeGateway.OwnerRef == nil {
+		return ""
+	}
+	return s.edgeGateway.OwnerRef.ID
+}
+
+// isIPOrCIDR reports whether the value is an IP address or a CIDR.
+func isIPOrCIDR(value string) bool {
+	if net.ParseIP(value) != nil {
+		return true
+	}
+	_, _, err := net.ParseCIDR(value)
+	return err == nil
+}
@@ -0,0 +1,381 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/endpoints"
+	clientcloudavenue "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/clients/cloudavenue"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
+)
+
+const (
+	testServiceAccessService = "ntp"
+	testServiceAccessSource  = "192.168.0.0/24"
+	testServiceAccessRange   = "100.113.99.96/27"
+)
+
+// testMockGetEdgeGateway registers the mocks of GetEdgeGateway.
+// When networkService is true, the network service is enabled on the edge gateway.
+func testMockGetEdgeGateway(t *testing.T, clientCAV *MockclientInterface, edgeGatewayID, vdcID string, networkService bool) {
+	t.Helper()
+
+	clientCAV.EXPECT().Refresh().Return(nil)
+	clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(&govcd.NsxtEdgeGateway{
+		EdgeGateway: &govcdtypes.OpenAPIEdgeGateway{
+			ID:                 edgeGatewayID,
+			Name:               testEdgeGatewayName,
+			OwnerRef:           &govcdtypes.OpenApiReference{ID: vdcID, Name: testVDCName},
+			EdgeGatewayUplinks: []govcdtypes.EdgeGatewayUplinks{{UplinkName: testVRFName}},
+		},
+	}, nil)
+
+	service := ""
+	if networkService {
+		service = fmt.Sprintf(`{
+			"type": "service",
+			"name": "cav-services",
+			"displayName": "%s",
+			"properties": {"ranges": ["%s"]},
+			"children": [],
+			"serviceId": "%s"
+		}`, testServiceDisplayName, testServiceAccessRange, testServiceName)
+	}
+
+	responder, err := httpmock.NewJsonResponder(200, json.RawMessage(`{"rateLimit":10}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpmock.RegisterResponder("GET", endpoints.InlineTemplate(endpoints.EdgeGatewayGet, map[string]string{testEdgeID: urn.ExtractUUID(edgeGatewayID)}), responder)
+
+	responder, err = httpmock.NewJsonResponder(200, json.RawMessage(fmt.Sprintf(`[{
+		"type": "tier-0-vrf",
+		"name": "%s",
+		"children": [{
+			"type": "edge-gateway",
+			"name": "%s",
+			"properties": {"rateLimit": 10, "edgeUUID": "%s"},
+			"children": [%s]
+		}]
+	}]`, testVRFName, testEdgeGatewayName, urn.ExtractUUID(edgeGatewayID), service)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpmock.RegisterResponder("GET", endpoints.NetworkServiceGet, responder)
+
+	clientCAV.EXPECT().R().DoAndReturn(clientcloudavenue.MockClient().R).Times(2)
+}
+
+// testServiceAccessObjects mocks the objects of an edge gateway managed by GrantServiceAccess.
+// The objects created, updated and deleted are recorded by name.
+type testServiceAccessObjects struct {
+	groups   []*govcd.NsxtFirewallGroup
+	rules    []*v1.NsxtFirewallRuleExtended
+	natRules []*govcd.NsxtNatRule
+
+	created []string
+	updated []string
+	deleted []string
+
+	// ipAddresses are the IP addresses of the IP sets created or updated
+	ipAddresses map[string][]string
+}
+
+func (o *testServiceAccessObjects) mock() {
+	o.ipAddresses = make(map[string][]string)
+	listFirewallGroups = func(_ fakeFirewallEdgeGatewayClient) ([]*govcd.NsxtFirewallGroup, error) {
+		return o.groups, nil
+	}
+	createFirewallGroup = func(_ fakeFirewallGroupEdgeGatewayClient, group *govcdtypes.NsxtFirewallGroup) (*govcd.NsxtFirewallGroup, error) {
+		group.ID = uuid.New().String()
+		o.created = append(o.created, group.Name)
+		o.ipAddresses[group.Name] = group.IpAddresses
+		created := &govcd.NsxtFirewallGroup{NsxtFirewallGroup: group}
+		o.groups = append(o.groups, created)
+		return created, nil
+	}
+	updateFirewallGroup = func(_ fakeFirewallGroupClient, group *govcdtypes.NsxtFirewallGroup) (*govcd.NsxtFirewallGroup, error) {
+		o.updated = append(o.updated, group.Name)
+		o.ipAddresses[group.Name] = group.IpAddresses
+		return &govcd.NsxtFirewallGroup{NsxtFirewallGroup: group}, nil
+	}
+	deleteFirewallGroup = func(group fakeFirewallGroupClient) error {
+		o.deleted = append(o.deleted, group.(*govcd.NsxtFirewallGroup).NsxtFirewallGroup.Name)
+		return nil
+	}
+	deleteAppPortProfile = func(profile fakeAppPortProfileClient) error {
+		o.deleted = append(o.deleted, profile.(*govcd.NsxtAppPortProfile).NsxtAppPortProfile.Name)
+		return nil
+	}
+	getFirewall = func(_ clientGoVCD, _ string) (*v1.NsxtFirewallRuleContainerExtended, error) {
+		return &v1.NsxtFirewallRuleContainerExtended{UserDefinedRules: o.rules}, nil
+	}
+	updateFirewall = func(_ clientGoVCD, _ string, rules []*v1.NsxtFirewallRuleExtended) (*v1.NsxtFirewallRuleContainerExtended, error) {
+		for _, rule := range rules {
+			if rule.ID == "" {
+				rule.ID = uuid.New().String()
+			}
+		}
+		o.rules = rules
+		return &v1.NsxtFirewallRuleContainerExtended{UserDefinedRules: rules}, nil
+	}
+	listNATRules = func(_ fakeNATRuleEdgeGatewayClient) ([]*govcd.NsxtNatRule, error) {
+		return o.natRules, nil
+	}
+	createNATRule = func(_ fakeNATRuleCreateEdgeGatewayClient, natRule *govcdtypes.NsxtNatRule) (*govcd.NsxtNatRule, error) {
+		o.created = append(o.created, natRule.Name)
+		return &govcd.NsxtNatRule{NsxtNatRule: natRule}, nil
+	}
+	updateNATRule = func(_ fakeNATRuleClient, natRule *govcdtypes.NsxtNatRule) (*govcd.NsxtNatRule, error) {
+		o.updated = append(o.updated, natRule.Name)
+		return &govcd.NsxtNatRule{NsxtNatRule: natRule}, nil
+	}
+	deleteNATRule = func(natRule fakeNATRuleClient) error {
+		o.deleted = append(o.deleted, natRule.(*govcd.NsxtNatRule).NsxtNatRule.Name)
+		return nil
+	}
+}
+
+func TestServicesCatalog(t *testing.T) {
+	service, err := GetServiceByName(testServiceAccessService)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ntp1.service.cav", "ntp2.service.cav"}, service.FQDN)
+
+	service, err = GetServiceByFQDN("smtp.service.cav")
+	assert.NoError(t, err)
+	assert.Equal(t, "smtp", service.Name)
+
+	_, err = GetServiceByName("unknown")
+	assert.ErrorIs(t, err, errors.ErrNotFound)
+
+	_, err = GetServiceByFQDN("unknown.service.cav")
+	assert.ErrorIs(t, err, errors.ErrNotFound)
+
+	assert.Len(t, ListServicesByCategory("s3"), 1)
+	assert.Empty(t, ListServicesByCategory("unknown"))
+
+	names := func(services []ServiceModelDetail) []string {
+		result := make([]string, 0, len(services))
+		for _, s := range services {
+			result = append(result, s.Name)
+		}
+		return result
+	}
+	assert.Equal(t, []string{"dns-authoritative", "dns-resolver"}, names(ListServicesByPort(53, "")))
+	assert.Equal(t, []string{"dns-authoritative", "dns-resolver"}, names(ListServicesByPort(53, "UDP")))
+	assert.Equal(t, []string{"ntp"}, names(ListServicesByPort(123, "udp")))
+	assert.Empty(t, ListServicesByPort(123, "tcp"))
+}
+
+func TestClient_GrantServiceAccess(t *testing.T) {
+	defer httpmock.DeactivateAndReset()
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+	profileName := serviceAccessName(testServiceAccessService)
+
+	tests := []struct {
+		name           string
+		serviceNames   []string
+		sourceNetworks []string
+		mockFunc       func(t *testing.T, clientCAV *MockclientInterface) *testServiceAccessObjects
+		expected       []*ServiceAccessModel
+		expectedError  bool
+		err            error
+	}{
+		{
+			name:           testSuccess,
+			serviceNames:   []string{testServiceAccessService},
+			sourceNetworks: []string{testServiceAccessSource},
+			mockFunc: func(t *testing.T, clientCAV *MockclientInterface) *testServiceAccessObjects {
+				testMockGetEdgeGateway(t, clientCAV, edgeGatewayID, vdcID, true)
+
+				objects := &testServiceAccessObjects{
+					// The access is already granted to another source network
+					groups: []*govcd.NsxtFirewallGroup{
+						{NsxtFirewallGroup: &govcdtypes.NsxtFirewallGroup{ID: uuid.New().String(), Name: serviceAccessName(testServiceAccessService), TypeValue: govcdtypes.FirewallGroupTypeIpSet}},
+						{NsxtFirewallGroup: &govcdtypes.NsxtFirewallGroup{ID: uuid.New().String(), Name: serviceAccessSourceIPSetName(testServiceAccessService), TypeValue: govcdtypes.FirewallGroupTypeIpSet, IpAddresses: []string{"10.0.0.0/24"}}},
+					},
+					natRules: []*govcd.NsxtNatRule{
+						{NsxtNatRule: &govcdtypes.NsxtNatRule{ID: uuid.New().String(), Name: serviceAccessNATRuleName(testServiceAccessService, "10.0.0.0/24")}},
+						{NsxtNatRule: &govcdtypes.NsxtNatRule{ID: uuid.New().String(), Name: "user-rule"}},
+					},
+				}
+				objects.mock()
+
+				// AddFirewallRule
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil).Times(2)
+				clientCAV.EXPECT().GetAllNsxtAppPortProfiles(gomock.Any(), govcdtypes.ApplicationPortProfileScopeTenant).Return(nil, nil)
+				clientCAV.EXPECT().CreateNsxtAppPortProfile(gomock.Any()).DoAndReturn(func(profile *govcdtypes.NsxtAppPortProfile) (*govcd.NsxtAppPortProfile, error) {
+					assert.Equal(t, []govcdtypes.NsxtAppPortProfilePort{{Protocol: "UDP", DestinationPorts: []string{"123"}}}, profile.ApplicationPorts)
+					assert.Equal(t, vdcID, profile.ContextEntityId)
+					return &govcd.NsxtAppPortProfile{NsxtAppPortProfile: profile}, nil
+				})
+				clientCAV.EXPECT().GetAllNsxtAppPortProfiles(gomock.Any(), gomock.Any()).Return([]*govcd.NsxtAppPortProfile{
+					{NsxtAppPortProfile: &govcdtypes.NsxtAppPortProfile{ID: uuid.New().String(), Name: profileName, Scope: govcdtypes.ApplicationPortProfileScopeTenant}},
+				}, nil)
+
+				return objects
+			},
+			expected: []*ServiceAccessModel{
+				{
+					ServiceName:        testServiceAccessService,
+					IPSetName:          "cav-svc-ntp",
+					SourceIPSetName:    "cav-svc-ntp-sources",
+					AppPortProfileName: "cav-svc-ntp",
+					FirewallRuleName:   "cav-svc-ntp",
+					NATRuleNames:       []string{"cav-svc-ntp-10.0.0.0/24", "cav-svc-ntp-192.168.0.0/24"},
+				},
+			},
+		},
+		{
+			name:           "error-empty-service-names",
+			sourceNetworks: []string{testServiceAccessSource},
+			expectedError:  true,
+			err:            errors.ErrEmpty,
+		},
+		{
+			name:          "error-empty-source-networks",
+			serviceNames:  []string{testServiceAccessService},
+			expectedError: true,
+			err:           errors.ErrEmpty,
+		},
+		{
+			name:           "error-invalid-source-network",
+			serviceNames:   []string{testServiceAccessService},
+			sourceNetworks: []string{"192.168.0.0/33"},
+			expectedError:  true,
+			err:            errors.ErrInvalidFormat,
+		},
+		{
+			name:           "error-unknown-service",
+			serviceNames:   []string{"unknown"},
+			sourceNetworks: []string{testServiceAccessSource},
+			expectedError:  true,
+			err:            errors.ErrNotFound,
+		},
+		{
+			name:           "error-network-service-not-enabled",
+			serviceNames:   []string{testServiceAccessService},
+			sourceNetworks: []string{testServiceAccessSource},
+			mockFunc: func(t *testing.T, clientCAV *MockclientInterface) *testServiceAccessObjects {
+				testMockGetEdgeGateway(t, clientCAV, edgeGatewayID, vdcID, false)
+				return nil
+			},
+			expectedError: true,
+			err:           errors.ErrEdgeGatewayNetworkServiceNotEnabled,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Mock controller.
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock client for cloudavenue.
+			clientCAV := NewMockclientInterface(ctrl)
+
+			c, _ := NewFakeClient(clientCAV)
+
+			httpmock.Reset()
+			httpmock.ActivateNonDefault(clientcloudavenue.MockClient().GetClient())
+
+			var objects *testServiceAccessObjects
+			if test.mockFunc != nil {
+				objects = test.mockFunc(t, clientCAV)
+			}
+
+			accesses, err := c.GrantServiceAccess(context.Background(), edgeGatewayID, test.serviceNames, test.sourceNetworks)
+			if test.expectedError {
+				assert.ErrorIs(t, err, test.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, accesses)
+			assert.Equal(t, []string{"cav-svc-ntp-192.168.0.0/24"}, objects.created)
+			assert.Equal(t, []string{"cav-svc-ntp", "cav-svc-ntp-sources", "cav-svc-ntp-10.0.0.0/24"}, objects.updated)
+			assert.Empty(t, objects.deleted)
+
+			// The source network is added to the networks already granted
+			assert.Equal(t, []string{"10.0.0.0/24", "192.168.0.0/24"}, objects.ipAddresses["cav-svc-ntp-sources"])
+
+			// The firewall rule allows the sources to reach the service
+			assert.Len(t, objects.rules, 1)
+			assert.Equal(t, "cav-svc-ntp", objects.rules[0].Name)
+			assert.Equal(t, "cav-svc-ntp-sources", objects.rules[0].SourceFirewallGroups[0].Name)
+			assert.Equal(t, "cav-svc-ntp", objects.rules[0].DestinationFirewallGroups[0].Name)
+		})
+	}
+}
+
+func TestClient_RevokeServiceAccess(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	defer httpmock.DeactivateAndReset()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+
+	httpmock.ActivateNonDefault(clientcloudavenue.MockClient().GetClient())
+	testMockGetEdgeGateway(t, clientCAV, edgeGatewayID, vdcID, true)
+
+	objects := &testServiceAccessObjects{
+		groups: []*govcd.NsxtFirewallGroup{
+			{NsxtFirewallGroup: &govcdtypes.NsxtFirewallGroup{ID: uuid.New().String(), Name: "cav-svc-ntp", TypeValue: govcdtypes.FirewallGroupTypeIpSet}},
+			{NsxtFirewallGroup: &govcdtypes.NsxtFirewallGroup{ID: uuid.New().String(), Name: "cav-svc-ntp-sources", TypeValue: govcdtypes.FirewallGroupTypeIpSet}},
+		},
+		rules: []*v1.NsxtFirewallRuleExtended{
+			{ID: uuid.New().String(), Name: "cav-svc-ntp"},
+			{ID: uuid.New().String(), Name: "user-rule"},
+		},
+		natRules: []*govcd.NsxtNatRule{
+			{NsxtNatRule: &govcdtypes.NsxtNatRule{ID: uuid.New().String(), Name: "cav-svc-ntp-192.168.0.0/24"}},
+			// Rule of another service sharing the prefix
+			{NsxtNatRule: &govcdtypes.NsxtNatRule{ID: uuid.New().String(), Name: "cav-svc-ntp-server-192.168.0.0/24"}},
+		},
+	}
+	objects.mock()
+
+	clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil).Times(2)
+	clientCAV.EXPECT().GetAllNsxtAppPortProfiles(gomock.Any(), govcdtypes.ApplicationPortProfileScopeTenant).Return([]*govcd.NsxtAppPortProfile{
+		{NsxtAppPortProfile: &govcdtypes.NsxtAppPortProfile{ID: uuid.New().String(), Name: "cav-svc-ntp", Scope: govcdtypes.ApplicationPortProfileScopeTenant}},
+	}, nil)
+	// DeleteFirewallRule
+	clientCAV.EXPECT().Refresh().Return(nil)
+
+	err := c.RevokeServiceAccess(context.Background(), edgeGatewayID, []string{testServiceAccessService})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cav-svc-ntp-192.168.0.0/24", "cav-svc-ntp", "cav-svc-ntp", "cav-svc-ntp-sources"}, objects.deleted)
+	assert.Len(t, objects.rules, 1)
+	assert.Equal(t, "user-rule", objects.rules[0].Name)
+}
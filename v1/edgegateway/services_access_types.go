@@ -0,0 +1,66 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+type (
+	// ServiceAccessModel lists the objects created on an edge gateway to reach a service of the catalog.
+	ServiceAccessModel struct {
+		// ServiceName is the name of the service in ListOfServices.
+		ServiceName string
+
+		// IPSetName is the name of the IP set containing the IP addresses of the service.
+		IPSetName string
+
+		// SourceIPSetName is the name of the IP set containing the source networks granted.
+		SourceIPSetName string
+
+		// AppPortProfileName is the name of the application port profile containing the ports of the service.
+		AppPortProfileName string
+
+		// FirewallRuleName is the name of the firewall rule allowing the source networks to reach the service.
+		FirewallRuleName string
+
+		// NATRuleNames are the names of the SNAT rules, one per source network granted.
+		NATRuleNames []string
+	}
+
+	// serviceAccessState holds the objects of the edge gateway managed by GrantServiceAccess, by name.
+	serviceAccessState struct {
+		edgeGateway    *EdgeGateway
+		vcdEdgeGateway *govcd.NsxtEdgeGateway
+
+		ipSets          map[string]*govcd.NsxtFirewallGroup
+		appPortProfiles map[string]*govcd.NsxtAppPortProfile
+		natRules        map[string]*govcd.NsxtNatRule
+		firewallRules   []string
+	}
+)
+
+// serviceAccessPrefix prefixes the name of all the objects managed by GrantServiceAccess.
+const serviceAccessPrefix = "cav-svc-"
+
+// serviceAccessName returns the name of the objects managed for the service.
+func serviceAccessName(serviceName string) string {
+	return serviceAccessPrefix + serviceName
+}
+
+// serviceAccessSourceIPSetName returns the name of the IP set of the source networks of the service.
+func serviceAccessSourceIPSetName(serviceName string) string {
+	return serviceAccessName(serviceName) + "-sources"
+}
+
+// serviceAccessNATRuleName returns the name of the SNAT rule of a source network of the service.
+func serviceAccessNATRuleName(serviceName, sourceNetwork string) string {
+	return serviceAccessName(serviceName) + "-" + sourceNetwork
+}
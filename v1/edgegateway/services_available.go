@@ -9,6 +9,14 @@
 
 package edgegateway
 
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+)
+
 const (
 	serviceProtocolTCP = "tcp"
 	serviceProtocolUDP = "udp"
@@ -201,3 +209,70 @@ var ListOfServices = []ServiceModelDetails{
 		},
 	},
 }
+
+// GetServiceByName returns the service of the catalog with the name.
+func GetServiceByName(name string) (*ServiceModelDetail, error) {
+	for _, details := range ListOfServices {
+		for _, service := range details.Services {
+			if service.Name == name {
+				return &service, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("service %s %w", name, errors.ErrNotFound)
+}
+
+// GetServiceByFQDN returns the service of the catalog reachable with the FQDN.
+func GetServiceByFQDN(fqdn string) (*ServiceModelDetail, error) {
+	for _, details := range ListOfServices {
+		for _, service := range details.Services {
+			if slices.Contains(service.FQDN, fqdn) {
+				return &service, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("service with FQDN %s %w", fqdn, errors.ErrNotFound)
+}
+
+// ListServicesByCategory returns the services of the catalog in the category.
+func ListServicesByCategory(category string) []ServiceModelDetail {
+	for _, details := range ListOfServices {
+		if details.Category == category {
+			return slices.Clone(details.Services)
+		}
+	}
+
+	return nil
+}
+
+// ListServicesByPort returns the services of the catalog listening on the port.
+// An empty protocol matches both tcp and udp.
+func ListServicesByPort(port int, protocol string) []ServiceModelDetail {
+	var services []ServiceModelDetail
+	for _, details := range ListOfServices {
+		for _, service := range details.Services {
+			if slices.ContainsFunc(service.Ports, func(p NetworkServicesModelSvcServiceDetailsPorts) bool {
+				return p.Port == port && (protocol == "" || strings.EqualFold(p.Protocol, protocol))
+			}) {
+				services = append(services, service)
+			}
+		}
+	}
+
+	return services
+}
+
+// getServiceCategory returns the category of the catalog containing the service.
+func getServiceCategory(name string) (*ServiceModelDetails, error) {
+	for _, details := range ListOfServices {
+		for _, service := range details.Services {
+			if service.Name == name {
+				return &details, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("service %s %w", name, errors.ErrNotFound)
+}
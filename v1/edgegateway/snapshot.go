@@ -0,0 +1,1030 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/edgeloadbalancer"
+)
+
+var (
+	createFirewallGroup = func(edgeClient fakeFirewallGroupEdgeGatewayClient, group *govcdtypes.NsxtFirewallGroup) (*govcd.NsxtFirewallGroup, error) {
+		return edgeClient.CreateNsxtFirewallGroup(group)
+	}
+
+	updateFirewallGroup = func(groupClient fakeFirewallGroupClient, group *govcdtypes.NsxtFirewallGroup) (*govcd.NsxtFirewallGroup, error) {
+		return groupClient.Update(group)
+	}
+
+	updateAppPortProfile = func(profileClient fakeAppPortProfileClient, profile *govcdtypes.NsxtAppPortProfile) (*govcd.NsxtAppPortProfile, error) {
+		return profileClient.Update(profile)
+	}
+
+	createNATRule = func(edgeClient fakeNATRuleCreateEdgeGatewayClient, natRule *govcdtypes.NsxtNatRule) (*govcd.NsxtNatRule, error) {
+		return edgeClient.CreateNatRule(natRule)
+	}
+
+	updateNATRule = func(natRuleClient fakeNATRuleClient, natRule *govcdtypes.NsxtNatRule) (*govcd.NsxtNatRule, error) {
+		return natRuleClient.Update(natRule)
+	}
+)
+
+// ParseSnapshot decodes a JSON snapshot produced by ExportSnapshot.
+// It returns an error if the version of the snapshot is not supported.
+func ParseSnapshot(data []byte) (*SnapshotModel, error) {
+	snapshot := &SnapshotModel{}
+	if err := json.Unmarshal(data, snapshot); err != nil {
+		return nil, fmt.Errorf("snapshot has %w: %w", errors.ErrInvalidFormat, err)
+	}
+
+	if snapshot.Version != SnapshotVersion {
+		return nil, fmt.Errorf("version %d, supported version is %d: %w", snapshot.Version, SnapshotVersion, errors.ErrEdgeGatewaySnapshotVersionUnsupported)
+	}
+
+	return snapshot, nil
+}
+
+// ExportSnapshot exports the configuration of an edge gateway.
+// The snapshot can be encoded with json.Marshal and decoded with ParseSnapshot.
+func (c *client) ExportSnapshot(ctx context.Context, edgeGatewayNameOrID string) (*SnapshotModel, error) {
+	state, err := c.exportSnapshot(ctx, edgeGatewayNameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	return state.snapshot, nil
+}
+
+// ImportSnapshot imports a snapshot on an edge gateway.
+// The objects of the snapshot are matched by name with the objects of the edge gateway:
+// missing objects are created, different objects are updated and the objects
+// of the edge gateway missing from the snapshot are left untouched.
+// The firewall rules are replaced as a whole because their order matters.
+//
+// With DryRun, the changes are computed but not applied.
+//
+// The public IPs of the snapshot are those of the source edge gateway. A NAT rule or a virtual service
+// using one of them that is not a public IP of the edge gateway is reported with the CONFLICT status,
+// and nothing is applied while the diff has conflicts (errors.ErrEdgeGatewaySnapshotIPNotAllocated is
+// returned with the diff). IPMapping replaces the IP addresses of the snapshot by those of the edge gateway.
+//
+// The changes are applied in order and the import stops at the first failure.
+// The changes already applied are not rolled back: the diff is returned with the error
+// and the Status of each change tells which ones have been applied, which one failed
+// and which ones are still pending. Importing the snapshot again applies the remaining changes.
+func (c *client) ImportSnapshot(ctx context.Context, edgeGatewayNameOrID string, snapshot *SnapshotModel, opts ImportSnapshotOptions) (*SnapshotDiffModel, error) {
+	if snapshot == nil {
+		return nil, fmt.Errorf("snapshot is %w. Please provide a valid snapshot", errors.ErrEmpty)
+	}
+
+	if snapshot.Version != SnapshotVersion {
+		return nil, fmt.Errorf("version %d, supported version is %d: %w", snapshot.Version, SnapshotVersion, errors.ErrEdgeGatewaySnapshotVersionUnsupported)
+	}
+
+	for source, target := range opts.IPMapping {
+		if net.ParseIP(source) == nil || net.ParseIP(target) == nil {
+			return nil, fmt.Errorf("IPMapping %s => %s has %w. Please provide valid IP addresses", source, target, errors.ErrInvalidFormat)
+		}
+	}
+
+	state, err := c.exportSnapshot(ctx, edgeGatewayNameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The load balancer can't be enabled by the import
+	if snapshot.hasLoadBalancer() && !state.snapshot.NetworkServices.LoadBalancer {
+		return nil, fmt.Errorf("load balancer of edge gateway %s is not enabled: %w", state.edgeGateway.Name, errors.ErrNotFound)
+	}
+
+	desired := snapshot.withIPMapping(opts.IPMapping)
+
+	diff := diffSnapshot(state.snapshot, desired)
+	if opts.DryRun {
+		return diff, nil
+	}
+
+	if conflicts := diff.conflicts(); conflicts > 0 {
+		return diff, fmt.Errorf("%d changes use IP addresses not allocated to edge gateway %s: %w", conflicts, state.edgeGateway.Name, errors.ErrEdgeGatewaySnapshotIPNotAllocated)
+	}
+
+	if err := c.applySnapshotDiff(ctx, state, desired, diff); err != nil {
+		return diff, err
+	}
+
+	return diff, nil
+}
+
+// exportSnapshot retrieves the configuration of the edge gateway.
+func (c *client) exportSnapshot(ctx context.Context, edgeGatewayNameOrID string) (*snapshotState, error) {
+	edgeGateway, err := c.GetEdgeGateway(ctx, edgeGatewayNameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	vcdEdgeGateway, err := c.getVCDEdgeGateway(ctx, edgeGateway.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &snapshotState{
+		snapshot: &SnapshotModel{
+			Version:    SnapshotVersion,
+			ExportedAt: time.Now().UTC(),
+			Source: SnapshotModelSource{
+				ID:       edgeGateway.ID,
+				Name:     edgeGateway.Name,
+				UplinkT0: edgeGateway.UplinkT0,
+			},
+			Bandwidth: edgeGateway.Bandwidth,
+			NetworkServices: SnapshotModelNetworkServices{
+				LoadBalancer:   edgeGateway.Services.LoadBalancer != nil,
+				NetworkService: edgeGateway.Services.Service != nil,
+			},
+		},
+		edgeGateway:     edgeGateway,
+		vcdEdgeGateway:  vcdEdgeGateway,
+		ipSets:          make(map[string]*govcd.NsxtFirewallGroup),
+		securityGroups:  make(map[string]*govcd.NsxtFirewallGroup),
+		appPortProfiles: make(map[string]*govcd.NsxtAppPortProfile),
+		natRules:        make(map[string]*govcd.NsxtNatRule),
+		staticRoutes:    make(map[string]string),
+		pools:           make(map[string]string),
+		virtualServices: make(map[string]string),
+	}
+
+	if edgeGateway.OwnerRef != nil {
+		state.snapshot.Source.OwnerName = edgeGateway.OwnerRef.Name
+	}
+
+	for _, publicIP := range edgeGateway.Services.PublicIP {
+		state.snapshot.NetworkServices.PublicIPs = append(state.snapshot.NetworkServices.PublicIPs, publicIP.IP)
+	}
+
+	for _, export := range []func(context.Context, *snapshotState) error{
+		c.exportSnapshotFirewall,
+		c.exportSnapshotNATRules,
+		c.exportSnapshotStaticRoutes,
+		c.exportSnapshotLoadBalancer,
+	} {
+		if err := export(ctx, state); err != nil {
+			return nil, fmt.Errorf("error exporting edge gateway %s: %w", edgeGateway.Name, err)
+		}
+	}
+
+	return state, nil
+}
+
+// exportSnapshotFirewall exports the IP sets, the security groups, the application port profiles and the firewall rules.
+// It returns an error if a firewall rule references a dynamic security group.
+func (c *client) exportSnapshotFirewall(ctx context.Context, state *snapshotState) error {
+	groups, err := listFirewallGroups(state.vcdEdgeGateway)
+	if err != nil {
+		return fmt.Errorf("error retrieving firewall groups: %w", err)
+	}
+
+	groupTypes := make(map[string]string, len(groups))
+	for _, group := range groups {
+		groupTypes[group.NsxtFirewallGroup.ID] = firewallGroupType(group.NsxtFirewallGroup)
+
+		switch firewallGroupType(group.NsxtFirewallGroup) {
+		case govcdtypes.FirewallGroupTypeIpSet:
+			ipAddresses := slices.Clone(group.NsxtFirewallGroup.IpAddresses)
+			slices.Sort(ipAddresses)
+
+			state.snapshot.IPSets = append(state.snapshot.IPSets, SnapshotModelIPSet{
+				Name:        group.NsxtFirewallGroup.Name,
+				Description: group.NsxtFirewallGroup.Description,
+				IPAddresses: ipAddresses,
+			})
+			state.ipSets[group.NsxtFirewallGroup.Name] = group
+
+		case govcdtypes.FirewallGroupTypeSecurityGroup:
+			networks := make([]string, 0, len(group.NsxtFirewallGroup.Members))
+			for _, member := range group.NsxtFirewallGroup.Members {
+				networks = append(networks, member.Name)
+			}
+			slices.Sort(networks)
+
+			state.snapshot.SecurityGroups = append(state.snapshot.SecurityGroups, SnapshotModelSecurityGroup{
+				Name:        group.NsxtFirewallGroup.Name,
+				Description: group.NsxtFirewallGroup.Description,
+				Networks:    networks,
+			})
+			state.securityGroups[group.NsxtFirewallGroup.Name] = group
+		}
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("filter", "_context=="+state.ownerID())
+
+	profiles, err := c.clientGoVCDOrg.GetAllNsxtAppPortProfiles(queryParams, govcdtypes.ApplicationPortProfileScopeTenant)
+	if err != nil {
+		return fmt.Errorf("error retrieving application port profiles: %w", err)
+	}
+
+	for _, profile := range profiles {
+		appPortProfile := SnapshotModelAppPortProfile{
+			Name:        profile.NsxtAppPortProfile.Name,
+			Description: profile.NsxtAppPortProfile.Description,
+		}
+		for _, port := range profile.NsxtAppPortProfile.ApplicationPorts {
+			appPortProfile.Ports = append(appPortProfile.Ports, SnapshotModelAppPortProfilePort{
+				Protocol:         port.Protocol,
+				DestinationPorts: port.DestinationPorts,
+			})
+		}
+
+		state.snapshot.AppPortProfiles = append(state.snapshot.AppPortProfiles, appPortProfile)
+		state.appPortProfiles[profile.NsxtAppPortProfile.Name] = profile
+	}
+
+	firewall, err := getFirewall(c.clientGoVCD, state.vcdEdgeGateway.EdgeGateway.ID)
+	if err != nil {
+		return fmt.Errorf("error retrieving firewall: %w", err)
+	}
+
+	fm := &FirewallModel{}
+	fm.fromVCD(firewall.UserDefinedRules)
+
+	for _, rule := range fm.Rules {
+		// The dynamic security groups can't be imported, a snapshot without them would not restore the rule
+		for _, ref := range append(slices.Clone(rule.Sources), rule.Destinations...) {
+			if groupTypes[ref.ID] == govcdtypes.FirewallGroupTypeVmCriteria {
+				return fmt.Errorf("dynamic security group %s of firewall rule %s: %w", ref.Name, rule.Name, errors.ErrEdgeGatewaySnapshotObjectUnsupported)
+			}
+		}
+
+		state.snapshot.FirewallRules = append(state.snapshot.FirewallRules, firewallRuleRequestFromModel(rule, groupTypes))
+	}
+
+	return nil
+}
+
+// exportSnapshotNATRules exports the NAT rules.
+func (c *client) exportSnapshotNATRules(_ context.Context, state *snapshotState) error {
+	natRules, err := listNATRules(state.vcdEdgeGateway)
+	if err != nil {
+		return fmt.Errorf("error retrieving NAT rules: %w", err)
+	}
+
+	for _, natRule := range natRules {
+		if natRule == nil || natRule.NsxtNatRule == nil {
+			continue
+		}
+
+		m := SnapshotModelNATRule{}
+		m.fromVCD(natRule.NsxtNatRule)
+
+		state.snapshot.NATRules = append(state.snapshot.NATRules, m)
+		state.natRules[natRule.NsxtNatRule.Name] = natRule
+	}
+
+	return nil
+}
+
+// exportSnapshotStaticRoutes exports the user-defined static routes.
+func (c *client) exportSnapshotStaticRoutes(ctx context.Context, state *snapshotState) error {
+	staticRoutes, err := c.ListStaticRoutes(ctx, state.edgeGateway.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, staticRoute := range staticRoutes {
+		if staticRoute.SystemOwned {
+			continue
+		}
+
+		request := StaticRouteModelRequest{
+			Name:        staticRoute.Name,
+			Description: staticRoute.Description,
+			NetworkCIDR: staticRoute.NetworkCIDR,
+		}
+		for _, nextHop := range staticRoute.NextHops {
+			nextHopRequest := StaticRouteModelNextHopRequest{
+				IPAddress:     nextHop.IPAddress,
+				AdminDistance: nextHop.AdminDistance,
+			}
+			if nextHop.Scope != nil && nextHop.Scope.Type == StaticRouteScopeTypeNetwork {
+				nextHopRequest.ScopeNetworkNameOrID = nextHop.Scope.Name
+			}
+			request.NextHops = append(request.NextHops, nextHopRequest)
+		}
+
+		state.snapshot.StaticRoutes = append(state.snapshot.StaticRoutes, request)
+		state.staticRoutes[staticRoute.Name] = staticRoute.ID
+	}
+
+	return nil
+}
+
+// exportSnapshotLoadBalancer exports the load balancer pools and virtual services with their HTTP policies.
+// Nothing is exported when the load balancer is not enabled.
+func (c *client) exportSnapshotLoadBalancer(ctx context.Context, state *snapshotState) error {
+	if !state.snapshot.NetworkServices.LoadBalancer {
+		return nil
+	}
+
+	pools, err := c.clientLoadBalancer.ListPools(ctx, state.edgeGateway.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, pool := range pools {
+		state.snapshot.LoadBalancer.Pools = append(state.snapshot.LoadBalancer.Pools, snapshotPoolFromModel(pool))
+		state.pools[pool.Name] = pool.ID
+	}
+
+	virtualServices, err := c.clientLoadBalancer.ListVirtualServices(ctx, state.edgeGateway.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, vs := range virtualServices {
+		m := snapshotVirtualServiceFromModel(vs)
+
+		// HTTP policies are only available for the HTTP and HTTPS virtual services
+		if vs.ApplicationProfile == edgeloadbalancer.VirtualServiceApplicationProfileHTTP || vs.ApplicationProfile == edgeloadbalancer.VirtualServiceApplicationProfileHTTPS {
+			requestPolicies, err := c.clientLoadBalancer.GetPoliciesHTTPRequest(ctx, vs.ID)
+			if err != nil {
+				return err
+			}
+			m.PoliciesHTTPRequest = requestPolicies.Policies
+
+			responsePolicies, err := c.clientLoadBalancer.GetPoliciesHTTPResponse(ctx, vs.ID)
+			if err != nil {
+				return err
+			}
+			m.PoliciesHTTPResponse = responsePolicies.Policies
+
+			securityPolicies, err := c.clientLoadBalancer.GetPoliciesHTTPSecurity(ctx, vs.ID)
+			if err != nil {
+				return err
+			}
+			m.PoliciesHTTPSecurity = securityPolicies.Policies
+		}
+
+		state.snapshot.LoadBalancer.VirtualServices = append(state.snapshot.LoadBalancer.VirtualServices, m)
+		state.virtualServices[vs.Name] = vs.ID
+	}
+
+	return nil
+}
+
+// diffSnapshot computes the changes to apply on the current configuration to get the desired one.
+// The changes are ordered so that the referenced objects are created first.
+func diffSnapshot(current, desired *SnapshotModel) *SnapshotDiffModel {
+	diff := &SnapshotDiffModel{
+		Changes: make([]SnapshotChangeModel, 0),
+	}
+
+	if desired.Bandwidth != 0 && desired.Bandwidth != current.Bandwidth {
+		diff.add(SnapshotObjectKindBandwidth, strconv.Itoa(desired.Bandwidth)+" Mbps", SnapshotChangeActionUpdate)
+	}
+
+	if desired.NetworkServices.NetworkService && !current.NetworkServices.NetworkService {
+		diff.add(SnapshotObjectKindNetworkService, "network service", SnapshotChangeActionCreate)
+	}
+
+	diffSnapshotObjects(diff, SnapshotObjectKindIPSet, current.IPSets, desired.IPSets, func(m SnapshotModelIPSet) string { return m.Name })
+	diffSnapshotObjects(diff, SnapshotObjectKindSecurityGroup, current.SecurityGroups, desired.SecurityGroups, func(m SnapshotModelSecurityGroup) string { return m.Name })
+	diffSnapshotObjects(diff, SnapshotObjectKindAppPortProfile, current.AppPortProfiles, desired.AppPortProfiles, func(m SnapshotModelAppPortProfile) string { return m.Name })
+
+	// An empty list of firewall rules is ignored to not remove the rules of the edge gateway
+	if len(desired.FirewallRules) > 0 && !snapshotEqual(current.FirewallRules, desired.FirewallRules) {
+		diff.add(SnapshotObjectKindFirewall, "firewall", SnapshotChangeActionUpdate)
+	}
+
+	diffSnapshotObjects(diff, SnapshotObjectKindNATRule, current.NATRules, desired.NATRules, func(m SnapshotModelNATRule) string { return m.Name })
+	diffSnapshotObjects(diff, SnapshotObjectKindStaticRoute, current.StaticRoutes, desired.StaticRoutes, func(m StaticRouteModelRequest) string { return m.Name })
+	diffSnapshotObjects(diff, SnapshotObjectKindLoadBalancerPool, current.LoadBalancer.Pools, desired.LoadBalancer.Pools, func(m SnapshotModelPool) string { return m.Name })
+
+	// The HTTP policies are compared apart from the virtual service
+	withoutPolicies := func(vss []SnapshotModelVirtualService) []SnapshotModelVirtualService {
+		result := make([]SnapshotModelVirtualService, 0, len(vss))
+		for _, vs := range vss {
+			vs.PoliciesHTTPRequest, vs.PoliciesHTTPResponse, vs.PoliciesHTTPSecurity = nil, nil, nil
+			result = append(result, vs)
+		}
+		return result
+	}
+	diffSnapshotObjects(diff, SnapshotObjectKindVirtualService, withoutPolicies(current.LoadBalancer.VirtualServices), withoutPolicies(desired.LoadBalancer.VirtualServices), func(m SnapshotModelVirtualService) string { return m.Name })
+
+	for _, desiredVS := range desired.LoadBalancer.VirtualServices {
+		currentVS := findSnapshotVirtualService(current.LoadBalancer.VirtualServices, desiredVS.Name)
+		if !snapshotEqual(currentVS.PoliciesHTTPRequest, desiredVS.PoliciesHTTPRequest) ||
+			!snapshotEqual(currentVS.PoliciesHTTPResponse, desiredVS.PoliciesHTTPResponse) ||
+			!snapshotEqual(currentVS.PoliciesHTTPSecurity, desiredVS.PoliciesHTTPSecurity) {
+			diff.add(SnapshotObjectKindVirtualServicePolicy, desiredVS.Name, SnapshotChangeActionUpdate)
+		}
+	}
+
+	markSnapshotIPConflicts(diff, current, desired)
+
+	return diff
+}
+
+// markSnapshotIPConflicts marks the changes of the NAT rules and of the virtual services using a public IP
+// of the desired snapshot that is not a public IP of the current one.
+// The addresses that are not public IPs (internal virtual IP addresses for example) are not checked.
+func markSnapshotIPConflicts(diff *SnapshotDiffModel, current, desired *SnapshotModel) {
+	for i := range diff.Changes {
+		change := &diff.Changes[i]
+
+		var addresses []string
+		switch change.Kind {
+		case SnapshotObjectKindNATRule:
+			natRule := findSnapshotObject(desired.NATRules, change.Name, func(m SnapshotModelNATRule) string { return m.Name })
+			addresses = []string{natRule.ExternalAddresses}
+		case SnapshotObjectKindVirtualService, SnapshotObjectKindVirtualServicePolicy:
+			vs := findSnapshotVirtualService(desired.LoadBalancer.VirtualServices, change.Name)
+			addresses = []string{vs.VirtualIPAddress, vs.IPv6VirtualIPAddress}
+		default:
+			continue
+		}
+
+		for _, address := range addresses {
+			if address == "" || !slices.Contains(desired.NetworkServices.PublicIPs, address) || slices.Contains(current.NetworkServices.PublicIPs, address) {
+				continue
+			}
+
+			change.Status = SnapshotChangeStatusConflict
+			change.Error = fmt.Sprintf("public IP %s is not allocated to the edge gateway", address)
+			break
+		}
+	}
+}
+
+// conflicts returns the number of changes with the CONFLICT status.
+func (d *SnapshotDiffModel) conflicts() int {
+	count := 0
+	for _, change := range d.Changes {
+		if change.Status == SnapshotChangeStatusConflict {
+			count++
+		}
+	}
+
+	return count
+}
+
+// diffSnapshotObjects adds the changes of a list of objects matched by name.
+func diffSnapshotObjects[T any](diff *SnapshotDiffModel, kind SnapshotObjectKind, current, desired []T, name func(T) string) {
+	for _, d := range desired {
+		idx := slices.IndexFunc(current, func(c T) bool { return name(c) == name(d) })
+		switch {
+		case idx == -1:
+			diff.add(kind, name(d), SnapshotChangeActionCreate)
+		case !snapshotEqual(current[idx], d):
+			diff.add(kind, name(d), SnapshotChangeActionUpdate)
+		}
+	}
+}
+
+// add appends a change to the diff.
+func (d *SnapshotDiffModel) add(kind SnapshotObjectKind, name string, action SnapshotChangeAction) {
+	d.Changes = append(d.Changes, SnapshotChangeModel{Kind: kind, Name: name, Action: action, Status: SnapshotChangeStatusPending})
+}
+
+// applySnapshotDiff applies the changes of the diff in order and sets their status.
+// It stops at the first failure and leaves the next changes pending.
+func (c *client) applySnapshotDiff(ctx context.Context, state *snapshotState, snapshot *SnapshotModel, diff *SnapshotDiffModel) error {
+	for i := range diff.Changes {
+		change := &diff.Changes[i]
+		if err := c.applySnapshotChange(ctx, state, snapshot, *change); err != nil {
+			change.Status = SnapshotChangeStatusFailed
+			change.Error = err.Error()
+			return fmt.Errorf("error applying %s %s on edge gateway %s: %w", change.Kind, change.Name, state.edgeGateway.Name, err)
+		}
+		change.Status = SnapshotChangeStatusApplied
+	}
+
+	return nil
+}
+
+// applySnapshotChange applies a change of the diff on the edge gateway.
+// The state is updated with the created objects to remap the next references.
+func (c *client) applySnapshotChange(ctx context.Context, state *snapshotState, snapshot *SnapshotModel, change SnapshotChangeModel) error {
+	edgeGatewayID := state.edgeGateway.ID
+
+	switch change.Kind {
+	case SnapshotObjectKindBandwidth:
+		return c.UpdateEdgeGateway(ctx, &EdgeGatewayModelUpdate{
+			ID:        edgeGatewayID,
+			Bandwidth: snapshot.Bandwidth,
+		})
+
+	case SnapshotObjectKindNetworkService:
+		return state.edgeGateway.EnableNetworkService(ctx)
+
+	case SnapshotObjectKindIPSet:
+		ipSet := findSnapshotObject(snapshot.IPSets, change.Name, func(m SnapshotModelIPSet) string { return m.Name })
+		if change.Action == SnapshotChangeActionCreate {
+			group, err := createFirewallGroup(state.vcdEdgeGateway, ipSet.toVCD(edgeGatewayID))
+			if err != nil {
+				return err
+			}
+			state.ipSets[change.Name] = group
+			return nil
+		}
+
+		current := state.ipSets[change.Name]
+		config := *current.NsxtFirewallGroup
+		config.Description = ipSet.Description
+		config.IpAddresses = ipSet.IPAddresses
+		group, err := updateFirewallGroup(current, &config)
+		if err != nil {
+			return err
+		}
+		state.ipSets[change.Name] = group
+		return nil
+
+	case SnapshotObjectKindSecurityGroup:
+		securityGroup := findSnapshotObject(snapshot.SecurityGroups, change.Name, func(m SnapshotModelSecurityGroup) string { return m.Name })
+		members, err := c.snapshotNetworkReferences(state, securityGroup.Networks)
+		if err != nil {
+			return err
+		}
+
+		if change.Action == SnapshotChangeActionCreate {
+			group, err := createFirewallGroup(state.vcdEdgeGateway, securityGroup.toVCD(edgeGatewayID, members))
+			if err != nil {
+				return err
+			}
+			state.securityGroups[change.Name] = group
+			return nil
+		}
+
+		current := state.securityGroups[change.Name]
+		config := *current.NsxtFirewallGroup
+		config.Description = securityGroup.Description
+		config.Members = members
+		group, err := updateFirewallGroup(current, &config)
+		if err != nil {
+			return err
+		}
+		state.securityGroups[change.Name] = group
+		return nil
+
+	case SnapshotObjectKindAppPortProfile:
+		profile := findSnapshotObject(snapshot.AppPortProfiles, change.Name, func(m SnapshotModelAppPortProfile) string { return m.Name })
+		config := profile.toVCD(state.vcdEdgeGateway.EdgeGateway.Org, state.ownerID())
+		if change.Action == SnapshotChangeActionCreate {
+			_, err := c.clientGoVCDOrg.CreateNsxtAppPortProfile(config)
+			return err
+		}
+
+		current := state.appPortProfiles[change.Name]
+		config.ID = current.NsxtAppPortProfile.ID
+		_, err := updateAppPortProfile(current, config)
+		return err
+
+	case SnapshotObjectKindFirewall:
+		_, err := c.UpdateFirewall(ctx, edgeGatewayID, snapshot.FirewallRules)
+		return err
+
+	case SnapshotObjectKindNATRule:
+		natRule := findSnapshotObject(snapshot.NATRules, change.Name, func(m SnapshotModelNATRule) string { return m.Name })
+
+		var appPortProfile *govcdtypes.OpenApiReference
+		if natRule.ApplicationPortProfile != "" {
+			resolver := &firewallReferenceResolver{
+				edgeClient: state.vcdEdgeGateway,
+				orgClient:  c.clientGoVCDOrg,
+				ownerID:    state.ownerID(),
+			}
+			refs, err := resolver.resolveAppPortProfiles([]string{natRule.ApplicationPortProfile})
+			if err != nil {
+				return err
+			}
+			appPortProfile = &refs[0]
+		}
+
+		config := natRule.toVCD(appPortProfile)
+		if change.Action == SnapshotChangeActionCreate {
+			_, err := createNATRule(state.vcdEdgeGateway, config)
+			return err
+		}
+
+		current := state.natRules[change.Name]
+		config.ID = current.NsxtNatRule.ID
+		config.Version = current.NsxtNatRule.Version
+		_, err := updateNATRule(current, config)
+		return err
+
+	case SnapshotObjectKindStaticRoute:
+		staticRoute := findSnapshotObject(snapshot.StaticRoutes, change.Name, func(m StaticRouteModelRequest) string { return m.Name })
+		if change.Action == SnapshotChangeActionCreate {
+			_, err := c.CreateStaticRoute(ctx, edgeGatewayID, staticRoute)
+			return err
+		}
+
+		_, err := c.UpdateStaticRoute(ctx, edgeGatewayID, state.staticRoutes[change.Name], staticRoute)
+		return err
+
+	case SnapshotObjectKindLoadBalancerPool:
+		pool := findSnapshotObject(snapshot.LoadBalancer.Pools, change.Name, func(m SnapshotModelPool) string { return m.Name })
+		request, err := c.snapshotPoolToRequest(ctx, state, pool)
+		if err != nil {
+			return err
+		}
+
+		if change.Action == SnapshotChangeActionCreate {
+			created, err := c.clientLoadBalancer.CreatePool(ctx, request)
+			if err != nil {
+				return err
+			}
+			state.pools[change.Name] = created.ID
+			return nil
+		}
+
+		_, err = c.clientLoadBalancer.UpdatePool(ctx, state.pools[change.Name], request)
+		return err
+
+	case SnapshotObjectKindVirtualService:
+		vs := findSnapshotVirtualService(snapshot.LoadBalancer.VirtualServices, change.Name)
+		request, err := c.snapshotVirtualServiceToRequest(ctx, state, vs)
+		if err != nil {
+			return err
+		}
+
+		if change.Action == SnapshotChangeActionCreate {
+			created, err := c.clientLoadBalancer.CreateVirtualService(ctx, request)
+			if err != nil {
+				return err
+			}
+			state.virtualServices[change.Name] = created.ID
+			return nil
+		}
+
+		_, err = c.clientLoadBalancer.UpdateVirtualService(ctx, state.virtualServices[change.Name], request)
+		return err
+
+	case SnapshotObjectKindVirtualServicePolicy:
+		return c.applySnapshotVirtualServicePolicies(ctx, state, findSnapshotVirtualService(snapshot.LoadBalancer.VirtualServices, change.Name))
+	}
+
+	return fmt.Errorf("change kind %s has %w", change.Kind, errors.ErrInvalidFormat)
+}
+
+// applySnapshotVirtualServicePolicies replaces the HTTP policies of a virtual service.
+// Only the policies that differ from the current ones are updated.
+func (c *client) applySnapshotVirtualServicePolicies(ctx context.Context, state *snapshotState, vs SnapshotModelVirtualService) error {
+	vsID, ok := state.virtualServices[vs.Name]
+	if !ok {
+		return fmt.Errorf("virtual service %s %w", vs.Name, errors.ErrNotFound)
+	}
+
+	currentVS := findSnapshotVirtualService(state.snapshot.LoadBalancer.VirtualServices, vs.Name)
+
+	if !snapshotEqual(currentVS.PoliciesHTTPRequest, vs.PoliciesHTTPRequest) {
+		if len(vs.PoliciesHTTPRequest) == 0 {
+			if err := c.clientLoadBalancer.DeletePoliciesHTTPRequest(ctx, vsID); err != nil {
+				return err
+			}
+		} else if _, err := c.clientLoadBalancer.UpdatePoliciesHTTPRequest(ctx, &edgeloadbalancer.PoliciesHTTPRequestModel{VirtualServiceID: vsID, Policies: vs.PoliciesHTTPRequest}); err != nil {
+			return err
+		}
+	}
+
+	if !snapshotEqual(currentVS.PoliciesHTTPResponse, vs.PoliciesHTTPResponse) {
+		if len(vs.PoliciesHTTPResponse) == 0 {
+			if err := c.clientLoadBalancer.DeletePoliciesHTTPResponse(ctx, vsID); err != nil {
+				return err
+			}
+		} else if _, err := c.clientLoadBalancer.UpdatePoliciesHTTPResponse(ctx, &edgeloadbalancer.PoliciesHTTPResponseModel{VirtualServiceID: vsID, Policies: vs.PoliciesHTTPResponse}); err != nil {
+			return err
+		}
+	}
+
+	if !snapshotEqual(currentVS.PoliciesHTTPSecurity, vs.PoliciesHTTPSecurity) {
+		if len(vs.PoliciesHTTPSecurity) == 0 {
+			if err := c.clientLoadBalancer.DeletePoliciesHTTPSecurity(ctx, vsID); err != nil {
+				return err
+			}
+		} else if _, err := c.clientLoadBalancer.UpdatePoliciesHTTPSecurity(ctx, &edgeloadbalancer.PoliciesHTTPSecurityModel{VirtualServiceID: vsID, Policies: vs.PoliciesHTTPSecurity}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// snapshotPoolToRequest converts a snapshot pool to a pool request of the edge gateway.
+func (c *client) snapshotPoolToRequest(ctx context.Context, state *snapshotState, pool SnapshotModelPool) (edgeloadbalancer.PoolModelRequest, error) {
+	request := pool.PoolModelRequest
+	request.GatewayRef = govcdtypes.OpenApiReference{ID: state.edgeGateway.ID}
+
+	if request.MemberGroupName != "" {
+		group, ok := state.ipSets[request.MemberGroupName]
+		if !ok {
+			group, ok = state.securityGroups[request.MemberGroupName]
+		}
+		if !ok {
+			return request, fmt.Errorf("ip set or security group %s %w", request.MemberGroupName, errors.ErrNotFound)
+		}
+		request.MemberGroupRef = &govcdtypes.OpenApiReference{ID: group.NsxtFirewallGroup.ID, Name: group.NsxtFirewallGroup.Name}
+		request.MemberGroupName = ""
+	}
+
+	for _, name := range pool.CaCertificateNames {
+		certificateID, err := c.snapshotCertificateID(ctx, state, name)
+		if err != nil {
+			return request, err
+		}
+		request.CaCertificateRefs = append(request.CaCertificateRefs, govcdtypes.OpenApiReference{ID: certificateID, Name: name})
+	}
+
+	return request, nil
+}
+
+// snapshotVirtualServiceToRequest converts a snapshot virtual service to a virtual service request of the edge gateway.
+func (c *client) snapshotVirtualServiceToRequest(ctx context.Context, state *snapshotState, vs SnapshotModelVirtualService) (edgeloadbalancer.VirtualServiceModelRequest, error) {
+	request := vs.VirtualServiceModelRequest
+	request.EdgeGatewayID = state.edgeGateway.ID
+
+	poolID, ok := state.pools[vs.PoolName]
+	if !ok {
+		return request, fmt.Errorf("pool %s %w", vs.PoolName, errors.ErrNotFound)
+	}
+	request.PoolID = poolID
+
+	if vs.ServiceEngineGroupName != "" {
+		if state.serviceEngineGroups == nil {
+			segs, err := c.clientLoadBalancer.ListServiceEngineGroups(ctx, state.edgeGateway.ID)
+			if err != nil {
+				return request, err
+			}
+			state.serviceEngineGroups = segs
+		}
+
+		idx := slices.IndexFunc(state.serviceEngineGroups, func(seg *edgeloadbalancer.ServiceEngineGroupModel) bool {
+			return seg.Name == vs.ServiceEngineGroupName
+		})
+		if idx == -1 {
+			return request, fmt.Errorf("service engine group %s %w", vs.ServiceEngineGroupName, errors.ErrNotFound)
+		}
+		request.ServiceEngineGroupID = &state.serviceEngineGroups[idx].ID
+	}
+
+	if vs.CertificateName != "" {
+		certificateID, err := c.snapshotCertificateID(ctx, state, vs.CertificateName)
+		if err != nil {
+			return request, err
+		}
+		request.CertificateID = &certificateID
+	}
+
+	return request, nil
+}
+
+// snapshotNetworkReferences returns the references of the Org VDC networks of the edge gateway's owner by name.
+func (c *client) snapshotNetworkReferences(state *snapshotState, names []string) ([]govcdtypes.OpenApiReference, error) {
+	if state.networks == nil && len(names) > 0 {
+		queryParams := url.Values{}
+		queryParams.Add("filter", "ownerRef.id=="+state.ownerID())
+
+		networks, err := c.clientGoVCDOrg.GetAllOpenApiOrgVdcNetworks(queryParams)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving networks: %w", err)
+		}
+		state.networks = networks
+	}
+
+	refs := make([]govcdtypes.OpenApiReference, 0, len(names))
+	for _, name := range names {
+		idx := slices.IndexFunc(state.networks, func(network *govcd.OpenApiOrgVdcNetwork) bool {
+			return network.OpenApiOrgVdcNetwork != nil && network.OpenApiOrgVdcNetwork.Name == name
+		})
+		if idx == -1 {
+			return nil, fmt.Errorf("network %s %w", name, errors.ErrNotFound)
+		}
+		refs = append(refs, govcdtypes.OpenApiReference{ID: state.networks[idx].OpenApiOrgVdcNetwork.ID, Name: name})
+	}
+
+	return refs, nil
+}
+
+// snapshotCertificateID returns the ID of a certificate of the certificate library by name.
+func (c *client) snapshotCertificateID(ctx context.Context, state *snapshotState, name string) (string, error) {
+	if state.certificates == nil {
+		certificates, err := c.clientOrg.ListCertificatesInLibrary(ctx)
+		if err != nil {
+			return "", fmt.Errorf("error retrieving certificates: %w", err)
+		}
+		state.certificates = certificates
+	}
+
+	for _, certificate := range state.certificates {
+		if certificate.Name == name {
+			return certificate.ID, nil
+		}
+	}
+
+	return "", fmt.Errorf("certificate %s %w", name, errors.ErrNotFound)
+}
+
+// ownerID returns the ID of the VDC or VDC Group owning the edge gateway.
+func (s *snapshotState) ownerID() string {
+	if s.edgeGateway.OwnerRef == nil {
+		return ""
+	}
+	return s.edgeGateway.OwnerRef.ID
+}
+
+// firewallRuleRequestFromModel converts a firewall rule to a request referencing the objects by name.
+// groupTypes maps the IDs of the firewall groups to their type.
+func firewallRuleRequestFromModel(rule *FirewallRuleModel, groupTypes map[string]string) FirewallRuleModelRequest {
+	enabled := rule.Enabled
+	request := FirewallRuleModelRequest{
+		Name:       rule.Name,
+		Enabled:    &enabled,
+		Action:     rule.Action,
+		Direction:  rule.Direction,
+		IPProtocol: rule.IPProtocol,
+		Logging:    rule.Logging,
+	}
+
+	for _, ref := range rule.Sources {
+		if groupTypes[ref.ID] == govcdtypes.FirewallGroupTypeIpSet {
+			request.SourceIPSets = append(request.SourceIPSets, ref.Name)
+		} else {
+			request.SourceSecurityGroups = append(request.SourceSecurityGroups, ref.Name)
+		}
+	}
+
+	for _, ref := range rule.Destinations {
+		if groupTypes[ref.ID] == govcdtypes.FirewallGroupTypeIpSet {
+			request.DestinationIPSets = append(request.DestinationIPSets, ref.Name)
+		} else {
+			request.DestinationSecurityGroups = append(request.DestinationSecurityGroups, ref.Name)
+		}
+	}
+
+	for _, ref := range rule.ApplicationPortProfiles {
+		request.ApplicationPortProfiles = append(request.ApplicationPortProfiles, ref.Name)
+	}
+
+	for _, ref := range rule.NetworkContextProfiles {
+		request.NetworkContextProfiles = append(request.NetworkContextProfiles, ref.Name)
+	}
+
+	return request
+}
+
+// snapshotPoolFromModel converts a pool to the snapshot format.
+// The read-only and computed fields are left empty.
+func snapshotPoolFromModel(pool *edgeloadbalancer.PoolModel) SnapshotModelPool {
+	m := SnapshotModelPool{
+		PoolModelRequest: edgeloadbalancer.PoolModelRequest{
+			Name:                     pool.Name,
+			Description:              pool.Description,
+			Enabled:                  pool.Enabled,
+			Algorithm:                pool.Algorithm,
+			DefaultPort:              pool.DefaultPort,
+			GracefulTimeoutPeriod:    pool.GracefulTimeoutPeriod,
+			PassiveMonitoringEnabled: pool.PassiveMonitoringEnabled,
+			CommonNameCheckEnabled:   pool.CommonNameCheckEnabled,
+			DomainNames:              pool.DomainNames,
+			SSLEnabled:               pool.SSLEnabled,
+		},
+	}
+
+	for _, healthMonitor := range pool.HealthMonitors {
+		m.HealthMonitors = append(m.HealthMonitors, edgeloadbalancer.PoolModelHealthMonitor{Type: healthMonitor.Type})
+	}
+
+	for _, member := range pool.Members {
+		m.Members = append(m.Members, edgeloadbalancer.PoolModelMember{
+			Enabled:   member.Enabled,
+			IPAddress: member.IPAddress,
+			Port:      member.Port,
+			Ratio:     member.Ratio,
+		})
+	}
+
+	if pool.PersistenceProfile != nil {
+		m.PersistenceProfile = &edgeloadbalancer.PoolModelPersistenceProfile{
+			Type:  pool.PersistenceProfile.Type,
+			Value: pool.PersistenceProfile.Value,
+		}
+	}
+
+	if pool.MemberGroupRef != nil {
+		m.MemberGroupName = pool.MemberGroupRef.Name
+	}
+
+	for _, ref := range pool.CaCertificateRefs {
+		m.CaCertificateNames = append(m.CaCertificateNames, ref.Name)
+	}
+
+	return m
+}
+
+// snapshotVirtualServiceFromModel converts a virtual service to the snapshot format, without its HTTP policies.
+func snapshotVirtualServiceFromModel(vs *edgeloadbalancer.VirtualServiceModel) SnapshotModelVirtualService {
+	m := SnapshotModelVirtualService{
+		VirtualServiceModelRequest: edgeloadbalancer.VirtualServiceModelRequest{
+			Name:                   vs.Name,
+			Description:            vs.Description,
+			Enabled:                vs.Enabled,
+			ApplicationProfile:     vs.ApplicationProfile,
+			ServicePorts:           vs.ServicePorts,
+			VirtualIPAddress:       vs.VirtualIPAddress,
+			IPv6VirtualIPAddress:   vs.IPv6VirtualIPAddress,
+			TransparentModeEnabled: &vs.TransparentModeEnabled,
+		},
+		PoolName: vs.PoolRef.Name,
+	}
+
+	if vs.ServiceEngineGroupRef != nil {
+		m.ServiceEngineGroupName = vs.ServiceEngineGroupRef.Name
+	}
+
+	if vs.CertificateRef != nil {
+		m.CertificateName = vs.CertificateRef.Name
+	}
+
+	return m
+}
+
+// findSnapshotObject returns the object of the list with the name, or the zero value.
+func findSnapshotObject[T any](objects []T, name string, nameOf func(T) string) T {
+	var zero T
+	for _, object := range objects {
+		if nameOf(object) == name {
+			return object
+		}
+	}
+	return zero
+}
+
+// findSnapshotVirtualService returns the virtual service with the name, or the zero value.
+func findSnapshotVirtualService(vss []SnapshotModelVirtualService, name string) SnapshotModelVirtualService {
+	return findSnapshotObject(vss, name, func(m SnapshotModelVirtualService) string { return m.Name })
+}
+
+// snapshotEqual reports whether two snapshot objects are equal once encoded in JSON.
+// Null values and empty lists are considered equal.
+func snapshotEqual(a, b any) bool {
+	normalize := func(v any) any {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil
+		}
+
+		var decoded any
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			return nil
+		}
+
+		return pruneSnapshotValue(decoded)
+	}
+
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
+
+// pruneSnapshotValue removes the null values and the empty lists of a decoded JSON value.
+func pruneSnapshotValue(v any) any {
+	switch value := v.(type) {
+	case map[string]any:
+		for k, item := range value {
+			pruned := pruneSnapshotValue(item)
+			if pruned == nil {
+				delete(value, k)
+				continue
+			}
+			value[k] = pruned
+		}
+		return value
+	case []any:
+		if len(value) == 0 {
+			return nil
+		}
+		for i, item := range value {
+			value[i] = pruneSnapshotValue(item)
+		}
+		return value
+	default:
+		return v
+	}
+}
@@ -0,0 +1,593 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	clientcloudavenue "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/clients/cloudavenue"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/edgeloadbalancer"
+)
+
+const (
+	testSnapshotNetworkName = "test-network"
+	testSnapshotPoolName    = "test-pool"
+	testSnapshotVSName      = "test-virtual-service"
+	testSnapshotSEGName     = "test-seg"
+)
+
+func testSnapshot() *SnapshotModel {
+	return &SnapshotModel{
+		Version:   SnapshotVersion,
+		Bandwidth: 50,
+		IPSets: []SnapshotModelIPSet{
+			{Name: testFirewallIPSetName, IPAddresses: []string{"10.0.0.1", "10.0.0.2"}},
+		},
+		SecurityGroups: []SnapshotModelSecurityGroup{
+			{Name: testFirewallSecurityGroupName, Networks: []string{testSnapshotNetworkName}},
+		},
+		FirewallRules: []FirewallRuleModelRequest{testFirewallRuleRequest("rule-1")},
+		StaticRoutes: []StaticRouteModelRequest{
+			{Name: testStaticRouteName, NetworkCIDR: testStaticRouteCIDR, NextHops: []StaticRouteModelNextHopRequest{{IPAddress: testStaticRouteNextHop}}},
+		},
+		LoadBalancer: SnapshotModelLoadBalancer{
+			Pools: []SnapshotModelPool{
+				{PoolModelRequest: edgeloadbalancer.PoolModelRequest{Name: testSnapshotPoolName}},
+			},
+			VirtualServices: []SnapshotModelVirtualService{
+				{
+					VirtualServiceModelRequest: edgeloadbalancer.VirtualServiceModelRequest{Name: testSnapshotVSName},
+					PoolName:                   testSnapshotPoolName,
+					ServiceEngineGroupName:     testSnapshotSEGName,
+				},
+			},
+		},
+	}
+}
+
+func TestParseSnapshot(t *testing.T) {
+	data, err := json.Marshal(testSnapshot())
+	assert.NoError(t, err)
+
+	snapshot, err := ParseSnapshot(data)
+	assert.NoError(t, err)
+	assert.True(t, snapshotEqual(testSnapshot(), snapshot))
+
+	// The keys of the document are fixed by the json tags, not by the Go field names.
+	var document map[string]any
+	assert.NoError(t, json.Unmarshal(data, &document))
+	for _, key := range []string{"version", "exportedAt", "source", "ipSets", "natRules", "loadBalancer"} {
+		assert.Contains(t, document, key)
+	}
+
+	_, err = ParseSnapshot([]byte(`{"version": 2}`))
+	assert.ErrorIs(t, err, errors.ErrEdgeGatewaySnapshotVersionUnsupported)
+	assert.ErrorIs(t, err, errors.ErrInvalidFormat)
+
+	_, err = ParseSnapshot([]byte(`{`))
+	assert.ErrorIs(t, err, errors.ErrInvalidFormat)
+}
+
+func TestDiffSnapshot(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  func(*SnapshotModel)
+		expected []SnapshotChangeModel
+	}{
+		{
+			name:     "no-change",
+			current:  func(*SnapshotModel) {},
+			expected: []SnapshotChangeModel{},
+		},
+		{
+			name: "create",
+			current: func(s *SnapshotModel) {
+				*s = SnapshotModel{Version: SnapshotVersion, Bandwidth: 50}
+			},
+			expected: []SnapshotChangeModel{
+				{Kind: SnapshotObjectKindIPSet, Name: testFirewallIPSetName, Action: SnapshotChangeActionCreate, Status: SnapshotChangeStatusPending},
+				{Kind: SnapshotObjectKindSecurityGroup, Name: testFirewallSecurityGroupName, Action: SnapshotChangeActionCreate, Status: SnapshotChangeStatusPending},
+				{Kind: SnapshotObjectKindFirewall, Name: "firewall", Action: SnapshotChangeActionUpdate, Status: SnapshotChangeStatusPending},
+				{Kind: SnapshotObjectKindStaticRoute, Name: testStaticRouteName, Action: SnapshotChangeActionCreate, Status: SnapshotChangeStatusPending},
+				{Kind: SnapshotObjectKindLoadBalancerPool, Name: testSnapshotPoolName, Action: SnapshotChangeActionCreate, Status: SnapshotChangeStatusPending},
+				{Kind: SnapshotObjectKindVirtualService, Name: testSnapshotVSName, Action: SnapshotChangeActionCreate, Status: SnapshotChangeStatusPending},
+			},
+		},
+		{
+			name: "update",
+			current: func(s *SnapshotModel) {
+				s.Bandwidth = 25
+				s.IPSets[0].IPAddresses = []string{"10.0.0.1"}
+				s.LoadBalancer.VirtualServices[0].PoliciesHTTPSecurity = []*edgeloadbalancer.PoliciesHTTPSecurityModelPolicy{{Name: "policy"}}
+			},
+			expected: []SnapshotChangeModel{
+				{Kind: SnapshotObjectKindBandwidth, Name: "50 Mbps", Action: SnapshotChangeActionUpdate, Status: SnapshotChangeStatusPending},
+				{Kind: SnapshotObjectKindIPSet, Name: testFirewallIPSetName, Action: SnapshotChangeActionUpdate, Status: SnapshotChangeStatusPending},
+				{Kind: SnapshotObjectKindVirtualServicePolicy, Name: testSnapshotVSName, Action: SnapshotChangeActionUpdate, Status: SnapshotChangeStatusPending},
+			},
+		},
+		{
+			name: "objects-missing-from-snapshot-are-kept",
+			current: func(s *SnapshotModel) {
+				s.IPSets = append(s.IPSets, SnapshotModelIPSet{Name: "other-ip-set"})
+			},
+			expected: []SnapshotChangeModel{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			current := testSnapshot()
+			test.current(current)
+
+			diff := diffSnapshot(current, testSnapshot())
+			assert.Equal(t, test.expected, diff.Changes)
+		})
+	}
+
+	t.Run("public-ip-not-allocated", func(t *testing.T) {
+		desired := testSnapshot()
+		desired.NetworkServices.PublicIPs = []string{testPublicIP2}
+		desired.NATRules = []SnapshotModelNATRule{{Name: "dnat-web", Type: "DNAT", ExternalAddresses: testPublicIP2, InternalAddresses: "192.168.0.10"}}
+		desired.LoadBalancer.VirtualServices[0].VirtualIPAddress = testPublicIP2
+
+		current := testSnapshot()
+		current.NetworkServices.PublicIPs = []string{testIPAddress}
+
+		diff := diffSnapshot(current, desired)
+		assert.Equal(t, 2, diff.conflicts())
+		for _, change := range diff.Changes {
+			if change.Kind == SnapshotObjectKindNATRule || change.Kind == SnapshotObjectKindVirtualService {
+				assert.Equal(t, SnapshotChangeStatusConflict, change.Status)
+				assert.Contains(t, change.Error, testPublicIP2)
+			}
+		}
+
+		// With the mapping, the public IP of the edge gateway is used
+		mapped := desired.withIPMapping(map[string]string{testPublicIP2: testIPAddress})
+		assert.Equal(t, testIPAddress, mapped.NATRules[0].ExternalAddresses)
+		assert.Equal(t, testIPAddress, mapped.LoadBalancer.VirtualServices[0].VirtualIPAddress)
+		assert.Equal(t, testPublicIP2, desired.NATRules[0].ExternalAddresses)
+
+		diff = diffSnapshot(current, mapped)
+		assert.Zero(t, diff.conflicts())
+	})
+
+	t.Run("empty-firewall-is-ignored", func(t *testing.T) {
+		desired := testSnapshot()
+		desired.FirewallRules = nil
+
+		diff := diffSnapshot(testSnapshot(), desired)
+		assert.Empty(t, diff.Changes)
+	})
+}
+
+func TestSnapshotVirtualServiceFromModel(t *testing.T) {
+	m := snapshotVirtualServiceFromModel(&edgeloadbalancer.VirtualServiceModel{
+		Name:                   testSnapshotVSName,
+		VirtualIPAddress:       "192.168.0.1",
+		IPv6VirtualIPAddress:   "2001:db8::1",
+		TransparentModeEnabled: true,
+		PoolRef:                govcdtypes.OpenApiReference{ID: uuid.New().String(), Name: testSnapshotPoolName},
+		ServiceEngineGroupRef:  &govcdtypes.OpenApiReference{ID: uuid.New().String(), Name: testSnapshotSEGName},
+	})
+
+	assert.Equal(t, "192.168.0.1", m.VirtualIPAddress)
+	assert.Equal(t, "2001:db8::1", m.IPv6VirtualIPAddress)
+	assert.True(t, *m.TransparentModeEnabled)
+	assert.Equal(t, testSnapshotPoolName, m.PoolName)
+	assert.Equal(t, testSnapshotSEGName, m.ServiceEngineGroupName)
+}
+
+func TestClient_ImportSnapshot(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+
+	tests := []struct {
+		name      string
+		snapshot  *SnapshotModel
+		ipMapping map[string]string
+		err       error
+	}{
+		{
+			name:     "error-empty-snapshot",
+			snapshot: nil,
+			err:      errors.ErrEmpty,
+		},
+		{
+			name:     "error-unsupported-version",
+			snapshot: &SnapshotModel{Version: SnapshotVersion + 1},
+			err:      errors.ErrEdgeGatewaySnapshotVersionUnsupported,
+		},
+		{
+			name:      "error-invalid-ip-mapping",
+			snapshot:  &SnapshotModel{Version: SnapshotVersion},
+			ipMapping: map[string]string{testPublicIP2: "not-an-ip"},
+			err:       errors.ErrInvalidFormat,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diff, err := c.ImportSnapshot(context.Background(), edgeGatewayID, test.snapshot, ImportSnapshotOptions{DryRun: true, IPMapping: test.ipMapping})
+			assert.Nil(t, diff)
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+}
+
+func TestClient_applySnapshotChange(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c := &client{
+		clientCloudavenue:  clientCAV,
+		clientGoVCD:        clientCAV,
+		clientGoVCDOrg:     clientCAV,
+		clientOrg:          clientCAV,
+		clientLoadBalancer: clientCAV,
+	}
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+	ipSetID := uuid.New().String()
+	poolID := urn.LoadBalancerPool.String() + uuid.New().String()
+	segID := urn.ServiceEngineGroup.String() + uuid.New().String()
+	vsID := urn.LoadBalancerVirtualService.String() + uuid.New().String()
+
+	newState := func() *snapshotState {
+		edgeGateway := newFakeEdgeGatewayClient(clientCAV)
+		edgeGateway.EdgeGatewayModel = &EdgeGatewayModel{
+			ID:       edgeGatewayID,
+			Name:     testEdgeGatewayName,
+			OwnerRef: &govcdtypes.OpenApiReference{ID: vdcID, Name: testVDCName},
+		}
+
+		return &snapshotState{
+			snapshot:        &SnapshotModel{Version: SnapshotVersion},
+			edgeGateway:     edgeGateway,
+			vcdEdgeGateway:  testVCDEdgeGateway(edgeGatewayID, vdcID),
+			ipSets:          make(map[string]*govcd.NsxtFirewallGroup),
+			securityGroups:  make(map[string]*govcd.NsxtFirewallGroup),
+			appPortProfiles: make(map[string]*govcd.NsxtAppPortProfile),
+			natRules:        make(map[string]*govcd.NsxtNatRule),
+			staticRoutes:    make(map[string]string),
+			pools:           make(map[string]string),
+			virtualServices: make(map[string]string),
+		}
+	}
+
+	t.Run("create-ip-set", func(t *testing.T) {
+		state := newState()
+
+		createFirewallGroup = func(_ fakeFirewallGroupEdgeGatewayClient, group *govcdtypes.NsxtFirewallGroup) (*govcd.NsxtFirewallGroup, error) {
+			assert.Equal(t, edgeGatewayID, group.OwnerRef.ID)
+			assert.Equal(t, govcdtypes.FirewallGroupTypeIpSet, group.TypeValue)
+			group.ID = ipSetID
+			return &govcd.NsxtFirewallGroup{NsxtFirewallGroup: group}, nil
+		}
+
+		err := c.applySnapshotChange(context.Background(), state, testSnapshot(), SnapshotChangeModel{Kind: SnapshotObjectKindIPSet, Name: testFirewallIPSetName, Action: SnapshotChangeActionCreate})
+		assert.NoError(t, err)
+		assert.Equal(t, ipSetID, state.ipSets[testFirewallIPSetName].NsxtFirewallGroup.ID)
+	})
+
+	t.Run("create-security-group", func(t *testing.T) {
+		state := newState()
+		networkID := urn.Network.String() + uuid.New().String()
+
+		clientCAV.EXPECT().GetAllOpenApiOrgVdcNetworks(gomock.Any()).Return([]*govcd.OpenApiOrgVdcNetwork{
+			{OpenApiOrgVdcNetwork: &govcdtypes.OpenApiOrgVdcNetwork{ID: networkID, Name: testSnapshotNetworkName}},
+		}, nil)
+		createFirewallGroup = func(_ fakeFirewallGroupEdgeGatewayClient, group *govcdtypes.NsxtFirewallGroup) (*govcd.NsxtFirewallGroup, error) {
+			// The names of the networks are remapped to the IDs of the edge gateway's owner
+			assert.Equal(t, govcdtypes.FirewallGroupTypeSecurityGroup, group.TypeValue)
+			assert.Equal(t, []govcdtypes.OpenApiReference{{ID: networkID, Name: testSnapshotNetworkName}}, group.Members)
+			group.ID = uuid.New().String()
+			return &govcd.NsxtFirewallGroup{NsxtFirewallGroup: group}, nil
+		}
+
+		err := c.applySnapshotChange(context.Background(), state, testSnapshot(), SnapshotChangeModel{Kind: SnapshotObjectKindSecurityGroup, Name: testFirewallSecurityGroupName, Action: SnapshotChangeActionCreate})
+		assert.NoError(t, err)
+		assert.Contains(t, state.securityGroups, testFirewallSecurityGroupName)
+	})
+
+	t.Run("error-unknown-network", func(t *testing.T) {
+		state := newState()
+
+		clientCAV.EXPECT().GetAllOpenApiOrgVdcNetworks(gomock.Any()).Return(nil, nil)
+
+		err := c.applySnapshotChange(context.Background(), state, testSnapshot(), SnapshotChangeModel{Kind: SnapshotObjectKindSecurityGroup, Name: testFirewallSecurityGroupName, Action: SnapshotChangeActionCreate})
+		assert.ErrorIs(t, err, errors.ErrNotFound)
+	})
+
+	t.Run("create-pool-and-virtual-service", func(t *testing.T) {
+		state := newState()
+
+		clientCAV.EXPECT().CreatePool(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, pool edgeloadbalancer.PoolModelRequest) (*edgeloadbalancer.PoolModel, error) {
+			assert.Equal(t, edgeGatewayID, pool.GatewayRef.ID)
+			return &edgeloadbalancer.PoolModel{ID: poolID, Name: pool.Name}, nil
+		})
+		clientCAV.EXPECT().ListServiceEngineGroups(gomock.Any(), edgeGatewayID).Return([]*edgeloadbalancer.ServiceEngineGroupModel{{ID: segID, Name: testSnapshotSEGName}}, nil)
+		clientCAV.EXPECT().CreateVirtualService(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, vsr edgeloadbalancer.VirtualServiceModelRequest) (*edgeloadbalancer.VirtualServiceModel, error) {
+			// The names of the snapshot are remapped to the IDs of the edge gateway
+			assert.Equal(t, edgeGatewayID, vsr.EdgeGatewayID)
+			assert.Equal(t, poolID, vsr.PoolID)
+			assert.Equal(t, segID, *vsr.ServiceEngineGroupID)
+			return &edgeloadbalancer.VirtualServiceModel{ID: vsID, Name: vsr.Name}, nil
+		})
+
+		snapshot := testSnapshot()
+		assert.NoError(t, c.applySnapshotChange(context.Background(), state, snapshot, SnapshotChangeModel{Kind: SnapshotObjectKindLoadBalancerPool, Name: testSnapshotPoolName, Action: SnapshotChangeActionCreate}))
+		assert.NoError(t, c.applySnapshotChange(context.Background(), state, snapshot, SnapshotChangeModel{Kind: SnapshotObjectKindVirtualService, Name: testSnapshotVSName, Action: SnapshotChangeActionCreate}))
+		assert.Equal(t, vsID, state.virtualServices[testSnapshotVSName])
+	})
+
+	t.Run("create-pool-security-group", func(t *testing.T) {
+		state := newState()
+		securityGroupID := uuid.New().String()
+		state.securityGroups[testFirewallSecurityGroupName] = &govcd.NsxtFirewallGroup{NsxtFirewallGroup: &govcdtypes.NsxtFirewallGroup{ID: securityGroupID, Name: testFirewallSecurityGroupName}}
+
+		// The pool is exported and read back from the document
+		snapshot := testSnapshot()
+		snapshot.LoadBalancer.Pools = []SnapshotModelPool{snapshotPoolFromModel(&edgeloadbalancer.PoolModel{
+			Name:           testSnapshotPoolName,
+			MemberGroupRef: &govcdtypes.OpenApiReference{ID: uuid.New().String(), Name: testFirewallSecurityGroupName},
+		})}
+		document, err := json.Marshal(snapshot)
+		assert.NoError(t, err)
+		snapshot, err = ParseSnapshot(document)
+		assert.NoError(t, err)
+
+		clientCAV.EXPECT().CreatePool(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, pool edgeloadbalancer.PoolModelRequest) (*edgeloadbalancer.PoolModel, error) {
+			assert.Equal(t, &govcdtypes.OpenApiReference{ID: securityGroupID, Name: testFirewallSecurityGroupName}, pool.MemberGroupRef)
+			assert.Empty(t, pool.MemberGroupName)
+			return &edgeloadbalancer.PoolModel{ID: poolID, Name: pool.Name}, nil
+		})
+
+		assert.NoError(t, c.applySnapshotChange(context.Background(), state, snapshot, SnapshotChangeModel{Kind: SnapshotObjectKindLoadBalancerPool, Name: testSnapshotPoolName, Action: SnapshotChangeActionCreate}))
+		assert.Equal(t, poolID, state.pools[testSnapshotPoolName])
+	})
+
+	t.Run("error-partial-apply", func(t *testing.T) {
+		state := newState()
+
+		createFirewallGroup = func(_ fakeFirewallGroupEdgeGatewayClient, group *govcdtypes.NsxtFirewallGroup) (*govcd.NsxtFirewallGroup, error) {
+			group.ID = ipSetID
+			return &govcd.NsxtFirewallGroup{NsxtFirewallGroup: group}, nil
+		}
+		clientCAV.EXPECT().GetAllOpenApiOrgVdcNetworks(gomock.Any()).Return(nil, nil)
+
+		diff := &SnapshotDiffModel{}
+		diff.add(SnapshotObjectKindIPSet, testFirewallIPSetName, SnapshotChangeActionCreate)
+		diff.add(SnapshotObjectKindSecurityGroup, testFirewallSecurityGroupName, SnapshotChangeActionCreate)
+		diff.add(SnapshotObjectKindStaticRoute, testStaticRouteName, SnapshotChangeActionCreate)
+
+		err := c.applySnapshotDiff(context.Background(), state, testSnapshot(), diff)
+		assert.ErrorIs(t, err, errors.ErrNotFound)
+
+		// The diff tells which changes have been applied before the failure
+		assert.Equal(t, SnapshotChangeStatusApplied, diff.Changes[0].Status)
+		assert.Equal(t, SnapshotChangeStatusFailed, diff.Changes[1].Status)
+		assert.NotEmpty(t, diff.Changes[1].Error)
+		assert.Equal(t, SnapshotChangeStatusPending, diff.Changes[2].Status)
+	})
+
+	t.Run("error-unknown-pool", func(t *testing.T) {
+		state := newState()
+
+		err := c.applySnapshotChange(context.Background(), state, testSnapshot(), SnapshotChangeModel{Kind: SnapshotObjectKindVirtualService, Name: testSnapshotVSName, Action: SnapshotChangeActionCreate})
+		assert.ErrorIs(t, err, errors.ErrNotFound)
+	})
+}
+
+// testSnapshotObjects mocks the firewall and the NAT rules of an edge gateway exported by ExportSnapshot.
+type testSnapshotObjects struct {
+	groups   []*govcd.NsxtFirewallGroup
+	rules    []*v1.NsxtFirewallRuleExtended
+	natRules []*govcd.NsxtNatRule
+}
+
+func (o *testSnapshotObjects) mock() {
+	listFirewallGroups = func(_ fakeFirewallEdgeGatewayClient) ([]*govcd.NsxtFirewallGroup, error) {
+		return o.groups, nil
+	}
+	getFirewall = func(_ clientGoVCD, _ string) (*v1.NsxtFirewallRuleContainerExtended, error) {
+		return &v1.NsxtFirewallRuleContainerExtended{UserDefinedRules: o.rules}, nil
+	}
+	listNATRules = func(_ fakeNATRuleEdgeGatewayClient) ([]*govcd.NsxtNatRule, error) {
+		return o.natRules, nil
+	}
+	listStaticRoutes = func(_ fakeStaticRouteEdgeGatewayClient) ([]*govcd.NsxtEdgeGatewayStaticRoute, error) {
+		return nil, nil
+	}
+}
+
+// testSnapshotSourceObjects returns the objects of an edge gateway with an IP set, a static
+// security group, a dynamic security group, a firewall rule and a NAT rule.
+// When dynamicRule is true, the firewall rule references the dynamic security group.
+func testSnapshotSourceObjects(dynamicRule bool) *testSnapshotObjects {
+	ipSet := &govcdtypes.NsxtFirewallGroup{ID: uuid.New().String(), Name: testFirewallIPSetName, TypeValue: govcdtypes.FirewallGroupTypeIpSet, IpAddresses: []string{"10.0.0.2", "10.0.0.1"}}
+	securityGroup := &govcdtypes.NsxtFirewallGroup{ID: uuid.New().String(), Name: testFirewallSecurityGroupName, TypeValue: govcdtypes.FirewallGroupTypeSecurityGroup, Members: []govcdtypes.OpenApiReference{
+		{ID: urn.Network.String() + uuid.New().String(), Name: testSnapshotNetworkName},
+	}}
+	dynamicGroup := &govcdtypes.NsxtFirewallGroup{ID: uuid.New().String(), Name: "test-dynamic-group", TypeValue: govcdtypes.FirewallGroupTypeVmCriteria}
+
+	destination := securityGroup
+	if dynamicRule {
+		destination = dynamicGroup
+	}
+
+	return &testSnapshotObjects{
+		groups: []*govcd.NsxtFirewallGroup{{NsxtFirewallGroup: ipSet}, {NsxtFirewallGroup: securityGroup}, {NsxtFirewallGroup: dynamicGroup}},
+		rules: []*v1.NsxtFirewallRuleExtended{
+			{
+				ID:                        uuid.New().String(),
+				Name:                      "rule-1",
+				ActionValue:               string(FirewallRuleActionDrop),
+				Enabled:                   true,
+				IPProtocol:                string(FirewallRuleIPProtocolIPv4),
+				Direction:                 string(FirewallRuleDirectionIn),
+				SourceFirewallGroups:      []govcdtypes.OpenApiReference{{ID: ipSet.ID, Name: ipSet.Name}},
+				DestinationFirewallGroups: []govcdtypes.OpenApiReference{{ID: destination.ID, Name: destination.Name}},
+			},
+		},
+		natRules: []*govcd.NsxtNatRule{
+			{NsxtNatRule: &govcdtypes.NsxtNatRule{ID: uuid.New().String(), Name: "dnat-web", Enabled: true, Type: "DNAT", ExternalAddresses: testPublicIP2, InternalAddresses: "192.168.0.10"}},
+		},
+	}
+}
+
+// testMockExportSnapshotFirewall registers the mocks of an export of the edge gateway up to the firewall.
+func testMockExportSnapshotFirewall(t *testing.T, clientCAV *MockclientInterface, edgeGatewayID, vdcID string) {
+	t.Helper()
+
+	testMockGetEdgeGateway(t, clientCAV, edgeGatewayID, vdcID, false)
+	clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+	clientCAV.EXPECT().GetAllNsxtAppPortProfiles(gomock.Any(), govcdtypes.ApplicationPortProfileScopeTenant).Return(nil, nil)
+}
+
+// testMockExportSnapshot registers the mocks of an export of the edge gateway.
+func testMockExportSnapshot(t *testing.T, clientCAV *MockclientInterface, edgeGatewayID, vdcID string) {
+	t.Helper()
+
+	testMockExportSnapshotFirewall(t, clientCAV, edgeGatewayID, vdcID)
+
+	// ListStaticRoutes
+	clientCAV.EXPECT().Refresh().Return(nil)
+	clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+}
+
+func TestClient_ExportSnapshot(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	defer httpmock.DeactivateAndReset()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+
+	httpmock.ActivateNonDefault(clientcloudavenue.MockClient().GetClient())
+
+	t.Run(testSuccess, func(t *testing.T) {
+		testSnapshotSourceObjects(false).mock()
+		testMockExportSnapshot(t, clientCAV, edgeGatewayID, vdcID)
+
+		snapshot, err := c.ExportSnapshot(context.Background(), edgeGatewayID)
+		assert.NoError(t, err)
+		assert.Equal(t, SnapshotVersion, snapshot.Version)
+		assert.Equal(t, edgeGatewayID, snapshot.Source.ID)
+		assert.Equal(t, []SnapshotModelIPSet{{Name: testFirewallIPSetName, IPAddresses: []string{"10.0.0.1", "10.0.0.2"}}}, snapshot.IPSets)
+		// The dynamic security group is not exported
+		assert.Equal(t, []SnapshotModelSecurityGroup{{Name: testFirewallSecurityGroupName, Networks: []string{testSnapshotNetworkName}}}, snapshot.SecurityGroups)
+
+		// The firewall rules reference the groups by name
+		assert.Len(t, snapshot.FirewallRules, 1)
+		assert.Equal(t, []string{testFirewallIPSetName}, snapshot.FirewallRules[0].SourceIPSets)
+		assert.Equal(t, []string{testFirewallSecurityGroupName}, snapshot.FirewallRules[0].DestinationSecurityGroups)
+
+		assert.Len(t, snapshot.NATRules, 1)
+		assert.Equal(t, "dnat-web", snapshot.NATRules[0].Name)
+		assert.Empty(t, snapshot.LoadBalancer.Pools)
+	})
+
+	t.Run("error-dynamic-security-group", func(t *testing.T) {
+		testSnapshotSourceObjects(true).mock()
+		testMockExportSnapshotFirewall(t, clientCAV, edgeGatewayID, vdcID)
+
+		snapshot, err := c.ExportSnapshot(context.Background(), edgeGatewayID)
+		assert.Nil(t, snapshot)
+		assert.ErrorIs(t, err, errors.ErrEdgeGatewaySnapshotObjectUnsupported)
+	})
+}
+
+func TestClient_ExportImportSnapshot(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	defer httpmock.DeactivateAndReset()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	sourceID := urn.Gateway.String() + uuid.New().String()
+	targetID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+
+	httpmock.ActivateNonDefault(clientcloudavenue.MockClient().GetClient())
+
+	source := testSnapshotSourceObjects(false)
+	source.mock()
+	testMockExportSnapshot(t, clientCAV, sourceID, vdcID)
+
+	exported, err := c.ExportSnapshot(context.Background(), sourceID)
+	assert.NoError(t, err)
+
+	data, err := json.Marshal(exported)
+	assert.NoError(t, err)
+
+	snapshot, err := ParseSnapshot(data)
+	assert.NoError(t, err)
+
+	t.Run("same-edge-gateway", func(t *testing.T) {
+		source.mock()
+		testMockExportSnapshot(t, clientCAV, sourceID, vdcID)
+
+		diff, err := c.ImportSnapshot(context.Background(), sourceID, snapshot, ImportSnapshotOptions{DryRun: true})
+		assert.NoError(t, err)
+		assert.Empty(t, diff.Changes)
+	})
+
+	t.Run("empty-edge-gateway", func(t *testing.T) {
+		(&testSnapshotObjects{}).mock()
+		testMockExportSnapshot(t, clientCAV, targetID, vdcID)
+
+		diff, err := c.ImportSnapshot(context.Background(), targetID, snapshot, ImportSnapshotOptions{DryRun: true})
+		assert.NoError(t, err)
+		assert.Equal(t, []SnapshotChangeModel{
+			{Kind: SnapshotObjectKindIPSet, Name: testFirewallIPSetName, Action: SnapshotChangeActionCreate, Status: SnapshotChangeStatusPending},
+			{Kind: SnapshotObjectKindSecurityGroup, Name: testFirewallSecurityGroupName, Action: SnapshotChangeActionCreate, Status: SnapshotChangeStatusPending},
+			{Kind: SnapshotObjectKindFirewall, Name: "firewall", Action: SnapshotChangeActionUpdate, Status: SnapshotChangeStatusPending},
+			{Kind: SnapshotObjectKindNATRule, Name: "dnat-web", Action: SnapshotChangeActionCreate, Status: SnapshotChangeStatusPending},
+		}, diff.Changes)
+	})
+}
@@ -0,0 +1,410 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"slices"
+	"time"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/edgeloadbalancer"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/org"
+)
+
+type (
+	fakeFirewallGroupEdgeGatewayClient interface {
+		CreateNsxtFirewallGroup(firewallGroupConfig *govcdtypes.NsxtFirewallGroup) (*govcd.NsxtFirewallGroup, error)
+	}
+
+	fakeFirewallGroupClient interface {
+		Update(firewallGroupConfig *govcdtypes.NsxtFirewallGroup) (*govcd.NsxtFirewallGroup, error)
+		Delete() error
+	}
+
+	fakeAppPortProfileClient interface {
+		Update(appPortProfileConfig *govcdtypes.NsxtAppPortProfile) (*govcd.NsxtAppPortProfile, error)
+		Delete() error
+	}
+
+	fakeNATRuleCreateEdgeGatewayClient interface {
+		CreateNatRule(natRuleConfig *govcdtypes.NsxtNatRule) (*govcd.NsxtNatRule, error)
+	}
+
+	fakeNATRuleClient interface {
+		Update(natRuleConfig *govcdtypes.NsxtNatRule) (*govcd.NsxtNatRule, error)
+		Delete() error
+	}
+
+	// SnapshotModel is the configuration of an edge gateway, exported as a versioned JSON document.
+	// Every reference between the objects of the snapshot is done by name,
+	// so a snapshot can be imported on another edge gateway.
+	// The keys of the document are set by the json tags of the snapshot types and of the
+	// request types they embed, renaming a Go field does not change the format.
+	SnapshotModel struct {
+		// Version of the snapshot format.
+		Version int `json:"version"`
+
+		// ExportedAt is the date of the export.
+		ExportedAt time.Time `json:"exportedAt"`
+
+		// Source is the edge gateway the snapshot has been exported from.
+		Source SnapshotModelSource `json:"source"`
+
+		// Bandwidth of the edge gateway in Mbps.
+		Bandwidth int `json:"bandwidth"`
+
+		// NetworkServices enabled on the edge gateway.
+		NetworkServices SnapshotModelNetworkServices `json:"networkServices"`
+
+		// IPSets are the IP sets available to the edge gateway.
+		IPSets []SnapshotModelIPSet `json:"ipSets"`
+
+		// SecurityGroups are the static security groups available to the edge gateway.
+		// Dynamic security groups (VM criteria) are not supported.
+		SecurityGroups []SnapshotModelSecurityGroup `json:"securityGroups"`
+
+		// AppPortProfiles are the tenant application port profiles of the edge gateway's owner.
+		AppPortProfiles []SnapshotModelAppPortProfile `json:"appPortProfiles"`
+
+		// FirewallRules is the ordered list of user-defined firewall rules.
+		FirewallRules []FirewallRuleModelRequest `json:"firewallRules"`
+
+		// NATRules is the list of NAT rules.
+		NATRules []SnapshotModelNATRule `json:"natRules"`
+
+		// StaticRoutes is the list of user-defined static routes.
+		StaticRoutes []StaticRouteModelRequest `json:"staticRoutes"`
+
+		// LoadBalancer holds the load balancer configuration.
+		LoadBalancer SnapshotModelLoadBalancer `json:"loadBalancer"`
+	}
+
+	// SnapshotModelSource identifies the edge gateway a snapshot has been exported from.
+	SnapshotModelSource struct {
+		ID        string `json:"id"`
+		Name      string `json:"name"`
+		OwnerName string `json:"ownerName"`
+		UplinkT0  string `json:"uplinkT0"`
+	}
+
+	// SnapshotModelNetworkServices represents the network services enabled on the edge gateway.
+	SnapshotModelNetworkServices struct {
+		// LoadBalancer reports whether the load balancer is enabled.
+		LoadBalancer bool `json:"loadBalancer"`
+
+		// NetworkService reports whether the network service (Cloud Avenue services access) is enabled.
+		NetworkService bool `json:"networkService"`
+
+		// PublicIPs are the public IPs of the edge gateway.
+		// They are informative: public IPs are not allocated on import.
+		PublicIPs []string `json:"publicIPs"`
+	}
+
+	// SnapshotModelIPSet represents an IP set.
+	SnapshotModelIPSet struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+
+		// IPAddresses is the list of IPs, CIDRs or IP ranges of the IP set.
+		IPAddresses []string `json:"ipAddresses"`
+	}
+
+	// SnapshotModelSecurityGroup represents a static security group.
+	SnapshotModelSecurityGroup struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+
+		// Networks are the names of the Org VDC networks members of the security group.
+		Networks []string `json:"networks"`
+	}
+
+	// SnapshotModelAppPortProfile represents a tenant application port profile.
+	SnapshotModelAppPortProfile struct {
+		Name        string                            `json:"name"`
+		Description string                            `json:"description"`
+		Ports       []SnapshotModelAppPortProfilePort `json:"ports"`
+	}
+
+	// SnapshotModelAppPortProfilePort represents a port definition of an application port profile.
+	SnapshotModelAppPortProfilePort struct {
+		// Protocol is one of ICMPv4, ICMPv6, TCP or UDP.
+		Protocol string `json:"protocol"`
+
+		// DestinationPorts is the list of ports ("443") or port ranges ("8000-8080").
+		DestinationPorts []string `json:"destinationPorts"`
+	}
+
+	// SnapshotModelNATRule represents a NAT rule.
+	SnapshotModelNATRule struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Enabled     bool   `json:"enabled"`
+
+		// Type is one of DNAT, NO_DNAT, SNAT, NO_SNAT or REFLEXIVE.
+		Type string `json:"type"`
+
+		ExternalAddresses        string `json:"externalAddresses"`
+		InternalAddresses        string `json:"internalAddresses"`
+		SnatDestinationAddresses string `json:"snatDestinationAddresses"`
+		DnatExternalPort         string `json:"dnatExternalPort"`
+
+		// ApplicationPortProfile is the name of the application port profile.
+		ApplicationPortProfile string `json:"applicationPortProfile"`
+
+		Logging       bool   `json:"logging"`
+		FirewallMatch string `json:"firewallMatch"`
+		Priority      *int   `json:"priority"`
+	}
+
+	// SnapshotModelLoadBalancer represents the load balancer configuration.
+	SnapshotModelLoadBalancer struct {
+		Pools           []SnapshotModelPool           `json:"pools"`
+		VirtualServices []SnapshotModelVirtualService `json:"virtualServices"`
+	}
+
+	// SnapshotModelPool represents a load balancer pool.
+	// The references of the pool request (GatewayRef, MemberGroupRef and CaCertificateRefs)
+	// are replaced by the names of the objects. MemberGroupRef is replaced by the MemberGroupName
+	// of the pool request, the name of an IP set or a security group of the snapshot.
+	SnapshotModelPool struct {
+		edgeloadbalancer.PoolModelRequest
+
+		// CaCertificateNames are the names of the CA certificates of the certificate library.
+		CaCertificateNames []string `json:"caCertificateNames"`
+	}
+
+	// SnapshotModelVirtualService represents a load balancer virtual service and its HTTP policies.
+	// The IDs of the virtual service request (EdgeGatewayID, PoolID, ServiceEngineGroupID and CertificateID)
+	// are replaced by the names of the objects.
+	SnapshotModelVirtualService struct {
+		edgeloadbalancer.VirtualServiceModelRequest
+
+		PoolName               string `json:"poolName"`
+		ServiceEngineGroupName string `json:"serviceEngineGroupName"`
+		CertificateName        string `json:"certificateName"`
+
+		PoliciesHTTPRequest  []*edgeloadbalancer.PoliciesHTTPRequestModelPolicy  `json:"policiesHTTPRequest"`
+		PoliciesHTTPResponse []*edgeloadbalancer.PoliciesHTTPResponseModelPolicy `json:"policiesHTTPResponse"`
+		PoliciesHTTPSecurity []*edgeloadbalancer.PoliciesHTTPSecurityModelPolicy `json:"policiesHTTPSecurity"`
+	}
+
+	// ImportSnapshotOptions defines the behavior of a snapshot import.
+	ImportSnapshotOptions struct {
+		// DryRun computes the changes without applying them.
+		DryRun bool
+
+		// IPMapping replaces the IP addresses of the snapshot, the keys, by the IP addresses
+		// of the edge gateway, the values. It applies to the public IPs, to the external and
+		// internal addresses of the NAT rules and to the virtual IP addresses of the virtual services.
+		// An address is replaced only when it is equal to a key.
+		IPMapping map[string]string
+	}
+
+	// SnapshotDiffModel is the list of changes applied, or that would be applied, by a snapshot import.
+	// The objects of the edge gateway missing from the snapshot are left untouched.
+	SnapshotDiffModel struct {
+		// Changes is the list of changes in the order they are applied.
+		Changes []SnapshotChangeModel `json:"changes"`
+	}
+
+	// SnapshotChangeModel represents the change of an object of the edge gateway.
+	SnapshotChangeModel struct {
+		Kind   SnapshotObjectKind   `json:"kind"`
+		Name   string               `json:"name"`
+		Action SnapshotChangeAction `json:"action"`
+
+		// Status reports whether the change has been applied on the edge gateway.
+		Status SnapshotChangeStatus `json:"status"`
+
+		// Error is the reason of the failure or of the conflict of the change.
+		Error string `json:"error,omitempty"`
+	}
+
+	SnapshotObjectKind   string
+	SnapshotChangeAction string
+	SnapshotChangeStatus string
+
+	// snapshotState is the snapshot of an edge gateway with the objects
+	// used to remap the names of the snapshot to IDs on import.
+	snapshotState struct {
+		snapshot       *SnapshotModel
+		edgeGateway    *EdgeGateway
+		vcdEdgeGateway *govcd.NsxtEdgeGateway
+
+		ipSets          map[string]*govcd.NsxtFirewallGroup
+		securityGroups  map[string]*govcd.NsxtFirewallGroup
+		appPortProfiles map[string]*govcd.NsxtAppPortProfile
+		natRules        map[string]*govcd.NsxtNatRule
+		staticRoutes    map[string]string
+		pools           map[string]string
+		virtualServices map[string]string
+
+		// Retrieved on first use
+		certificates        org.CertificatesModel
+		networks            []*govcd.OpenApiOrgVdcNetwork
+		serviceEngineGroups []*edgeloadbalancer.ServiceEngineGroupModel
+	}
+)
+
+const (
+	// SnapshotVersion is the version of the snapshot format produced by ExportSnapshot.
+	SnapshotVersion = 1
+
+	SnapshotObjectKindBandwidth            SnapshotObjectKind = "BANDWIDTH"
+	SnapshotObjectKindNetworkService       SnapshotObjectKind = "NETWORK_SERVICE"
+	SnapshotObjectKindIPSet                SnapshotObjectKind = "IP_SET"
+	SnapshotObjectKindSecurityGroup        SnapshotObjectKind = "SECURITY_GROUP"
+	SnapshotObjectKindAppPortProfile       SnapshotObjectKind = "APP_PORT_PROFILE"
+	SnapshotObjectKindFirewall             SnapshotObjectKind = "FIREWALL"
+	SnapshotObjectKindNATRule              SnapshotObjectKind = "NAT_RULE"
+	SnapshotObjectKindStaticRoute          SnapshotObjectKind = "STATIC_ROUTE"
+	SnapshotObjectKindLoadBalancerPool     SnapshotObjectKind = "LOAD_BALANCER_POOL"
+	SnapshotObjectKindVirtualService       SnapshotObjectKind = "VIRTUAL_SERVICE"
+	SnapshotObjectKindVirtualServicePolicy SnapshotObjectKind = "VIRTUAL_SERVICE_HTTP_POLICIES"
+
+	// SnapshotChangeActionCreate creates the object on the edge gateway.
+	SnapshotChangeActionCreate SnapshotChangeAction = "CREATE"
+	// SnapshotChangeActionUpdate updates the existing object of the edge gateway.
+	SnapshotChangeActionUpdate SnapshotChangeAction = "UPDATE"
+
+	// SnapshotChangeStatusPending is a change not applied, because of DryRun or of a previous failure.
+	SnapshotChangeStatusPending SnapshotChangeStatus = "PENDING"
+	// SnapshotChangeStatusApplied is a change applied on the edge gateway.
+	SnapshotChangeStatusApplied SnapshotChangeStatus = "APPLIED"
+	// SnapshotChangeStatusFailed is a change that failed, the next changes are not applied.
+	SnapshotChangeStatusFailed SnapshotChangeStatus = "FAILED"
+	// SnapshotChangeStatusConflict is a change using a public IP that is not allocated to the edge gateway.
+	SnapshotChangeStatusConflict SnapshotChangeStatus = "CONFLICT"
+)
+
+// hasLoadBalancer reports whether the snapshot contains load balancer objects.
+func (s *SnapshotModel) hasLoadBalancer() bool {
+	return len(s.LoadBalancer.Pools) > 0 || len(s.LoadBalancer.VirtualServices) > 0
+}
+
+// withIPMapping returns a copy of the snapshot where the IP addresses found in the mapping are replaced.
+func (s *SnapshotModel) withIPMapping(mapping map[string]string) *SnapshotModel {
+	if len(mapping) == 0 {
+		return s
+	}
+
+	replace := func(address string) string {
+		if mapped, ok := mapping[address]; ok {
+			return mapped
+		}
+		return address
+	}
+
+	m := *s
+
+	m.NetworkServices.PublicIPs = make([]string, 0, len(s.NetworkServices.PublicIPs))
+	for _, publicIP := range s.NetworkServices.PublicIPs {
+		m.NetworkServices.PublicIPs = append(m.NetworkServices.PublicIPs, replace(publicIP))
+	}
+
+	m.NATRules = slices.Clone(s.NATRules)
+	for i := range m.NATRules {
+		m.NATRules[i].ExternalAddresses = replace(m.NATRules[i].ExternalAddresses)
+		m.NATRules[i].InternalAddresses = replace(m.NATRules[i].InternalAddresses)
+	}
+
+	m.LoadBalancer.VirtualServices = slices.Clone(s.LoadBalancer.VirtualServices)
+	for i := range m.LoadBalancer.VirtualServices {
+		m.LoadBalancer.VirtualServices[i].VirtualIPAddress = replace(m.LoadBalancer.VirtualServices[i].VirtualIPAddress)
+		m.LoadBalancer.VirtualServices[i].IPv6VirtualIPAddress = replace(m.LoadBalancer.VirtualServices[i].IPv6VirtualIPAddress)
+	}
+
+	return &m
+}
+
+// toVCD converts the IP set to the VCD format.
+func (m SnapshotModelIPSet) toVCD(edgeGatewayID string) *govcdtypes.NsxtFirewallGroup {
+	return &govcdtypes.NsxtFirewallGroup{
+		Name:        m.Name,
+		Description: m.Description,
+		IpAddresses: m.IPAddresses,
+		OwnerRef:    &govcdtypes.OpenApiReference{ID: edgeGatewayID},
+		TypeValue:   govcdtypes.FirewallGroupTypeIpSet,
+	}
+}
+
+// toVCD converts the security group to the VCD format.
+// members are the references of the networks of the security group.
+func (m SnapshotModelSecurityGroup) toVCD(edgeGatewayID string, members []govcdtypes.OpenApiReference) *govcdtypes.NsxtFirewallGroup {
+	return &govcdtypes.NsxtFirewallGroup{
+		Name:        m.Name,
+		Description: m.Description,
+		Members:     members,
+		OwnerRef:    &govcdtypes.OpenApiReference{ID: edgeGatewayID},
+		TypeValue:   govcdtypes.FirewallGroupTypeSecurityGroup,
+	}
+}
+
+// toVCD converts the application port profile to the VCD format.
+func (m SnapshotModelAppPortProfile) toVCD(orgRef *govcdtypes.OpenApiReference, ownerID string) *govcdtypes.NsxtAppPortProfile {
+	ports := make([]govcdtypes.NsxtAppPortProfilePort, 0, len(m.Ports))
+	for _, port := range m.Ports {
+		ports = append(ports, govcdtypes.NsxtAppPortProfilePort{
+			Protocol:         port.Protocol,
+			DestinationPorts: port.DestinationPorts,
+		})
+	}
+
+	return &govcdtypes.NsxtAppPortProfile{
+		Name:             m.Name,
+		Description:      m.Description,
+		ApplicationPorts: ports,
+		OrgRef:           orgRef,
+		ContextEntityId:  ownerID,
+		Scope:            govcdtypes.ApplicationPortProfileScopeTenant,
+	}
+}
+
+// toVCD converts the NAT rule to the VCD format.
+func (m SnapshotModelNATRule) toVCD(appPortProfile *govcdtypes.OpenApiReference) *govcdtypes.NsxtNatRule {
+	return &govcdtypes.NsxtNatRule{
+		Name:                     m.Name,
+		Description:              m.Description,
+		Enabled:                  m.Enabled,
+		Type:                     m.Type,
+		ExternalAddresses:        m.ExternalAddresses,
+		InternalAddresses:        m.InternalAddresses,
+		SnatDestinationAddresses: m.SnatDestinationAddresses,
+		DnatExternalPort:         m.DnatExternalPort,
+		ApplicationPortProfile:   appPortProfile,
+		Logging:                  m.Logging,
+		FirewallMatch:            m.FirewallMatch,
+		Priority:                 m.Priority,
+	}
+}
+
+// fromVCD converts a VCD NAT rule to the snapshot format.
+func (m *SnapshotModelNATRule) fromVCD(natRule *govcdtypes.NsxtNatRule) {
+	m.Name = natRule.Name
+	m.Description = natRule.Description
+	m.Enabled = natRule.Enabled
+	// Type replaces the deprecated RuleType field.
+	m.Type = natRule.Type
+	if m.Type == "" {
+		m.Type = natRule.RuleType
+	}
+	m.ExternalAddresses = natRule.ExternalAddresses
+	m.InternalAddresses = natRule.InternalAddresses
+	m.SnatDestinationAddresses = natRule.SnatDestinationAddresses
+	m.DnatExternalPort = natRule.DnatExternalPort
+	if natRule.ApplicationPortProfile != nil {
+		m.ApplicationPortProfile = natRule.ApplicationPortProfile.Name
+	}
+	m.Logging = natRule.Logging
+	m.FirewallMatch = natRule.FirewallMatch
+	m.Priority = natRule.Priority
+}
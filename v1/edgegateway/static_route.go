@@ -0,0 +1,323 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+
+	"github.com/orange-cloudavenue/common-go/validators"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+)
+
+var (
+	listStaticRoutes = func(edgeClient fakeStaticRouteEdgeGatewayClient) ([]*govcd.NsxtEdgeGatewayStaticRoute, error) {
+		return edgeClient.GetAllStaticRoutes(nil)
+	}
+
+	getStaticRoute = func(edgeClient fakeStaticRouteEdgeGatewayClient, staticRouteNameOrID string) (*govcd.NsxtEdgeGatewayStaticRoute, error) {
+		// Static route IDs are plain UUIDs (not URNs)
+		if urn.IsUUIDV4(staticRouteNameOrID) {
+			return edgeClient.GetStaticRouteById(staticRouteNameOrID)
+		}
+		return edgeClient.GetStaticRouteByName(staticRouteNameOrID)
+	}
+
+	createStaticRoute = func(edgeClient fakeStaticRouteEdgeGatewayClient, staticRoute *govcdtypes.NsxtEdgeGatewayStaticRoute) (*govcd.NsxtEdgeGatewayStaticRoute, error) {
+		return edgeClient.CreateStaticRoute(staticRoute)
+	}
+
+	updateStaticRoute = func(staticRouteClient fakeStaticRouteClient, staticRoute *govcdtypes.NsxtEdgeGatewayStaticRoute) (*govcd.NsxtEdgeGatewayStaticRoute, error) {
+		return staticRouteClient.Update(staticRoute)
+	}
+
+	deleteStaticRoute = func(staticRouteClient fakeStaticRouteClient) error {
+		return staticRouteClient.Delete()
+	}
+)
+
+// ListStaticRoutes retrieves all static routes of an edge gateway.
+func (c *client) ListStaticRoutes(ctx context.Context, edgeGatewayNameOrID string) ([]*StaticRouteModel, error) {
+	if err := c.edgeGatewayNameOrIDValidation(edgeGatewayNameOrID); err != nil {
+		return nil, err
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return nil, err
+	}
+
+	edgeGateway, err := c.getVCDEdgeGateway(ctx, edgeGatewayNameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	staticRoutes, err := listStaticRoutes(edgeGateway)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving static routes: %w", err)
+	}
+
+	staticRouteModels := make([]*StaticRouteModel, 0, len(staticRoutes))
+	for _, sr := range staticRoutes {
+		srm := &StaticRouteModel{}
+		srm.fromVCD(sr.NsxtEdgeGatewayStaticRoute)
+
+		staticRouteModels = append(staticRouteModels, srm)
+	}
+
+	return staticRouteModels, nil
+}
+
+// GetStaticRoute retrieves a static route of an edge gateway by name or ID.
+func (c *client) GetStaticRoute(ctx context.Context, edgeGatewayNameOrID, staticRouteNameOrID string) (*StaticRouteModel, error) {
+	if err := c.edgeGatewayNameOrIDValidation(edgeGatewayNameOrID); err != nil {
+		return nil, err
+	}
+
+	if staticRouteNameOrID == "" {
+		return nil, fmt.Errorf("staticRouteNameOrID is %w. Please provide a valid staticRouteNameOrID", errors.ErrEmpty)
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return nil, err
+	}
+
+	edgeGateway, err := c.getVCDEdgeGateway(ctx, edgeGatewayNameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	staticRoute, err := getStaticRoute(edgeGateway, staticRouteNameOrID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving static route %s: %w", staticRouteNameOrID, err)
+	}
+
+	srm := &StaticRouteModel{}
+	srm.fromVCD(staticRoute.NsxtEdgeGatewayStaticRoute)
+
+	return srm, nil
+}
+
+// CreateStaticRoute creates a new static route on an edge gateway.
+// Each next hop must be reachable through a routed network of the edge gateway's owner (VDC or VDC Group).
+func (c *client) CreateStaticRoute(ctx context.Context, edgeGatewayNameOrID string, staticRoute StaticRouteModelRequest) (*StaticRouteModel, error) {
+	if err := c.edgeGatewayNameOrIDValidation(edgeGatewayNameOrID); err != nil {
+		return nil, err
+	}
+
+	if err := validators.New().StructCtx(ctx, &staticRoute); err != nil {
+		return nil, err
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return nil, err
+	}
+
+	edgeGateway, err := c.getVCDEdgeGateway(ctx, edgeGatewayNameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	nextHops, err := c.resolveStaticRouteNextHops(ctx, edgeGateway.EdgeGateway, staticRoute.NextHops)
+	if err != nil {
+		return nil, err
+	}
+
+	staticRouteCreated, err := createStaticRoute(edgeGateway, &govcdtypes.NsxtEdgeGatewayStaticRoute{
+		Name:        staticRoute.Name,
+		Description: staticRoute.Description,
+		NetworkCidr: staticRoute.NetworkCIDR,
+		NextHops:    nextHops,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating static route: %w", err)
+	}
+
+	srm := &StaticRouteModel{}
+	srm.fromVCD(staticRouteCreated.NsxtEdgeGatewayStaticRoute)
+
+	return srm, nil
+}
+
+// UpdateStaticRoute updates an existing static route of an edge gateway.
+// Each next hop must be reachable through a routed network of the edge gateway's owner (VDC or VDC Group).
+func (c *client) UpdateStaticRoute(ctx context.Context, edgeGatewayNameOrID, staticRouteID string, staticRoute StaticRouteModelRequest) (*StaticRouteModel, error) {
+	if err := c.edgeGatewayNameOrIDValidation(edgeGatewayNameOrID); err != nil {
+		return nil, err
+	}
+
+	if staticRouteID == "" {
+		return nil, fmt.Errorf("staticRouteID is %w. Please provide a valid staticRouteID", errors.ErrEmpty)
+	}
+
+	if !urn.IsUUIDV4(staticRouteID) {
+		return nil, fmt.Errorf("staticRouteID has %w. Please provide a valid staticRouteID", errors.ErrInvalidFormat)
+	}
+
+	if err := validators.New().StructCtx(ctx, &staticRoute); err != nil {
+		return nil, err
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return nil, err
+	}
+
+	edgeGateway, err := c.getVCDEdgeGateway(ctx, edgeGatewayNameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	staticRouteToUpdate, err := getStaticRoute(edgeGateway, staticRouteID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving static route %s: %w", staticRouteID, err)
+	}
+
+	nextHops, err := c.resolveStaticRouteNextHops(ctx, edgeGateway.EdgeGateway, staticRoute.NextHops)
+	if err != nil {
+		return nil, err
+	}
+
+	staticRouteUpdated, err := updateStaticRoute(staticRouteToUpdate, &govcdtypes.NsxtEdgeGatewayStaticRoute{
+		ID:          staticRouteToUpdate.NsxtEdgeGatewayStaticRoute.ID,
+		Name:        staticRoute.Name,
+		Description: staticRoute.Description,
+		NetworkCidr: staticRoute.NetworkCIDR,
+		NextHops:    nextHops,
+		// Version is required to prevent overwriting a concurrent change
+		Version: staticRouteToUpdate.NsxtEdgeGatewayStaticRoute.Version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error updating static route: %w", err)
+	}
+
+	srm := &StaticRouteModel{}
+	srm.fromVCD(staticRouteUpdated.NsxtEdgeGatewayStaticRoute)
+
+	return srm, nil
+}
+
+// DeleteStaticRoute deletes a static route of an edge gateway.
+func (c *client) DeleteStaticRoute(ctx context.Context, edgeGatewayNameOrID, staticRouteID string) error {
+	if err := c.edgeGatewayNameOrIDValidation(edgeGatewayNameOrID); err != nil {
+		return err
+	}
+
+	if staticRouteID == "" {
+		return fmt.Errorf("staticRouteID is %w. Please provide a valid staticRouteID", errors.ErrEmpty)
+	}
+
+	if !urn.IsUUIDV4(staticRouteID) {
+		return fmt.Errorf("staticRouteID has %w. Please provide a valid staticRouteID", errors.ErrInvalidFormat)
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return err
+	}
+
+	edgeGateway, err := c.getVCDEdgeGateway(ctx, edgeGatewayNameOrID)
+	if err != nil {
+		return err
+	}
+
+	staticRouteToDelete, err := getStaticRoute(edgeGateway, staticRouteID)
+	if err != nil {
+		return fmt.Errorf("error retrieving static route %s: %w", staticRouteID, err)
+	}
+
+	return deleteStaticRoute(staticRouteToDelete)
+}
+
+// * Local functions
+
+// resolveStaticRouteNextHops validates that each next hop is reachable through a routed network
+// connected to the edge gateway and owned by the edge gateway's owner (VDC or VDC Group).
+// It returns the next hops in the VCD format with their scope set.
+func (c *client) resolveStaticRouteNextHops(_ context.Context, edgeGateway *govcdtypes.OpenAPIEdgeGateway, nextHops []StaticRouteModelNextHopRequest) ([]govcdtypes.NsxtEdgeGatewayStaticRouteNextHops, error) {
+	if edgeGateway == nil || edgeGateway.OwnerRef == nil {
+		return nil, fmt.Errorf("edge gateway owner is %w", errors.ErrEmpty)
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("filter", fmt.Sprintf("ownerRef.id==%s", edgeGateway.OwnerRef.ID))
+
+	networks, err := c.clientGoVCDOrg.GetAllOpenApiOrgVdcNetworks(queryParams)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving networks of %s: %w", edgeGateway.OwnerRef.Name, err)
+	}
+
+	// Keep only the routed networks connected to this edge gateway
+	routedNetworks := make([]*govcdtypes.OpenApiOrgVdcNetwork, 0)
+	for _, network := range networks {
+		n := network.OpenApiOrgVdcNetwork
+		if n == nil || n.NetworkType != govcdtypes.OrgVdcNetworkTypeRouted || n.Connection == nil || n.Connection.RouterRef.ID != edgeGateway.ID {
+			continue
+		}
+		routedNetworks = append(routedNetworks, n)
+	}
+
+	vcdNextHops := make([]govcdtypes.NsxtEdgeGatewayStaticRouteNextHops, len(nextHops))
+	for i, nextHop := range nextHops {
+		network := findRoutedNetworkForIP(routedNetworks, nextHop.IPAddress, nextHop.ScopeNetworkNameOrID)
+		if network == nil {
+			return nil, fmt.Errorf("next hop %s is not reachable through a routed network of %s connected to the edge gateway %s: %w", nextHop.IPAddress, edgeGateway.OwnerRef.Name, edgeGateway.Name, errors.ErrNotFound)
+		}
+
+		adminDistance := nextHop.AdminDistance
+		if adminDistance == 0 {
+			adminDistance = staticRouteDefaultAdminDistance
+		}
+
+		vcdNextHops[i] = govcdtypes.NsxtEdgeGatewayStaticRouteNextHops{
+			IPAddress:     nextHop.IPAddress,
+			AdminDistance: adminDistance,
+			Scope: &govcdtypes.NsxtEdgeGatewayStaticRouteNextHopScope{
+				ID:        network.ID,
+				Name:      network.Name,
+				ScopeType: string(StaticRouteScopeTypeNetwork),
+			},
+		}
+	}
+
+	return vcdNextHops, nil
+}
+
+// findRoutedNetworkForIP returns the first network whose subnets contain the IP address.
+// If networkNameOrID is set, only the network matching this name or ID is considered.
+func findRoutedNetworkForIP(networks []*govcdtypes.OpenApiOrgVdcNetwork, ipAddress, networkNameOrID string) *govcdtypes.OpenApiOrgVdcNetwork {
+	ip := net.ParseIP(ipAddress)
+	if ip == nil {
+		return nil
+	}
+
+	for _, network := range networks {
+		if networkNameOrID != "" && network.ID != networkNameOrID && network.Name != networkNameOrID {
+			continue
+		}
+
+		for _, subnet := range network.Subnets.Values {
+			_, ipNet, err := net.ParseCIDR(fmt.Sprintf("%s/%d", subnet.Gateway, subnet.PrefixLength))
+			if err != nil {
+				continue
+			}
+
+			if ipNet.Contains(ip) {
+				return network
+			}
+		}
+	}
+
+	return nil
+}
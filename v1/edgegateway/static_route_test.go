@@ -0,0 +1,725 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/utils"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+)
+
+const (
+	testStaticRouteName    = "test-static-route"
+	testStaticRouteDesc    = "test static route description"
+	testStaticRouteCIDR    = "10.10.0.0/16"
+	testStaticRouteNextHop = "192.168.1.254"
+	testRoutedNetworkName  = "test-routed-network"
+)
+
+func testVCDEdgeGateway(edgeGatewayID, vdcID string) *govcd.NsxtEdgeGateway {
+	return &govcd.NsxtEdgeGateway{
+		EdgeGateway: &govcdtypes.OpenAPIEdgeGateway{
+			ID:   edgeGatewayID,
+			Name: testEdgeGatewayName,
+			OwnerRef: &govcdtypes.OpenApiReference{
+				ID:   vdcID,
+				Name: testVDCName,
+			},
+		},
+	}
+}
+
+func testRoutedNetworks(edgeGatewayID, networkID string) []*govcd.OpenApiOrgVdcNetwork {
+	return []*govcd.OpenApiOrgVdcNetwork{
+		{
+			OpenApiOrgVdcNetwork: &govcdtypes.OpenApiOrgVdcNetwork{
+				ID:          networkID,
+				Name:        testRoutedNetworkName,
+				NetworkType: govcdtypes.OrgVdcNetworkTypeRouted,
+				Connection: &govcdtypes.Connection{
+					RouterRef: govcdtypes.OpenApiReference{ID: edgeGatewayID},
+				},
+				Subnets: govcdtypes.OrgVdcNetworkSubnets{
+					Values: []govcdtypes.OrgVdcNetworkSubnetValues{
+						{
+							Gateway:      "192.168.1.1",
+							PrefixLength: 24,
+						},
+					},
+				},
+			},
+		},
+		{
+			// Isolated network is ignored
+			OpenApiOrgVdcNetwork: &govcdtypes.OpenApiOrgVdcNetwork{
+				ID:          urn.Network.String() + uuid.New().String(),
+				Name:        "isolated",
+				NetworkType: govcdtypes.OrgVdcNetworkTypeIsolated,
+				Subnets: govcdtypes.OrgVdcNetworkSubnets{
+					Values: []govcdtypes.OrgVdcNetworkSubnetValues{
+						{
+							Gateway:      "172.16.0.1",
+							PrefixLength: 24,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestClient_ListStaticRoutes(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+	networkID := urn.Network.String() + uuid.New().String()
+	staticRouteID := uuid.New().String()
+
+	tests := []struct {
+		name                 string
+		edgeGatewayNameOrID  string
+		mockFunc             func()
+		expectedStaticRoutes []*StaticRouteModel
+		expectedError        bool
+		err                  error
+	}{
+		{
+			name:                testSuccess,
+			edgeGatewayNameOrID: edgeGatewayID,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				listStaticRoutes = func(_ fakeStaticRouteEdgeGatewayClient) ([]*govcd.NsxtEdgeGatewayStaticRoute, error) {
+					return []*govcd.NsxtEdgeGatewayStaticRoute{
+						{
+							NsxtEdgeGatewayStaticRoute: &govcdtypes.NsxtEdgeGatewayStaticRoute{
+								ID:          staticRouteID,
+								Name:        testStaticRouteName,
+								Description: testStaticRouteDesc,
+								NetworkCidr: testStaticRouteCIDR,
+								NextHops: []govcdtypes.NsxtEdgeGatewayStaticRouteNextHops{
+									{
+										IPAddress:     testStaticRouteNextHop,
+										AdminDistance: 1,
+										Scope: &govcdtypes.NsxtEdgeGatewayStaticRouteNextHopScope{
+											ID:        networkID,
+											Name:      testRoutedNetworkName,
+											ScopeType: string(StaticRouteScopeTypeNetwork),
+										},
+									},
+								},
+								SystemOwned: utils.ToPTR(false),
+							},
+						},
+					}, nil
+				}
+			},
+			expectedStaticRoutes: []*StaticRouteModel{
+				{
+					ID:          staticRouteID,
+					Name:        testStaticRouteName,
+					Description: testStaticRouteDesc,
+					NetworkCIDR: testStaticRouteCIDR,
+					NextHops: []StaticRouteModelNextHop{
+						{
+							IPAddress:     testStaticRouteNextHop,
+							AdminDistance: 1,
+							Scope: &StaticRouteModelNextHopScope{
+								ID:   networkID,
+								Name: testRoutedNetworkName,
+								Type: StaticRouteScopeTypeNetwork,
+							},
+						},
+					},
+					SystemOwned: false,
+				},
+			},
+		},
+		{
+			name:                "empty-edge-gateway-name-or-id",
+			edgeGatewayNameOrID: "",
+			mockFunc:            func() {},
+			expectedError:       true,
+			err:                 fmt.Errorf("edgeGatewayNameOrID is empty"),
+		},
+		{
+			name:                "refresh-error",
+			edgeGatewayNameOrID: edgeGatewayID,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(fmt.Errorf("error"))
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error"),
+		},
+		{
+			name:                "error-get-edge-gateway",
+			edgeGatewayNameOrID: testEdgeGatewayName,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayByName(testEdgeGatewayName).Return(nil, fmt.Errorf("error"))
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error retrieving edge gateway"),
+		},
+		{
+			name:                "error-list-static-routes",
+			edgeGatewayNameOrID: edgeGatewayID,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				listStaticRoutes = func(_ fakeStaticRouteEdgeGatewayClient) ([]*govcd.NsxtEdgeGatewayStaticRoute, error) {
+					return nil, fmt.Errorf("error")
+				}
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error retrieving static routes"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mockFunc()
+
+			staticRoutes, err := c.ListStaticRoutes(context.Background(), test.edgeGatewayNameOrID)
+			if !test.expectedError {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expectedStaticRoutes, staticRoutes)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err.Error())
+		})
+	}
+}
+
+func TestClient_GetStaticRoute(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+	staticRouteID := uuid.New().String()
+
+	tests := []struct {
+		name                string
+		staticRouteNameOrID string
+		mockFunc            func()
+		expectedError       bool
+		err                 error
+	}{
+		{
+			name:                "success-by-id",
+			staticRouteNameOrID: staticRouteID,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+			},
+		},
+		{
+			name:                "success-by-name",
+			staticRouteNameOrID: testStaticRouteName,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+			},
+		},
+		{
+			name:                "empty-static-route-name-or-id",
+			staticRouteNameOrID: "",
+			mockFunc:            func() {},
+			expectedError:       true,
+			err:                 fmt.Errorf("staticRouteNameOrID is empty"),
+		},
+		{
+			name:                "error-get-static-route",
+			staticRouteNameOrID: "unknown",
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error retrieving static route unknown"),
+		},
+	}
+
+	getStaticRoute = func(_ fakeStaticRouteEdgeGatewayClient, staticRouteNameOrID string) (*govcd.NsxtEdgeGatewayStaticRoute, error) {
+		if staticRouteNameOrID != staticRouteID && staticRouteNameOrID != testStaticRouteName {
+			return nil, fmt.Errorf("not found")
+		}
+
+		return &govcd.NsxtEdgeGatewayStaticRoute{
+			NsxtEdgeGatewayStaticRoute: &govcdtypes.NsxtEdgeGatewayStaticRoute{
+				ID:          staticRouteID,
+				Name:        testStaticRouteName,
+				NetworkCidr: testStaticRouteCIDR,
+				NextHops: []govcdtypes.NsxtEdgeGatewayStaticRouteNextHops{
+					{
+						IPAddress:     testStaticRouteNextHop,
+						AdminDistance: 1,
+					},
+				},
+			},
+		}, nil
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mockFunc()
+
+			staticRoute, err := c.GetStaticRoute(context.Background(), edgeGatewayID, test.staticRouteNameOrID)
+			if !test.expectedError {
+				assert.NoError(t, err)
+				assert.Equal(t, staticRouteID, staticRoute.ID)
+				assert.Equal(t, testStaticRouteName, staticRoute.Name)
+				assert.Nil(t, staticRoute.NextHops[0].Scope)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err.Error())
+		})
+	}
+}
+
+func TestClient_CreateStaticRoute(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+	networkID := urn.Network.String() + uuid.New().String()
+	staticRouteID := uuid.New().String()
+
+	tests := []struct {
+		name          string
+		request       StaticRouteModelRequest
+		mockFunc      func()
+		expectedError bool
+		err           error
+	}{
+		{
+			name: testSuccess,
+			request: StaticRouteModelRequest{
+				Name:        testStaticRouteName,
+				NetworkCIDR: testStaticRouteCIDR,
+				NextHops: []StaticRouteModelNextHopRequest{
+					{
+						IPAddress: testStaticRouteNextHop,
+					},
+				},
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				clientCAV.EXPECT().GetAllOpenApiOrgVdcNetworks(gomock.AssignableToTypeOf(url.Values{})).Return(testRoutedNetworks(edgeGatewayID, networkID), nil)
+				createStaticRoute = func(_ fakeStaticRouteEdgeGatewayClient, staticRoute *govcdtypes.NsxtEdgeGatewayStaticRoute) (*govcd.NsxtEdgeGatewayStaticRoute, error) {
+					// The scope is resolved from the next hop IP and the admin distance is defaulted
+					assert.Equal(t, networkID, staticRoute.NextHops[0].Scope.ID)
+					assert.Equal(t, staticRouteDefaultAdminDistance, staticRoute.NextHops[0].AdminDistance)
+
+					staticRoute.ID = staticRouteID
+					return &govcd.NsxtEdgeGatewayStaticRoute{
+						NsxtEdgeGatewayStaticRoute: staticRoute,
+					}, nil
+				}
+			},
+		},
+		{
+			name: "success-with-scope",
+			request: StaticRouteModelRequest{
+				Name:        testStaticRouteName,
+				NetworkCIDR: testStaticRouteCIDR,
+				NextHops: []StaticRouteModelNextHopRequest{
+					{
+						IPAddress:            testStaticRouteNextHop,
+						AdminDistance:        10,
+						ScopeNetworkNameOrID: testRoutedNetworkName,
+					},
+				},
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				clientCAV.EXPECT().GetAllOpenApiOrgVdcNetworks(gomock.AssignableToTypeOf(url.Values{})).Return(testRoutedNetworks(edgeGatewayID, networkID), nil)
+				createStaticRoute = func(_ fakeStaticRouteEdgeGatewayClient, staticRoute *govcdtypes.NsxtEdgeGatewayStaticRoute) (*govcd.NsxtEdgeGatewayStaticRoute, error) {
+					staticRoute.ID = staticRouteID
+					return &govcd.NsxtEdgeGatewayStaticRoute{
+						NsxtEdgeGatewayStaticRoute: staticRoute,
+					}, nil
+				}
+			},
+		},
+		{
+			name: "error-validation-cidr",
+			request: StaticRouteModelRequest{
+				Name:        testStaticRouteName,
+				NetworkCIDR: "10.10.0.0",
+				NextHops: []StaticRouteModelNextHopRequest{
+					{
+						IPAddress: testStaticRouteNextHop,
+					},
+				},
+			},
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           fmt.Errorf("Error:Field validation for 'NetworkCIDR' failed on the 'cidr' tag"),
+		},
+		{
+			name: "error-validation-next-hops",
+			request: StaticRouteModelRequest{
+				Name:        testStaticRouteName,
+				NetworkCIDR: testStaticRouteCIDR,
+			},
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           fmt.Errorf("Error:Field validation for 'NextHops' failed on the 'required' tag"),
+		},
+		{
+			name: "error-next-hop-not-reachable",
+			request: StaticRouteModelRequest{
+				Name:        testStaticRouteName,
+				NetworkCIDR: testStaticRouteCIDR,
+				NextHops: []StaticRouteModelNextHopRequest{
+					{
+						// Only reachable through the isolated network
+						IPAddress: "172.16.0.254",
+					},
+				},
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				clientCAV.EXPECT().GetAllOpenApiOrgVdcNetworks(gomock.AssignableToTypeOf(url.Values{})).Return(testRoutedNetworks(edgeGatewayID, networkID), nil)
+			},
+			expectedError: true,
+			err:           fmt.Errorf("next hop 172.16.0.254 is not reachable"),
+		},
+		{
+			name: "error-next-hop-wrong-scope",
+			request: StaticRouteModelRequest{
+				Name:        testStaticRouteName,
+				NetworkCIDR: testStaticRouteCIDR,
+				NextHops: []StaticRouteModelNextHopRequest{
+					{
+						IPAddress:            testStaticRouteNextHop,
+						ScopeNetworkNameOrID: "another-network",
+					},
+				},
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				clientCAV.EXPECT().GetAllOpenApiOrgVdcNetworks(gomock.AssignableToTypeOf(url.Values{})).Return(testRoutedNetworks(edgeGatewayID, networkID), nil)
+			},
+			expectedError: true,
+			err:           fmt.Errorf("next hop %s is not reachable", testStaticRouteNextHop),
+		},
+		{
+			name: "error-get-networks",
+			request: StaticRouteModelRequest{
+				Name:        testStaticRouteName,
+				NetworkCIDR: testStaticRouteCIDR,
+				NextHops: []StaticRouteModelNextHopRequest{
+					{
+						IPAddress: testStaticRouteNextHop,
+					},
+				},
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				clientCAV.EXPECT().GetAllOpenApiOrgVdcNetworks(gomock.AssignableToTypeOf(url.Values{})).Return(nil, fmt.Errorf("error"))
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error retrieving networks of %s", testVDCName),
+		},
+		{
+			name: "error-create-static-route",
+			request: StaticRouteModelRequest{
+				Name:        testStaticRouteName,
+				NetworkCIDR: testStaticRouteCIDR,
+				NextHops: []StaticRouteModelNextHopRequest{
+					{
+						IPAddress: testStaticRouteNextHop,
+					},
+				},
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				clientCAV.EXPECT().GetAllOpenApiOrgVdcNetworks(gomock.AssignableToTypeOf(url.Values{})).Return(testRoutedNetworks(edgeGatewayID, networkID), nil)
+				createStaticRoute = func(_ fakeStaticRouteEdgeGatewayClient, _ *govcdtypes.NsxtEdgeGatewayStaticRoute) (*govcd.NsxtEdgeGatewayStaticRoute, error) {
+					return nil, fmt.Errorf("error")
+				}
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error creating static route"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mockFunc()
+
+			staticRoute, err := c.CreateStaticRoute(context.Background(), edgeGatewayID, test.request)
+			if !test.expectedError {
+				assert.NoError(t, err)
+				assert.Equal(t, staticRouteID, staticRoute.ID)
+				assert.Equal(t, test.request.Name, staticRoute.Name)
+				assert.Equal(t, test.request.NetworkCIDR, staticRoute.NetworkCIDR)
+				assert.Equal(t, networkID, staticRoute.NextHops[0].Scope.ID)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err.Error())
+		})
+	}
+}
+
+func TestClient_UpdateStaticRoute(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+	networkID := urn.Network.String() + uuid.New().String()
+	staticRouteID := uuid.New().String()
+
+	request := StaticRouteModelRequest{
+		Name:        testStaticRouteName,
+		Description: testStaticRouteDesc,
+		NetworkCIDR: testStaticRouteCIDR,
+		NextHops: []StaticRouteModelNextHopRequest{
+			{
+				IPAddress: testStaticRouteNextHop,
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		staticRouteID string
+		mockFunc      func()
+		expectedError bool
+		err           error
+	}{
+		{
+			name:          testSuccess,
+			staticRouteID: staticRouteID,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				clientCAV.EXPECT().GetAllOpenApiOrgVdcNetworks(gomock.AssignableToTypeOf(url.Values{})).Return(testRoutedNetworks(edgeGatewayID, networkID), nil)
+				getStaticRoute = func(_ fakeStaticRouteEdgeGatewayClient, _ string) (*govcd.NsxtEdgeGatewayStaticRoute, error) {
+					return &govcd.NsxtEdgeGatewayStaticRoute{
+						NsxtEdgeGatewayStaticRoute: &govcdtypes.NsxtEdgeGatewayStaticRoute{
+							ID:      staticRouteID,
+							Name:    "old-name",
+							Version: "1",
+						},
+					}, nil
+				}
+				updateStaticRoute = func(_ fakeStaticRouteClient, staticRoute *govcdtypes.NsxtEdgeGatewayStaticRoute) (*govcd.NsxtEdgeGatewayStaticRoute, error) {
+					// The version of the route read is sent back to detect concurrent changes
+					assert.Equal(t, "1", staticRoute.Version)
+					assert.Equal(t, staticRouteID, staticRoute.ID)
+
+					return &govcd.NsxtEdgeGatewayStaticRoute{
+						NsxtEdgeGatewayStaticRoute: staticRoute,
+					}, nil
+				}
+			},
+		},
+		{
+			name:          "empty-static-route-id",
+			staticRouteID: "",
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           fmt.Errorf("staticRouteID is empty"),
+		},
+		{
+			name:          "invalid-static-route-id",
+			staticRouteID: testStaticRouteName,
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           fmt.Errorf("staticRouteID has invalid format"),
+		},
+		{
+			name:          "error-get-static-route",
+			staticRouteID: staticRouteID,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getStaticRoute = func(_ fakeStaticRouteEdgeGatewayClient, _ string) (*govcd.NsxtEdgeGatewayStaticRoute, error) {
+					return nil, fmt.Errorf("error")
+				}
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error retrieving static route"),
+		},
+		{
+			name:          "error-update-static-route",
+			staticRouteID: staticRouteID,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				clientCAV.EXPECT().GetAllOpenApiOrgVdcNetworks(gomock.AssignableToTypeOf(url.Values{})).Return(testRoutedNetworks(edgeGatewayID, networkID), nil)
+				getStaticRoute = func(_ fakeStaticRouteEdgeGatewayClient, _ string) (*govcd.NsxtEdgeGatewayStaticRoute, error) {
+					return &govcd.NsxtEdgeGatewayStaticRoute{
+						NsxtEdgeGatewayStaticRoute: &govcdtypes.NsxtEdgeGatewayStaticRoute{
+							ID: staticRouteID,
+						},
+					}, nil
+				}
+				updateStaticRoute = func(_ fakeStaticRouteClient, _ *govcdtypes.NsxtEdgeGatewayStaticRoute) (*govcd.NsxtEdgeGatewayStaticRoute, error) {
+					return nil, fmt.Errorf("error")
+				}
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error updating static route"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mockFunc()
+
+			staticRoute, err := c.UpdateStaticRoute(context.Background(), edgeGatewayID, test.staticRouteID, request)
+			if !test.expectedError {
+				assert.NoError(t, err)
+				assert.Equal(t, testStaticRouteName, staticRoute.Name)
+				assert.Equal(t, testStaticRouteDesc, staticRoute.Description)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err.Error())
+		})
+	}
+}
+
+func TestClient_DeleteStaticRoute(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientInterface(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	vdcID := urn.VDC.String() + uuid.New().String()
+	staticRouteID := uuid.New().String()
+
+	tests := []struct {
+		name          string
+		staticRouteID string
+		mockFunc      func()
+		expectedError bool
+		err           error
+	}{
+		{
+			name:          testSuccess,
+			staticRouteID: staticRouteID,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getStaticRoute = func(_ fakeStaticRouteEdgeGatewayClient, _ string) (*govcd.NsxtEdgeGatewayStaticRoute, error) {
+					return &govcd.NsxtEdgeGatewayStaticRoute{}, nil
+				}
+				deleteStaticRoute = func(_ fakeStaticRouteClient) error {
+					return nil
+				}
+			},
+		},
+		{
+			name:          "invalid-static-route-id",
+			staticRouteID: testStaticRouteName,
+			mockFunc:      func() {},
+			expectedError: true,
+			err:           fmt.Errorf("staticRouteID has invalid format"),
+		},
+		{
+			name:          "refresh-error",
+			staticRouteID: staticRouteID,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(fmt.Errorf("error"))
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error"),
+		},
+		{
+			name:          "error-delete-static-route",
+			staticRouteID: staticRouteID,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testVCDEdgeGateway(edgeGatewayID, vdcID), nil)
+				getStaticRoute = func(_ fakeStaticRouteEdgeGatewayClient, _ string) (*govcd.NsxtEdgeGatewayStaticRoute, error) {
+					return &govcd.NsxtEdgeGatewayStaticRoute{}, nil
+				}
+				deleteStaticRoute = func(_ fakeStaticRouteClient) error {
+					return fmt.Errorf("error")
+				}
+			},
+			expectedError: true,
+			err:           fmt.Errorf("error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mockFunc()
+
+			err := c.DeleteStaticRoute(context.Background(), edgeGatewayID, test.staticRouteID)
+			if !test.expectedError {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.err.Error())
+		})
+	}
+}
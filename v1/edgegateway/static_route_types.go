@@ -0,0 +1,147 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgegateway
+
+import (
+	"net/url"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+type (
+	fakeStaticRouteEdgeGatewayClient interface {
+		GetAllStaticRoutes(queryParameters url.Values) ([]*govcd.NsxtEdgeGatewayStaticRoute, error)
+		GetStaticRouteById(id string) (*govcd.NsxtEdgeGatewayStaticRoute, error)
+		GetStaticRouteByName(name string) (*govcd.NsxtEdgeGatewayStaticRoute, error)
+		CreateStaticRoute(staticRouteConfig *govcdtypes.NsxtEdgeGatewayStaticRoute) (*govcd.NsxtEdgeGatewayStaticRoute, error)
+	}
+
+	fakeStaticRouteClient interface {
+		Update(staticRouteConfig *govcdtypes.NsxtEdgeGatewayStaticRoute) (*govcd.NsxtEdgeGatewayStaticRoute, error)
+		Delete() error
+	}
+
+	// StaticRouteModel represents a static route of an edge gateway.
+	StaticRouteModel struct {
+		ID string
+
+		// Name of the static route
+		Name string
+
+		// Description of the static route
+		Description string
+
+		// NetworkCIDR contains the network prefix in CIDR format (IPv4 or IPv6).
+		NetworkCIDR string
+
+		// NextHops is the list of next hops used by the static route.
+		NextHops []StaticRouteModelNextHop
+
+		// SystemOwned reports whether the static route is managed by the system (read-only).
+		SystemOwned bool
+	}
+
+	// StaticRouteModelNextHop represents a next hop of a static route.
+	StaticRouteModelNextHop struct {
+		// IPAddress of the next hop gateway.
+		IPAddress string
+
+		// AdminDistance of the next hop. The lowest value is preferred.
+		AdminDistance int
+
+		// Scope is the network where the next hop is reachable.
+		// If nil, the scope is resolved by the platform.
+		Scope *StaticRouteModelNextHopScope
+	}
+
+	// StaticRouteModelNextHopScope represents the entity where a next hop is reachable.
+	StaticRouteModelNextHopScope struct {
+		ID   string
+		Name string
+
+		// Type of the scope.
+		// * StaticRouteScopeTypeNetwork - The next hop is reachable through an Org VDC network.
+		// * StaticRouteScopeTypeSystemOwned - The next hop is configured outside of Cloud Director.
+		Type StaticRouteScopeType
+	}
+
+	StaticRouteScopeType string
+
+	// StaticRouteModelRequest represents the request model for creating or updating a static route.
+	StaticRouteModelRequest struct {
+		// Name of the static route
+		Name string `json:"name" validate:"required"`
+
+		// Description of the static route
+		Description string `json:"description" validate:"omitempty"`
+
+		// NetworkCIDR contains the network prefix in CIDR format (IPv4 or IPv6).
+		NetworkCIDR string `json:"networkCIDR" validate:"required,cidr"`
+
+		// NextHops is the list of next hops used by the static route.
+		// Each next hop must be reachable through a routed network
+		// of the edge gateway's owner (VDC or VDC Group).
+		NextHops []StaticRouteModelNextHopRequest `json:"nextHops" validate:"required,min=1,dive"`
+	}
+
+	// StaticRouteModelNextHopRequest represents a next hop of a static route request.
+	StaticRouteModelNextHopRequest struct {
+		// IPAddress of the next hop gateway.
+		IPAddress string `json:"ipAddress" validate:"required,ip"`
+
+		// AdminDistance of the next hop. The lowest value is preferred.
+		// Default value is 1.
+		AdminDistance int `json:"adminDistance" validate:"omitempty,min=1,max=255"`
+
+		// ScopeNetworkNameOrID is the name or the ID of the routed network where the next hop is reachable.
+		// If not set, the network is found automatically from the next hop IP address.
+		ScopeNetworkNameOrID string `json:"scopeNetworkNameOrID" validate:"omitempty"`
+	}
+)
+
+const (
+	// StaticRouteScopeTypeNetwork is the scope type of a next hop reachable through an Org VDC network.
+	StaticRouteScopeTypeNetwork StaticRouteScopeType = "NETWORK"
+	// StaticRouteScopeTypeSystemOwned is the scope type of a next hop configured outside of Cloud Director.
+	StaticRouteScopeTypeSystemOwned StaticRouteScopeType = "SYSTEM_OWNED"
+
+	// staticRouteDefaultAdminDistance is the admin distance applied when none is provided.
+	staticRouteDefaultAdminDistance = 1
+)
+
+// fromVCD converts a VCD static route to the internal StaticRouteModel.
+func (m *StaticRouteModel) fromVCD(vcdStaticRoute *govcdtypes.NsxtEdgeGatewayStaticRoute) {
+	if vcdStaticRoute == nil {
+		return
+	}
+
+	m.ID = vcdStaticRoute.ID
+	m.Name = vcdStaticRoute.Name
+	m.Description = vcdStaticRoute.Description
+	m.NetworkCIDR = vcdStaticRoute.NetworkCidr
+	m.SystemOwned = vcdStaticRoute.SystemOwned != nil && *vcdStaticRoute.SystemOwned
+
+	m.NextHops = make([]StaticRouteModelNextHop, len(vcdStaticRoute.NextHops))
+	for i, nextHop := range vcdStaticRoute.NextHops {
+		m.NextHops[i] = StaticRouteModelNextHop{
+			IPAddress:     nextHop.IPAddress,
+			AdminDistance: nextHop.AdminDistance,
+		}
+
+		if nextHop.Scope != nil {
+			m.NextHops[i].Scope = &StaticRouteModelNextHopScope{
+				ID:   nextHop.Scope.ID,
+				Name: nextHop.Scope.Name,
+				Type: StaticRouteScopeType(nextHop.Scope.ScopeType),
+			}
+		}
+	}
+}
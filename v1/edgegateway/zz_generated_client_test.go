@@ -18,12 +18,16 @@ package edgegateway
 
 import (
 	context "context"
+	iter "iter"
 	http "net/http"
 	url "net/url"
 	reflect "reflect"
 
 	resty "github.com/go-resty/resty/v2"
+	edgeloadbalancer "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/edgeloadbalancer"
+	org "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/org"
 	govcd "github.com/vmware/go-vcloud-director/v2/govcd"
+	types "github.com/vmware/go-vcloud-director/v2/types/v56"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -51,6 +55,35 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// AddFirewallRule mocks base method.
+func (m *MockClient) AddFirewallRule(ctx context.Context, edgeGatewayNameOrID string, rule FirewallRuleModelRequest, position FirewallRulePosition) (*FirewallRuleModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFirewallRule", ctx, edgeGatewayNameOrID, rule, position)
+	ret0, _ := ret[0].(*FirewallRuleModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddFirewallRule indicates an expected call of AddFirewallRule.
+func (mr *MockClientMockRecorder) AddFirewallRule(ctx, edgeGatewayNameOrID, rule, position any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFirewallRule", reflect.TypeOf((*MockClient)(nil).AddFirewallRule), ctx, edgeGatewayNameOrID, rule, position)
+}
+
+// BandwidthPlanner mocks base method.
+func (m *MockClient) BandwidthPlanner() *BandwidthPlanner {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BandwidthPlanner")
+	ret0, _ := ret[0].(*BandwidthPlanner)
+	return ret0
+}
+
+// BandwidthPlanner indicates an expected call of BandwidthPlanner.
+func (mr *MockClientMockRecorder) BandwidthPlanner() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BandwidthPlanner", reflect.TypeOf((*MockClient)(nil).BandwidthPlanner))
+}
+
 // CreateEdgeGateway mocks base method.
 func (m *MockClient) CreateEdgeGateway(ctx context.Context, edgeGateway *EdgeGatewayModelRequest) (*EdgeGatewayModel, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +99,36 @@ func (mr *MockClientMockRecorder) CreateEdgeGateway(ctx, edgeGateway any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEdgeGateway", reflect.TypeOf((*MockClient)(nil).CreateEdgeGateway), ctx, edgeGateway)
 }
 
+// CreateIPSecVPNTunnel mocks base method.
+func (m *MockClient) CreateIPSecVPNTunnel(ctx context.Context, edgeGatewayNameOrID string, tunnel IPSecVPNTunnelModelRequest) (*IPSecVPNTunnelModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIPSecVPNTunnel", ctx, edgeGatewayNameOrID, tunnel)
+	ret0, _ := ret[0].(*IPSecVPNTunnelModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIPSecVPNTunnel indicates an expected call of CreateIPSecVPNTunnel.
+func (mr *MockClientMockRecorder) CreateIPSecVPNTunnel(ctx, edgeGatewayNameOrID, tunnel any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIPSecVPNTunnel", reflect.TypeOf((*MockClient)(nil).CreateIPSecVPNTunnel), ctx, edgeGatewayNameOrID, tunnel)
+}
+
+// CreateStaticRoute mocks base method.
+func (m *MockClient) CreateStaticRoute(ctx context.Context, edgeGatewayNameOrID string, staticRoute StaticRouteModelRequest) (*StaticRouteModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStaticRoute", ctx, edgeGatewayNameOrID, staticRoute)
+	ret0, _ := ret[0].(*StaticRouteModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStaticRoute indicates an expected call of CreateStaticRoute.
+func (mr *MockClientMockRecorder) CreateStaticRoute(ctx, edgeGatewayNameOrID, staticRoute any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStaticRoute", reflect.TypeOf((*MockClient)(nil).CreateStaticRoute), ctx, edgeGatewayNameOrID, staticRoute)
+}
+
 // DeleteEdgeGateway mocks base method.
 func (m *MockClient) DeleteEdgeGateway(ctx context.Context, edgeGatewayNameOrID string) error {
 	m.ctrl.T.Helper()
@@ -80,6 +143,92 @@ func (mr *MockClientMockRecorder) DeleteEdgeGateway(ctx, edgeGatewayNameOrID any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEdgeGateway", reflect.TypeOf((*MockClient)(nil).DeleteEdgeGateway), ctx, edgeGatewayNameOrID)
 }
 
+// DeleteFirewall mocks base method.
+func (m *MockClient) DeleteFirewall(ctx context.Context, edgeGatewayNameOrID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFirewall", ctx, edgeGatewayNameOrID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFirewall indicates an expected call of DeleteFirewall.
+func (mr *MockClientMockRecorder) DeleteFirewall(ctx, edgeGatewayNameOrID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFirewall", reflect.TypeOf((*MockClient)(nil).DeleteFirewall), ctx, edgeGatewayNameOrID)
+}
+
+// DeleteFirewallRule mocks base method.
+func (m *MockClient) DeleteFirewallRule(ctx context.Context, edgeGatewayNameOrID, ruleNameOrID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFirewallRule", ctx, edgeGatewayNameOrID, ruleNameOrID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFirewallRule indicates an expected call of DeleteFirewallRule.
+func (mr *MockClientMockRecorder) DeleteFirewallRule(ctx, edgeGatewayNameOrID, ruleNameOrID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFirewallRule", reflect.TypeOf((*MockClient)(nil).DeleteFirewallRule), ctx, edgeGatewayNameOrID, ruleNameOrID)
+}
+
+// DeleteIPSecVPNTunnel mocks base method.
+func (m *MockClient) DeleteIPSecVPNTunnel(ctx context.Context, edgeGatewayNameOrID, tunnelID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIPSecVPNTunnel", ctx, edgeGatewayNameOrID, tunnelID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIPSecVPNTunnel indicates an expected call of DeleteIPSecVPNTunnel.
+func (mr *MockClientMockRecorder) DeleteIPSecVPNTunnel(ctx, edgeGatewayNameOrID, tunnelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIPSecVPNTunnel", reflect.TypeOf((*MockClient)(nil).DeleteIPSecVPNTunnel), ctx, edgeGatewayNameOrID, tunnelID)
+}
+
+// DeleteStaticRoute mocks base method.
+func (m *MockClient) DeleteStaticRoute(ctx context.Context, edgeGatewayNameOrID, staticRouteID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStaticRoute", ctx, edgeGatewayNameOrID, staticRouteID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteStaticRoute indicates an expected call of DeleteStaticRoute.
+func (mr *MockClientMockRecorder) DeleteStaticRoute(ctx, edgeGatewayNameOrID, staticRouteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStaticRoute", reflect.TypeOf((*MockClient)(nil).DeleteStaticRoute), ctx, edgeGatewayNameOrID, staticRouteID)
+}
+
+// ExportSnapshot mocks base method.
+func (m *MockClient) ExportSnapshot(ctx context.Context, edgeGatewayNameOrID string) (*SnapshotModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportSnapshot", ctx, edgeGatewayNameOrID)
+	ret0, _ := ret[0].(*SnapshotModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportSnapshot indicates an expected call of ExportSnapshot.
+func (mr *MockClientMockRecorder) ExportSnapshot(ctx, edgeGatewayNameOrID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportSnapshot", reflect.TypeOf((*MockClient)(nil).ExportSnapshot), ctx, edgeGatewayNameOrID)
+}
+
+// ExposeApplication mocks base method.
+func (m *MockClient) ExposeApplication(ctx context.Context, spec ExposeApplicationModelRequest) (*ExposedApplicationModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExposeApplication", ctx, spec)
+	ret0, _ := ret[0].(*ExposedApplicationModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExposeApplication indicates an expected call of ExposeApplication.
+func (mr *MockClientMockRecorder) ExposeApplication(ctx, spec any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExposeApplication", reflect.TypeOf((*MockClient)(nil).ExposeApplication), ctx, spec)
+}
+
 // GetEdgeGateway mocks base method.
 func (m *MockClient) GetEdgeGateway(ctx context.Context, edgeGatewayNameOrID string) (*EdgeGateway, error) {
 	m.ctrl.T.Helper()
@@ -95,265 +244,1100 @@ func (mr *MockClientMockRecorder) GetEdgeGateway(ctx, edgeGatewayNameOrID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEdgeGateway", reflect.TypeOf((*MockClient)(nil).GetEdgeGateway), ctx, edgeGatewayNameOrID)
 }
 
-// ListEdgeGateway mocks base method.
-func (m *MockClient) ListEdgeGateway(ctx context.Context) ([]*EdgeGatewayModel, error) {
+// GetFirewall mocks base method.
+func (m *MockClient) GetFirewall(ctx context.Context, edgeGatewayNameOrID string) (*FirewallModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListEdgeGateway", ctx)
-	ret0, _ := ret[0].([]*EdgeGatewayModel)
+	ret := m.ctrl.Call(m, "GetFirewall", ctx, edgeGatewayNameOrID)
+	ret0, _ := ret[0].(*FirewallModel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListEdgeGateway indicates an expected call of ListEdgeGateway.
-func (mr *MockClientMockRecorder) ListEdgeGateway(ctx any) *gomock.Call {
+// GetFirewall indicates an expected call of GetFirewall.
+func (mr *MockClientMockRecorder) GetFirewall(ctx, edgeGatewayNameOrID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEdgeGateway", reflect.TypeOf((*MockClient)(nil).ListEdgeGateway), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFirewall", reflect.TypeOf((*MockClient)(nil).GetFirewall), ctx, edgeGatewayNameOrID)
 }
 
-// UpdateEdgeGateway mocks base method.
-func (m *MockClient) UpdateEdgeGateway(ctx context.Context, edgeGateway *EdgeGatewayModelUpdate) error {
+// GetFirewallRule mocks base method.
+func (m *MockClient) GetFirewallRule(ctx context.Context, edgeGatewayNameOrID, ruleNameOrID string) (*FirewallRuleModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateEdgeGateway", ctx, edgeGateway)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetFirewallRule", ctx, edgeGatewayNameOrID, ruleNameOrID)
+	ret0, _ := ret[0].(*FirewallRuleModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateEdgeGateway indicates an expected call of UpdateEdgeGateway.
-func (mr *MockClientMockRecorder) UpdateEdgeGateway(ctx, edgeGateway any) *gomock.Call {
+// GetFirewallRule indicates an expected call of GetFirewallRule.
+func (mr *MockClientMockRecorder) GetFirewallRule(ctx, edgeGatewayNameOrID, ruleNameOrID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEdgeGateway", reflect.TypeOf((*MockClient)(nil).UpdateEdgeGateway), ctx, edgeGateway)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFirewallRule", reflect.TypeOf((*MockClient)(nil).GetFirewallRule), ctx, edgeGatewayNameOrID, ruleNameOrID)
 }
 
-// MockclientInterface is a mock of clientInterface interface.
-type MockclientInterface struct {
-	ctrl     *gomock.Controller
-	recorder *MockclientInterfaceMockRecorder
-	isgomock struct{}
+// GetIPSecVPNTunnel mocks base method.
+func (m *MockClient) GetIPSecVPNTunnel(ctx context.Context, edgeGatewayNameOrID, tunnelNameOrID string) (*IPSecVPNTunnelModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIPSecVPNTunnel", ctx, edgeGatewayNameOrID, tunnelNameOrID)
+	ret0, _ := ret[0].(*IPSecVPNTunnelModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockclientInterfaceMockRecorder is the mock recorder for MockclientInterface.
-type MockclientInterfaceMockRecorder struct {
-	mock *MockclientInterface
+// GetIPSecVPNTunnel indicates an expected call of GetIPSecVPNTunnel.
+func (mr *MockClientMockRecorder) GetIPSecVPNTunnel(ctx, edgeGatewayNameOrID, tunnelNameOrID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSecVPNTunnel", reflect.TypeOf((*MockClient)(nil).GetIPSecVPNTunnel), ctx, edgeGatewayNameOrID, tunnelNameOrID)
 }
 
-// NewMockclientInterface creates a new mock instance.
-func NewMockclientInterface(ctrl *gomock.Controller) *MockclientInterface {
-	mock := &MockclientInterface{ctrl: ctrl}
-	mock.recorder = &MockclientInterfaceMockRecorder{mock}
-	return mock
+// GetStaticRoute mocks base method.
+func (m *MockClient) GetStaticRoute(ctx context.Context, edgeGatewayNameOrID, staticRouteNameOrID string) (*StaticRouteModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStaticRoute", ctx, edgeGatewayNameOrID, staticRouteNameOrID)
+	ret0, _ := ret[0].(*StaticRouteModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockclientInterface) EXPECT() *MockclientInterfaceMockRecorder {
-	return m.recorder
+// GetStaticRoute indicates an expected call of GetStaticRoute.
+func (mr *MockClientMockRecorder) GetStaticRoute(ctx, edgeGatewayNameOrID, staticRouteNameOrID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStaticRoute", reflect.TypeOf((*MockClient)(nil).GetStaticRoute), ctx, edgeGatewayNameOrID, staticRouteNameOrID)
 }
 
-// GetAllNsxtEdgeGateways mocks base method.
-func (m *MockclientInterface) GetAllNsxtEdgeGateways(queryParameters url.Values) ([]*govcd.NsxtEdgeGateway, error) {
+// GetTunnelStatus mocks base method.
+func (m *MockClient) GetTunnelStatus(ctx context.Context, edgeGatewayNameOrID, tunnelNameOrID string) (*IPSecVPNTunnelStatusModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllNsxtEdgeGateways", queryParameters)
-	ret0, _ := ret[0].([]*govcd.NsxtEdgeGateway)
+	ret := m.ctrl.Call(m, "GetTunnelStatus", ctx, edgeGatewayNameOrID, tunnelNameOrID)
+	ret0, _ := ret[0].(*IPSecVPNTunnelStatusModel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAllNsxtEdgeGateways indicates an expected call of GetAllNsxtEdgeGateways.
-func (mr *MockclientInterfaceMockRecorder) GetAllNsxtEdgeGateways(queryParameters any) *gomock.Call {
+// GetTunnelStatus indicates an expected call of GetTunnelStatus.
+func (mr *MockClientMockRecorder) GetTunnelStatus(ctx, edgeGatewayNameOrID, tunnelNameOrID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllNsxtEdgeGateways", reflect.TypeOf((*MockclientInterface)(nil).GetAllNsxtEdgeGateways), queryParameters)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTunnelStatus", reflect.TypeOf((*MockClient)(nil).GetTunnelStatus), ctx, edgeGatewayNameOrID, tunnelNameOrID)
 }
 
-// GetClient mocks base method.
-func (m *MockclientInterface) GetClient() *http.Client {
+// GrantServiceAccess mocks base method.
+func (m *MockClient) GrantServiceAccess(ctx context.Context, edgeGatewayNameOrID string, serviceNames, sourceNetworks []string) ([]*ServiceAccessModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetClient")
-	ret0, _ := ret[0].(*http.Client)
-	return ret0
+	ret := m.ctrl.Call(m, "GrantServiceAccess", ctx, edgeGatewayNameOrID, serviceNames, sourceNetworks)
+	ret0, _ := ret[0].([]*ServiceAccessModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetClient indicates an expected call of GetClient.
-func (mr *MockclientInterfaceMockRecorder) GetClient() *gomock.Call {
+// GrantServiceAccess indicates an expected call of GrantServiceAccess.
+func (mr *MockClientMockRecorder) GrantServiceAccess(ctx, edgeGatewayNameOrID, serviceNames, sourceNetworks any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClient", reflect.TypeOf((*MockclientInterface)(nil).GetClient))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantServiceAccess", reflect.TypeOf((*MockClient)(nil).GrantServiceAccess), ctx, edgeGatewayNameOrID, serviceNames, sourceNetworks)
 }
 
-// GetNsxtEdgeGatewayById mocks base method.
-func (m *MockclientInterface) GetNsxtEdgeGatewayById(id string) (*govcd.NsxtEdgeGateway, error) {
+// ImportSnapshot mocks base method.
+func (m *MockClient) ImportSnapshot(ctx context.Context, edgeGatewayNameOrID string, snapshot *SnapshotModel, opts ImportSnapshotOptions) (*SnapshotDiffModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNsxtEdgeGatewayById", id)
-	ret0, _ := ret[0].(*govcd.NsxtEdgeGateway)
+	ret := m.ctrl.Call(m, "ImportSnapshot", ctx, edgeGatewayNameOrID, snapshot, opts)
+	ret0, _ := ret[0].(*SnapshotDiffModel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetNsxtEdgeGatewayById indicates an expected call of GetNsxtEdgeGatewayById.
-func (mr *MockclientInterfaceMockRecorder) GetNsxtEdgeGatewayById(id any) *gomock.Call {
+// ImportSnapshot indicates an expected call of ImportSnapshot.
+func (mr *MockClientMockRecorder) ImportSnapshot(ctx, edgeGatewayNameOrID, snapshot, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNsxtEdgeGatewayById", reflect.TypeOf((*MockclientInterface)(nil).GetNsxtEdgeGatewayById), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportSnapshot", reflect.TypeOf((*MockClient)(nil).ImportSnapshot), ctx, edgeGatewayNameOrID, snapshot, opts)
 }
 
-// GetNsxtEdgeGatewayByName mocks base method.
-func (m *MockclientInterface) GetNsxtEdgeGatewayByName(name string) (*govcd.NsxtEdgeGateway, error) {
+// IterEdgeGateways mocks base method.
+func (m *MockClient) IterEdgeGateways(ctx context.Context, opts ListEdgeGatewayOptions) iter.Seq2[*EdgeGatewayModel, error] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNsxtEdgeGatewayByName", name)
-	ret0, _ := ret[0].(*govcd.NsxtEdgeGateway)
+	ret := m.ctrl.Call(m, "IterEdgeGateways", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[*EdgeGatewayModel, error])
+	return ret0
+}
+
+// IterEdgeGateways indicates an expected call of IterEdgeGateways.
+func (mr *MockClientMockRecorder) IterEdgeGateways(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterEdgeGateways", reflect.TypeOf((*MockClient)(nil).IterEdgeGateways), ctx, opts)
+}
+
+// ListEdgeGateway mocks base method.
+func (m *MockClient) ListEdgeGateway(ctx context.Context) ([]*EdgeGatewayModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEdgeGateway", ctx)
+	ret0, _ := ret[0].([]*EdgeGatewayModel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetNsxtEdgeGatewayByName indicates an expected call of GetNsxtEdgeGatewayByName.
-func (mr *MockclientInterfaceMockRecorder) GetNsxtEdgeGatewayByName(name any) *gomock.Call {
+// ListEdgeGateway indicates an expected call of ListEdgeGateway.
+func (mr *MockClientMockRecorder) ListEdgeGateway(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNsxtEdgeGatewayByName", reflect.TypeOf((*MockclientInterface)(nil).GetNsxtEdgeGatewayByName), name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEdgeGateway", reflect.TypeOf((*MockClient)(nil).ListEdgeGateway), ctx)
 }
 
-// GetVDCById mocks base method.
-func (m *MockclientInterface) GetVDCById(vdcID string, refresh bool) (*govcd.Vdc, error) {
+// ListEdgeGatewayWithOptions mocks base method.
+func (m *MockClient) ListEdgeGatewayWithOptions(ctx context.Context, opts ListEdgeGatewayOptions) ([]*EdgeGatewayModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetVDCById", vdcID, refresh)
-	ret0, _ := ret[0].(*govcd.Vdc)
+	ret := m.ctrl.Call(m, "ListEdgeGatewayWithOptions", ctx, opts)
+	ret0, _ := ret[0].([]*EdgeGatewayModel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetVDCById indicates an expected call of GetVDCById.
-func (mr *MockclientInterfaceMockRecorder) GetVDCById(vdcID, refresh any) *gomock.Call {
+// ListEdgeGatewayWithOptions indicates an expected call of ListEdgeGatewayWithOptions.
+func (mr *MockClientMockRecorder) ListEdgeGatewayWithOptions(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVDCById", reflect.TypeOf((*MockclientInterface)(nil).GetVDCById), vdcID, refresh)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEdgeGatewayWithOptions", reflect.TypeOf((*MockClient)(nil).ListEdgeGatewayWithOptions), ctx, opts)
 }
 
-// GetVdcGroupById mocks base method.
-func (m *MockclientInterface) GetVdcGroupById(id string) (*govcd.VdcGroup, error) {
+// ListIPSecVPNTunnels mocks base method.
+func (m *MockClient) ListIPSecVPNTunnels(ctx context.Context, edgeGatewayNameOrID string) ([]*IPSecVPNTunnelModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetVdcGroupById", id)
-	ret0, _ := ret[0].(*govcd.VdcGroup)
+	ret := m.ctrl.Call(m, "ListIPSecVPNTunnels", ctx, edgeGatewayNameOrID)
+	ret0, _ := ret[0].([]*IPSecVPNTunnelModel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetVdcGroupById indicates an expected call of GetVdcGroupById.
-func (mr *MockclientInterfaceMockRecorder) GetVdcGroupById(id any) *gomock.Call {
+// ListIPSecVPNTunnels indicates an expected call of ListIPSecVPNTunnels.
+func (mr *MockClientMockRecorder) ListIPSecVPNTunnels(ctx, edgeGatewayNameOrID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVdcGroupById", reflect.TypeOf((*MockclientInterface)(nil).GetVdcGroupById), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIPSecVPNTunnels", reflect.TypeOf((*MockClient)(nil).ListIPSecVPNTunnels), ctx, edgeGatewayNameOrID)
 }
 
-// R mocks base method.
-func (m *MockclientInterface) R() *resty.Request {
+// ListStaticRoutes mocks base method.
+func (m *MockClient) ListStaticRoutes(ctx context.Context, edgeGatewayNameOrID string) ([]*StaticRouteModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "R")
-	ret0, _ := ret[0].(*resty.Request)
-	return ret0
+	ret := m.ctrl.Call(m, "ListStaticRoutes", ctx, edgeGatewayNameOrID)
+	ret0, _ := ret[0].([]*StaticRouteModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// R indicates an expected call of R.
-func (mr *MockclientInterfaceMockRecorder) R() *gomock.Call {
+// ListStaticRoutes indicates an expected call of ListStaticRoutes.
+func (mr *MockClientMockRecorder) ListStaticRoutes(ctx, edgeGatewayNameOrID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "R", reflect.TypeOf((*MockclientInterface)(nil).R))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStaticRoutes", reflect.TypeOf((*MockClient)(nil).ListStaticRoutes), ctx, edgeGatewayNameOrID)
 }
 
-// Refresh mocks base method.
-func (m *MockclientInterface) Refresh() error {
+// MoveFirewallRule mocks base method.
+func (m *MockClient) MoveFirewallRule(ctx context.Context, edgeGatewayNameOrID, ruleNameOrID string, position FirewallRulePosition) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Refresh")
+	ret := m.ctrl.Call(m, "MoveFirewallRule", ctx, edgeGatewayNameOrID, ruleNameOrID, position)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Refresh indicates an expected call of Refresh.
-func (mr *MockclientInterfaceMockRecorder) Refresh() *gomock.Call {
+// MoveFirewallRule indicates an expected call of MoveFirewallRule.
+func (mr *MockClientMockRecorder) MoveFirewallRule(ctx, edgeGatewayNameOrID, ruleNameOrID, position any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockclientInterface)(nil).Refresh))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveFirewallRule", reflect.TypeOf((*MockClient)(nil).MoveFirewallRule), ctx, edgeGatewayNameOrID, ruleNameOrID, position)
 }
 
-// MockclientGoVCDOrg is a mock of clientGoVCDOrg interface.
-type MockclientGoVCDOrg struct {
-	ctrl     *gomock.Controller
-	recorder *MockclientGoVCDOrgMockRecorder
-	isgomock struct{}
+// ReplaceCertificateUsages mocks base method.
+func (m *MockClient) ReplaceCertificateUsages(ctx context.Context, oldCertificateID, newCertificateID string, opts ReplaceCertificateUsagesOptions) (*CertificateUsagesPlanModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceCertificateUsages", ctx, oldCertificateID, newCertificateID, opts)
+	ret0, _ := ret[0].(*CertificateUsagesPlanModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockclientGoVCDOrgMockRecorder is the mock recorder for MockclientGoVCDOrg.
-type MockclientGoVCDOrgMockRecorder struct {
-	mock *MockclientGoVCDOrg
+// ReplaceCertificateUsages indicates an expected call of ReplaceCertificateUsages.
+func (mr *MockClientMockRecorder) ReplaceCertificateUsages(ctx, oldCertificateID, newCertificateID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceCertificateUsages", reflect.TypeOf((*MockClient)(nil).ReplaceCertificateUsages), ctx, oldCertificateID, newCertificateID, opts)
 }
 
-// NewMockclientGoVCDOrg creates a new mock instance.
-func NewMockclientGoVCDOrg(ctrl *gomock.Controller) *MockclientGoVCDOrg {
-	mock := &MockclientGoVCDOrg{ctrl: ctrl}
-	mock.recorder = &MockclientGoVCDOrgMockRecorder{mock}
-	return mock
+// RevokeServiceAccess mocks base method.
+func (m *MockClient) RevokeServiceAccess(ctx context.Context, edgeGatewayNameOrID string, serviceNames []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeServiceAccess", ctx, edgeGatewayNameOrID, serviceNames)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockclientGoVCDOrg) EXPECT() *MockclientGoVCDOrgMockRecorder {
-	return m.recorder
+// RevokeServiceAccess indicates an expected call of RevokeServiceAccess.
+func (mr *MockClientMockRecorder) RevokeServiceAccess(ctx, edgeGatewayNameOrID, serviceNames any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeServiceAccess", reflect.TypeOf((*MockClient)(nil).RevokeServiceAccess), ctx, edgeGatewayNameOrID, serviceNames)
 }
 
-// GetAllNsxtEdgeGateways mocks base method.
-func (m *MockclientGoVCDOrg) GetAllNsxtEdgeGateways(queryParameters url.Values) ([]*govcd.NsxtEdgeGateway, error) {
+// TeardownApplication mocks base method.
+func (m *MockClient) TeardownApplication(ctx context.Context, app *ExposedApplicationModel) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllNsxtEdgeGateways", queryParameters)
-	ret0, _ := ret[0].([]*govcd.NsxtEdgeGateway)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "TeardownApplication", ctx, app)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetAllNsxtEdgeGateways indicates an expected call of GetAllNsxtEdgeGateways.
-func (mr *MockclientGoVCDOrgMockRecorder) GetAllNsxtEdgeGateways(queryParameters any) *gomock.Call {
+// TeardownApplication indicates an expected call of TeardownApplication.
+func (mr *MockClientMockRecorder) TeardownApplication(ctx, app any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllNsxtEdgeGateways", reflect.TypeOf((*MockclientGoVCDOrg)(nil).GetAllNsxtEdgeGateways), queryParameters)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TeardownApplication", reflect.TypeOf((*MockClient)(nil).TeardownApplication), ctx, app)
 }
 
-// GetNsxtEdgeGatewayById mocks base method.
-func (m *MockclientGoVCDOrg) GetNsxtEdgeGatewayById(id string) (*govcd.NsxtEdgeGateway, error) {
+// UpdateEdgeGateway mocks base method.
+func (m *MockClient) UpdateEdgeGateway(ctx context.Context, edgeGateway *EdgeGatewayModelUpdate) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNsxtEdgeGatewayById", id)
-	ret0, _ := ret[0].(*govcd.NsxtEdgeGateway)
+	ret := m.ctrl.Call(m, "UpdateEdgeGateway", ctx, edgeGateway)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEdgeGateway indicates an expected call of UpdateEdgeGateway.
+func (mr *MockClientMockRecorder) UpdateEdgeGateway(ctx, edgeGateway any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEdgeGateway", reflect.TypeOf((*MockClient)(nil).UpdateEdgeGateway), ctx, edgeGateway)
+}
+
+// UpdateFirewall mocks base method.
+func (m *MockClient) UpdateFirewall(ctx context.Context, edgeGatewayNameOrID string, rules []FirewallRuleModelRequest) (*FirewallModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFirewall", ctx, edgeGatewayNameOrID, rules)
+	ret0, _ := ret[0].(*FirewallModel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetNsxtEdgeGatewayById indicates an expected call of GetNsxtEdgeGatewayById.
-func (mr *MockclientGoVCDOrgMockRecorder) GetNsxtEdgeGatewayById(id any) *gomock.Call {
+// UpdateFirewall indicates an expected call of UpdateFirewall.
+func (mr *MockClientMockRecorder) UpdateFirewall(ctx, edgeGatewayNameOrID, rules any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNsxtEdgeGatewayById", reflect.TypeOf((*MockclientGoVCDOrg)(nil).GetNsxtEdgeGatewayById), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFirewall", reflect.TypeOf((*MockClient)(nil).UpdateFirewall), ctx, edgeGatewayNameOrID, rules)
 }
 
-// GetNsxtEdgeGatewayByName mocks base method.
-func (m *MockclientGoVCDOrg) GetNsxtEdgeGatewayByName(name string) (*govcd.NsxtEdgeGateway, error) {
+// UpdateFirewallRule mocks base method.
+func (m *MockClient) UpdateFirewallRule(ctx context.Context, edgeGatewayNameOrID, ruleNameOrID string, rule FirewallRuleModelRequest) (*FirewallRuleModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNsxtEdgeGatewayByName", name)
-	ret0, _ := ret[0].(*govcd.NsxtEdgeGateway)
+	ret := m.ctrl.Call(m, "UpdateFirewallRule", ctx, edgeGatewayNameOrID, ruleNameOrID, rule)
+	ret0, _ := ret[0].(*FirewallRuleModel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetNsxtEdgeGatewayByName indicates an expected call of GetNsxtEdgeGatewayByName.
-func (mr *MockclientGoVCDOrgMockRecorder) GetNsxtEdgeGatewayByName(name any) *gomock.Call {
+// UpdateFirewallRule indicates an expected call of UpdateFirewallRule.
+func (mr *MockClientMockRecorder) UpdateFirewallRule(ctx, edgeGatewayNameOrID, ruleNameOrID, rule any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNsxtEdgeGatewayByName", reflect.TypeOf((*MockclientGoVCDOrg)(nil).GetNsxtEdgeGatewayByName), name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFirewallRule", reflect.TypeOf((*MockClient)(nil).UpdateFirewallRule), ctx, edgeGatewayNameOrID, ruleNameOrID, rule)
 }
 
-// GetVDCById mocks base method.
-func (m *MockclientGoVCDOrg) GetVDCById(vdcID string, refresh bool) (*govcd.Vdc, error) {
+// UpdateIPSecVPNTunnel mocks base method.
+func (m *MockClient) UpdateIPSecVPNTunnel(ctx context.Context, edgeGatewayNameOrID, tunnelID string, tunnel IPSecVPNTunnelModelRequest) (*IPSecVPNTunnelModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetVDCById", vdcID, refresh)
-	ret0, _ := ret[0].(*govcd.Vdc)
+	ret := m.ctrl.Call(m, "UpdateIPSecVPNTunnel", ctx, edgeGatewayNameOrID, tunnelID, tunnel)
+	ret0, _ := ret[0].(*IPSecVPNTunnelModel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetVDCById indicates an expected call of GetVDCById.
-func (mr *MockclientGoVCDOrgMockRecorder) GetVDCById(vdcID, refresh any) *gomock.Call {
+// UpdateIPSecVPNTunnel indicates an expected call of UpdateIPSecVPNTunnel.
+func (mr *MockClientMockRecorder) UpdateIPSecVPNTunnel(ctx, edgeGatewayNameOrID, tunnelID, tunnel any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVDCById", reflect.TypeOf((*MockclientGoVCDOrg)(nil).GetVDCById), vdcID, refresh)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIPSecVPNTunnel", reflect.TypeOf((*MockClient)(nil).UpdateIPSecVPNTunnel), ctx, edgeGatewayNameOrID, tunnelID, tunnel)
 }
 
-// GetVdcGroupById mocks base method.
-func (m *MockclientGoVCDOrg) GetVdcGroupById(id string) (*govcd.VdcGroup, error) {
+// UpdateStaticRoute mocks base method.
+func (m *MockClient) UpdateStaticRoute(ctx context.Context, edgeGatewayNameOrID, staticRouteID string, staticRoute StaticRouteModelRequest) (*StaticRouteModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetVdcGroupById", id)
-	ret0, _ := ret[0].(*govcd.VdcGroup)
+	ret := m.ctrl.Call(m, "UpdateStaticRoute", ctx, edgeGatewayNameOrID, staticRouteID, staticRoute)
+	ret0, _ := ret[0].(*StaticRouteModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStaticRoute indicates an expected call of UpdateStaticRoute.
+func (mr *MockClientMockRecorder) UpdateStaticRoute(ctx, edgeGatewayNameOrID, staticRouteID, staticRoute any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStaticRoute", reflect.TypeOf((*MockClient)(nil).UpdateStaticRoute), ctx, edgeGatewayNameOrID, staticRouteID, staticRoute)
+}
+
+// WaitForStatus mocks base method.
+func (m *MockClient) WaitForStatus(ctx context.Context, edgeGatewayNameOrID, status string) (*EdgeGatewayModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForStatus", ctx, edgeGatewayNameOrID, status)
+	ret0, _ := ret[0].(*EdgeGatewayModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForStatus indicates an expected call of WaitForStatus.
+func (mr *MockClientMockRecorder) WaitForStatus(ctx, edgeGatewayNameOrID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForStatus", reflect.TypeOf((*MockClient)(nil).WaitForStatus), ctx, edgeGatewayNameOrID, status)
+}
+
+// MockclientInterface is a mock of clientInterface interface.
+type MockclientInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockclientInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockclientInterfaceMockRecorder is the mock recorder for MockclientInterface.
+type MockclientInterfaceMockRecorder struct {
+	mock *MockclientInterface
+}
+
+// NewMockclientInterface creates a new mock instance.
+func NewMockclientInterface(ctrl *gomock.Controller) *MockclientInterface {
+	mock := &MockclientInterface{ctrl: ctrl}
+	mock.recorder = &MockclientInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockclientInterface) EXPECT() *MockclientInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateCertificateInLibrary mocks base method.
+func (m *MockclientInterface) CreateCertificateInLibrary(ctx context.Context, cert *org.CertificateCreateRequest) (*org.CertificateModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCertificateInLibrary", ctx, cert)
+	ret0, _ := ret[0].(*org.CertificateModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCertificateInLibrary indicates an expected call of CreateCertificateInLibrary.
+func (mr *MockclientInterfaceMockRecorder) CreateCertificateInLibrary(ctx, cert any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCertificateInLibrary", reflect.TypeOf((*MockclientInterface)(nil).CreateCertificateInLibrary), ctx, cert)
+}
+
+// CreateNsxtAppPortProfile mocks base method.
+func (m *MockclientInterface) CreateNsxtAppPortProfile(appPortProfileConfig *types.NsxtAppPortProfile) (*govcd.NsxtAppPortProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNsxtAppPortProfile", appPortProfileConfig)
+	ret0, _ := ret[0].(*govcd.NsxtAppPortProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNsxtAppPortProfile indicates an expected call of CreateNsxtAppPortProfile.
+func (mr *MockclientInterfaceMockRecorder) CreateNsxtAppPortProfile(appPortProfileConfig any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNsxtAppPortProfile", reflect.TypeOf((*MockclientInterface)(nil).CreateNsxtAppPortProfile), appPortProfileConfig)
+}
+
+// CreatePool mocks base method.
+func (m *MockclientInterface) CreatePool(ctx context.Context, pool edgeloadbalancer.PoolModelRequest) (*edgeloadbalancer.PoolModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePool", ctx, pool)
+	ret0, _ := ret[0].(*edgeloadbalancer.PoolModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePool indicates an expected call of CreatePool.
+func (mr *MockclientInterfaceMockRecorder) CreatePool(ctx, pool any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePool", reflect.TypeOf((*MockclientInterface)(nil).CreatePool), ctx, pool)
+}
+
+// CreateVirtualService mocks base method.
+func (m *MockclientInterface) CreateVirtualService(ctx context.Context, vsr edgeloadbalancer.VirtualServiceModelRequest) (*edgeloadbalancer.VirtualServiceModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVirtualService", ctx, vsr)
+	ret0, _ := ret[0].(*edgeloadbalancer.VirtualServiceModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVirtualService indicates an expected call of CreateVirtualService.
+func (mr *MockclientInterfaceMockRecorder) CreateVirtualService(ctx, vsr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualService", reflect.TypeOf((*MockclientInterface)(nil).CreateVirtualService), ctx, vsr)
+}
+
+// DeleteCertificateFromLibrary mocks base method.
+func (m *MockclientInterface) DeleteCertificateFromLibrary(ctx context.Context, certificateID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCertificateFromLibrary", ctx, certificateID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCertificateFromLibrary indicates an expected call of DeleteCertificateFromLibrary.
+func (mr *MockclientInterfaceMockRecorder) DeleteCertificateFromLibrary(ctx, certificateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCertificateFromLibrary", reflect.TypeOf((*MockclientInterface)(nil).DeleteCertificateFromLibrary), ctx, certificateID)
+}
+
+// DeletePoliciesHTTPRequest mocks base method.
+func (m *MockclientInterface) DeletePoliciesHTTPRequest(ctx context.Context, virtualServiceID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePoliciesHTTPRequest", ctx, virtualServiceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePoliciesHTTPRequest indicates an expected call of DeletePoliciesHTTPRequest.
+func (mr *MockclientInterfaceMockRecorder) DeletePoliciesHTTPRequest(ctx, virtualServiceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePoliciesHTTPRequest", reflect.TypeOf((*MockclientInterface)(nil).DeletePoliciesHTTPRequest), ctx, virtualServiceID)
+}
+
+// DeletePoliciesHTTPResponse mocks base method.
+func (m *MockclientInterface) DeletePoliciesHTTPResponse(ctx context.Context, virtualServiceID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePoliciesHTTPResponse", ctx, virtualServiceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePoliciesHTTPResponse indicates an expected call of DeletePoliciesHTTPResponse.
+func (mr *MockclientInterfaceMockRecorder) DeletePoliciesHTTPResponse(ctx, virtualServiceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePoliciesHTTPResponse", reflect.TypeOf((*MockclientInterface)(nil).DeletePoliciesHTTPResponse), ctx, virtualServiceID)
+}
+
+// DeletePoliciesHTTPSecurity mocks base method.
+func (m *MockclientInterface) DeletePoliciesHTTPSecurity(ctx context.Context, virtualServiceID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePoliciesHTTPSecurity", ctx, virtualServiceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePoliciesHTTPSecurity indicates an expected call of DeletePoliciesHTTPSecurity.
+func (mr *MockclientInterfaceMockRecorder) DeletePoliciesHTTPSecurity(ctx, virtualServiceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePoliciesHTTPSecurity", reflect.TypeOf((*MockclientInterface)(nil).DeletePoliciesHTTPSecurity), ctx, virtualServiceID)
+}
+
+// DeletePool mocks base method.
+func (m *MockclientInterface) DeletePool(ctx context.Context, poolID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePool", ctx, poolID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePool indicates an expected call of DeletePool.
+func (mr *MockclientInterfaceMockRecorder) DeletePool(ctx, poolID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePool", reflect.TypeOf((*MockclientInterface)(nil).DeletePool), ctx, poolID)
+}
+
+// DeleteVirtualService mocks base method.
+func (m *MockclientInterface) DeleteVirtualService(ctx context.Context, virtualServiceID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVirtualService", ctx, virtualServiceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVirtualService indicates an expected call of DeleteVirtualService.
+func (mr *MockclientInterfaceMockRecorder) DeleteVirtualService(ctx, virtualServiceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVirtualService", reflect.TypeOf((*MockclientInterface)(nil).DeleteVirtualService), ctx, virtualServiceID)
+}
+
+// GetAllNetworkContextProfiles mocks base method.
+func (m *MockclientInterface) GetAllNetworkContextProfiles(queryParameters url.Values) ([]*types.NsxtNetworkContextProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllNetworkContextProfiles", queryParameters)
+	ret0, _ := ret[0].([]*types.NsxtNetworkContextProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllNetworkContextProfiles indicates an expected call of GetAllNetworkContextProfiles.
+func (mr *MockclientInterfaceMockRecorder) GetAllNetworkContextProfiles(queryParameters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllNetworkContextProfiles", reflect.TypeOf((*MockclientInterface)(nil).GetAllNetworkContextProfiles), queryParameters)
+}
+
+// GetAllNsxtAppPortProfiles mocks base method.
+func (m *MockclientInterface) GetAllNsxtAppPortProfiles(queryParameters url.Values, scope string) ([]*govcd.NsxtAppPortProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllNsxtAppPortProfiles", queryParameters, scope)
+	ret0, _ := ret[0].([]*govcd.NsxtAppPortProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllNsxtAppPortProfiles indicates an expected call of GetAllNsxtAppPortProfiles.
+func (mr *MockclientInterfaceMockRecorder) GetAllNsxtAppPortProfiles(queryParameters, scope any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllNsxtAppPortProfiles", reflect.TypeOf((*MockclientInterface)(nil).GetAllNsxtAppPortProfiles), queryParameters, scope)
+}
+
+// GetAllOpenApiOrgVdcNetworks mocks base method.
+func (m *MockclientInterface) GetAllOpenApiOrgVdcNetworks(queryParameters url.Values) ([]*govcd.OpenApiOrgVdcNetwork, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllOpenApiOrgVdcNetworks", queryParameters)
+	ret0, _ := ret[0].([]*govcd.OpenApiOrgVdcNetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllOpenApiOrgVdcNetworks indicates an expected call of GetAllOpenApiOrgVdcNetworks.
+func (mr *MockclientInterfaceMockRecorder) GetAllOpenApiOrgVdcNetworks(queryParameters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllOpenApiOrgVdcNetworks", reflect.TypeOf((*MockclientInterface)(nil).GetAllOpenApiOrgVdcNetworks), queryParameters)
+}
+
+// GetClient mocks base method.
+func (m *MockclientInterface) GetClient() *http.Client {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClient")
+	ret0, _ := ret[0].(*http.Client)
+	return ret0
+}
+
+// GetClient indicates an expected call of GetClient.
+func (mr *MockclientInterfaceMockRecorder) GetClient() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClient", reflect.TypeOf((*MockclientInterface)(nil).GetClient))
+}
+
+// GetNsxtEdgeGatewayById mocks base method.
+func (m *MockclientInterface) GetNsxtEdgeGatewayById(id string) (*govcd.NsxtEdgeGateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNsxtEdgeGatewayById", id)
+	ret0, _ := ret[0].(*govcd.NsxtEdgeGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNsxtEdgeGatewayById indicates an expected call of GetNsxtEdgeGatewayById.
+func (mr *MockclientInterfaceMockRecorder) GetNsxtEdgeGatewayById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNsxtEdgeGatewayById", reflect.TypeOf((*MockclientInterface)(nil).GetNsxtEdgeGatewayById), id)
+}
+
+// GetNsxtEdgeGatewayByName mocks base method.
+func (m *MockclientInterface) GetNsxtEdgeGatewayByName(name string) (*govcd.NsxtEdgeGateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNsxtEdgeGatewayByName", name)
+	ret0, _ := ret[0].(*govcd.NsxtEdgeGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNsxtEdgeGatewayByName indicates an expected call of GetNsxtEdgeGatewayByName.
+func (mr *MockclientInterfaceMockRecorder) GetNsxtEdgeGatewayByName(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNsxtEdgeGatewayByName", reflect.TypeOf((*MockclientInterface)(nil).GetNsxtEdgeGatewayByName), name)
+}
+
+// GetPoliciesHTTPRequest mocks base method.
+func (m *MockclientInterface) GetPoliciesHTTPRequest(ctx context.Context, virtualServiceID string) (*edgeloadbalancer.PoliciesHTTPRequestModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPoliciesHTTPRequest", ctx, virtualServiceID)
+	ret0, _ := ret[0].(*edgeloadbalancer.PoliciesHTTPRequestModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPoliciesHTTPRequest indicates an expected call of GetPoliciesHTTPRequest.
+func (mr *MockclientInterfaceMockRecorder) GetPoliciesHTTPRequest(ctx, virtualServiceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoliciesHTTPRequest", reflect.TypeOf((*MockclientInterface)(nil).GetPoliciesHTTPRequest), ctx, virtualServiceID)
+}
+
+// GetPoliciesHTTPResponse mocks base method.
+func (m *MockclientInterface) GetPoliciesHTTPResponse(ctx context.Context, virtualServiceID string) (*edgeloadbalancer.PoliciesHTTPResponseModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPoliciesHTTPResponse", ctx, virtualServiceID)
+	ret0, _ := ret[0].(*edgeloadbalancer.PoliciesHTTPResponseModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPoliciesHTTPResponse indicates an expected call of GetPoliciesHTTPResponse.
+func (mr *MockclientInterfaceMockRecorder) GetPoliciesHTTPResponse(ctx, virtualServiceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoliciesHTTPResponse", reflect.TypeOf((*MockclientInterface)(nil).GetPoliciesHTTPResponse), ctx, virtualServiceID)
+}
+
+// GetPoliciesHTTPSecurity mocks base method.
+func (m *MockclientInterface) GetPoliciesHTTPSecurity(ctx context.Context, virtualServiceID string) (*edgeloadbalancer.PoliciesHTTPSecurityModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPoliciesHTTPSecurity", ctx, virtualServiceID)
+	ret0, _ := ret[0].(*edgeloadbalancer.PoliciesHTTPSecurityModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPoliciesHTTPSecurity indicates an expected call of GetPoliciesHTTPSecurity.
+func (mr *MockclientInterfaceMockRecorder) GetPoliciesHTTPSecurity(ctx, virtualServiceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoliciesHTTPSecurity", reflect.TypeOf((*MockclientInterface)(nil).GetPoliciesHTTPSecurity), ctx, virtualServiceID)
+}
+
+// GetPool mocks base method.
+func (m *MockclientInterface) GetPool(ctx context.Context, edgeGatewayID, poolNameOrID string) (*edgeloadbalancer.PoolModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPool", ctx, edgeGatewayID, poolNameOrID)
+	ret0, _ := ret[0].(*edgeloadbalancer.PoolModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPool indicates an expected call of GetPool.
+func (mr *MockclientInterfaceMockRecorder) GetPool(ctx, edgeGatewayID, poolNameOrID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPool", reflect.TypeOf((*MockclientInterface)(nil).GetPool), ctx, edgeGatewayID, poolNameOrID)
+}
+
+// GetVDCById mocks base method.
+func (m *MockclientInterface) GetVDCById(vdcID string, refresh bool) (*govcd.Vdc, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVDCById", vdcID, refresh)
+	ret0, _ := ret[0].(*govcd.Vdc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVDCById indicates an expected call of GetVDCById.
+func (mr *MockclientInterfaceMockRecorder) GetVDCById(vdcID, refresh any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVDCById", reflect.TypeOf((*MockclientInterface)(nil).GetVDCById), vdcID, refresh)
+}
+
+// GetVdcGroupById mocks base method.
+func (m *MockclientInterface) GetVdcGroupById(id string) (*govcd.VdcGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVdcGroupById", id)
+	ret0, _ := ret[0].(*govcd.VdcGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVdcGroupById indicates an expected call of GetVdcGroupById.
+func (mr *MockclientInterfaceMockRecorder) GetVdcGroupById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVdcGroupById", reflect.TypeOf((*MockclientInterface)(nil).GetVdcGroupById), id)
+}
+
+// ListCertificatesInLibrary mocks base method.
+func (m *MockclientInterface) ListCertificatesInLibrary(ctx context.Context) (org.CertificatesModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCertificatesInLibrary", ctx)
+	ret0, _ := ret[0].(org.CertificatesModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCertificatesInLibrary indicates an expected call of ListCertificatesInLibrary.
+func (mr *MockclientInterfaceMockRecorder) ListCertificatesInLibrary(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCertificatesInLibrary", reflect.TypeOf((*MockclientInterface)(nil).ListCertificatesInLibrary), ctx)
+}
+
+// ListPools mocks base method.
+func (m *MockclientInterface) ListPools(ctx context.Context, edgeGatewayID string) ([]*edgeloadbalancer.PoolModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPools", ctx, edgeGatewayID)
+	ret0, _ := ret[0].([]*edgeloadbalancer.PoolModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPools indicates an expected call of ListPools.
+func (mr *MockclientInterfaceMockRecorder) ListPools(ctx, edgeGatewayID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPools", reflect.TypeOf((*MockclientInterface)(nil).ListPools), ctx, edgeGatewayID)
+}
+
+// ListServiceEngineGroups mocks base method.
+func (m *MockclientInterface) ListServiceEngineGroups(ctx context.Context, edgeGatewayID string) ([]*edgeloadbalancer.ServiceEngineGroupModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServiceEngineGroups", ctx, edgeGatewayID)
+	ret0, _ := ret[0].([]*edgeloadbalancer.ServiceEngineGroupModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceEngineGroups indicates an expected call of ListServiceEngineGroups.
+func (mr *MockclientInterfaceMockRecorder) ListServiceEngineGroups(ctx, edgeGatewayID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceEngineGroups", reflect.TypeOf((*MockclientInterface)(nil).ListServiceEngineGroups), ctx, edgeGatewayID)
+}
+
+// ListVirtualServices mocks base method.
+func (m *MockclientInterface) ListVirtualServices(ctx context.Context, edgeGatewayID string) ([]*edgeloadbalancer.VirtualServiceModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVirtualServices", ctx, edgeGatewayID)
+	ret0, _ := ret[0].([]*edgeloadbalancer.VirtualServiceModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVirtualServices indicates an expected call of ListVirtualServices.
+func (mr *MockclientInterfaceMockRecorder) ListVirtualServices(ctx, edgeGatewayID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualServices", reflect.TypeOf((*MockclientInterface)(nil).ListVirtualServices), ctx, edgeGatewayID)
+}
+
+// OpenAPIGetItem mocks base method.
+func (m *MockclientInterface) OpenAPIGetItem(endpoint string, queryParameters url.Values, outType any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenAPIGetItem", endpoint, queryParameters, outType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OpenAPIGetItem indicates an expected call of OpenAPIGetItem.
+func (mr *MockclientInterfaceMockRecorder) OpenAPIGetItem(endpoint, queryParameters, outType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenAPIGetItem", reflect.TypeOf((*MockclientInterface)(nil).OpenAPIGetItem), endpoint, queryParameters, outType)
+}
+
+// OpenAPIGetPage mocks base method.
+func (m *MockclientInterface) OpenAPIGetPage(endpoint string, queryParameters url.Values) (*types.OpenApiPages, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenAPIGetPage", endpoint, queryParameters)
+	ret0, _ := ret[0].(*types.OpenApiPages)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenAPIGetPage indicates an expected call of OpenAPIGetPage.
+func (mr *MockclientInterfaceMockRecorder) OpenAPIGetPage(endpoint, queryParameters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenAPIGetPage", reflect.TypeOf((*MockclientInterface)(nil).OpenAPIGetPage), endpoint, queryParameters)
+}
+
+// OpenAPIPutItem mocks base method.
+func (m *MockclientInterface) OpenAPIPutItem(endpoint string, payload, outType any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenAPIPutItem", endpoint, payload, outType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OpenAPIPutItem indicates an expected call of OpenAPIPutItem.
+func (mr *MockclientInterfaceMockRecorder) OpenAPIPutItem(endpoint, payload, outType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenAPIPutItem", reflect.TypeOf((*MockclientInterface)(nil).OpenAPIPutItem), endpoint, payload, outType)
+}
+
+// R mocks base method.
+func (m *MockclientInterface) R() *resty.Request {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "R")
+	ret0, _ := ret[0].(*resty.Request)
+	return ret0
+}
+
+// R indicates an expected call of R.
+func (mr *MockclientInterfaceMockRecorder) R() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "R", reflect.TypeOf((*MockclientInterface)(nil).R))
+}
+
+// Refresh mocks base method.
+func (m *MockclientInterface) Refresh() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockclientInterfaceMockRecorder) Refresh() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockclientInterface)(nil).Refresh))
+}
+
+// UpdatePoliciesHTTPRequest mocks base method.
+func (m *MockclientInterface) UpdatePoliciesHTTPRequest(ctx context.Context, policies *edgeloadbalancer.PoliciesHTTPRequestModel, opts ...edgeloadbalancer.PoliciesHTTPUpdateOptions) (*edgeloadbalancer.PoliciesHTTPRequestModel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, policies}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdatePoliciesHTTPRequest", varargs...)
+	ret0, _ := ret[0].(*edgeloadbalancer.PoliciesHTTPRequestModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePoliciesHTTPRequest indicates an expected call of UpdatePoliciesHTTPRequest.
+func (mr *MockclientInterfaceMockRecorder) UpdatePoliciesHTTPRequest(ctx, policies any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, policies}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePoliciesHTTPRequest", reflect.TypeOf((*MockclientInterface)(nil).UpdatePoliciesHTTPRequest), varargs...)
+}
+
+// UpdatePoliciesHTTPResponse mocks base method.
+func (m *MockclientInterface) UpdatePoliciesHTTPResponse(ctx context.Context, policies *edgeloadbalancer.PoliciesHTTPResponseModel, opts ...edgeloadbalancer.PoliciesHTTPUpdateOptions) (*edgeloadbalancer.PoliciesHTTPResponseModel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, policies}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdatePoliciesHTTPResponse", varargs...)
+	ret0, _ := ret[0].(*edgeloadbalancer.PoliciesHTTPResponseModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePoliciesHTTPResponse indicates an expected call of UpdatePoliciesHTTPResponse.
+func (mr *MockclientInterfaceMockRecorder) UpdatePoliciesHTTPResponse(ctx, policies any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, policies}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePoliciesHTTPResponse", reflect.TypeOf((*MockclientInterface)(nil).UpdatePoliciesHTTPResponse), varargs...)
+}
+
+// UpdatePoliciesHTTPSecurity mocks base method.
+func (m *MockclientInterface) UpdatePoliciesHTTPSecurity(ctx context.Context, policies *edgeloadbalancer.PoliciesHTTPSecurityModel, opts ...edgeloadbalancer.PoliciesHTTPUpdateOptions) (*edgeloadbalancer.PoliciesHTTPSecurityModel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, policies}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdatePoliciesHTTPSecurity", varargs...)
+	ret0, _ := ret[0].(*edgeloadbalancer.PoliciesHTTPSecurityModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePoliciesHTTPSecurity indicates an expected call of UpdatePoliciesHTTPSecurity.
+func (mr *MockclientInterfaceMockRecorder) UpdatePoliciesHTTPSecurity(ctx, policies any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, policies}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePoliciesHTTPSecurity", reflect.TypeOf((*MockclientInterface)(nil).UpdatePoliciesHTTPSecurity), varargs...)
+}
+
+// UpdatePool mocks base method.
+func (m *MockclientInterface) UpdatePool(ctx context.Context, poolID string, pool edgeloadbalancer.PoolModelRequest) (*edgeloadbalancer.PoolModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePool", ctx, poolID, pool)
+	ret0, _ := ret[0].(*edgeloadbalancer.PoolModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePool indicates an expected call of UpdatePool.
+func (mr *MockclientInterfaceMockRecorder) UpdatePool(ctx, poolID, pool any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePool", reflect.TypeOf((*MockclientInterface)(nil).UpdatePool), ctx, poolID, pool)
+}
+
+// UpdateVirtualService mocks base method.
+func (m *MockclientInterface) UpdateVirtualService(ctx context.Context, virtualServiceID string, vsr edgeloadbalancer.VirtualServiceModelRequest) (*edgeloadbalancer.VirtualServiceModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVirtualService", ctx, virtualServiceID, vsr)
+	ret0, _ := ret[0].(*edgeloadbalancer.VirtualServiceModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVirtualService indicates an expected call of UpdateVirtualService.
+func (mr *MockclientInterfaceMockRecorder) UpdateVirtualService(ctx, virtualServiceID, vsr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVirtualService", reflect.TypeOf((*MockclientInterface)(nil).UpdateVirtualService), ctx, virtualServiceID, vsr)
+}
+
+// MockclientGoVCD is a mock of clientGoVCD interface.
+type MockclientGoVCD struct {
+	ctrl     *gomock.Controller
+	recorder *MockclientGoVCDMockRecorder
+	isgomock struct{}
+}
+
+// MockclientGoVCDMockRecorder is the mock recorder for MockclientGoVCD.
+type MockclientGoVCDMockRecorder struct {
+	mock *MockclientGoVCD
+}
+
+// NewMockclientGoVCD creates a new mock instance.
+func NewMockclientGoVCD(ctrl *gomock.Controller) *MockclientGoVCD {
+	mock := &MockclientGoVCD{ctrl: ctrl}
+	mock.recorder = &MockclientGoVCDMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockclientGoVCD) EXPECT() *MockclientGoVCDMockRecorder {
+	return m.recorder
+}
+
+// GetAllNetworkContextProfiles mocks base method.
+func (m *MockclientGoVCD) GetAllNetworkContextProfiles(queryParameters url.Values) ([]*types.NsxtNetworkContextProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllNetworkContextProfiles", queryParameters)
+	ret0, _ := ret[0].([]*types.NsxtNetworkContextProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllNetworkContextProfiles indicates an expected call of GetAllNetworkContextProfiles.
+func (mr *MockclientGoVCDMockRecorder) GetAllNetworkContextProfiles(queryParameters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllNetworkContextProfiles", reflect.TypeOf((*MockclientGoVCD)(nil).GetAllNetworkContextProfiles), queryParameters)
+}
+
+// OpenAPIGetItem mocks base method.
+func (m *MockclientGoVCD) OpenAPIGetItem(endpoint string, queryParameters url.Values, outType any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenAPIGetItem", endpoint, queryParameters, outType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OpenAPIGetItem indicates an expected call of OpenAPIGetItem.
+func (mr *MockclientGoVCDMockRecorder) OpenAPIGetItem(endpoint, queryParameters, outType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenAPIGetItem", reflect.TypeOf((*MockclientGoVCD)(nil).OpenAPIGetItem), endpoint, queryParameters, outType)
+}
+
+// OpenAPIGetPage mocks base method.
+func (m *MockclientGoVCD) OpenAPIGetPage(endpoint string, queryParameters url.Values) (*types.OpenApiPages, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenAPIGetPage", endpoint, queryParameters)
+	ret0, _ := ret[0].(*types.OpenApiPages)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenAPIGetPage indicates an expected call of OpenAPIGetPage.
+func (mr *MockclientGoVCDMockRecorder) OpenAPIGetPage(endpoint, queryParameters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenAPIGetPage", reflect.TypeOf((*MockclientGoVCD)(nil).OpenAPIGetPage), endpoint, queryParameters)
+}
+
+// OpenAPIPutItem mocks base method.
+func (m *MockclientGoVCD) OpenAPIPutItem(endpoint string, payload, outType any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenAPIPutItem", endpoint, payload, outType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OpenAPIPutItem indicates an expected call of OpenAPIPutItem.
+func (mr *MockclientGoVCDMockRecorder) OpenAPIPutItem(endpoint, payload, outType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenAPIPutItem", reflect.TypeOf((*MockclientGoVCD)(nil).OpenAPIPutItem), endpoint, payload, outType)
+}
+
+// MockclientGoVCDOrg is a mock of clientGoVCDOrg interface.
+type MockclientGoVCDOrg struct {
+	ctrl     *gomock.Controller
+	recorder *MockclientGoVCDOrgMockRecorder
+	isgomock struct{}
+}
+
+// MockclientGoVCDOrgMockRecorder is the mock recorder for MockclientGoVCDOrg.
+type MockclientGoVCDOrgMockRecorder struct {
+	mock *MockclientGoVCDOrg
+}
+
+// NewMockclientGoVCDOrg creates a new mock instance.
+func NewMockclientGoVCDOrg(ctrl *gomock.Controller) *MockclientGoVCDOrg {
+	mock := &MockclientGoVCDOrg{ctrl: ctrl}
+	mock.recorder = &MockclientGoVCDOrgMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockclientGoVCDOrg) EXPECT() *MockclientGoVCDOrgMockRecorder {
+	return m.recorder
+}
+
+// CreateNsxtAppPortProfile mocks base method.
+func (m *MockclientGoVCDOrg) CreateNsxtAppPortProfile(appPortProfileConfig *types.NsxtAppPortProfile) (*govcd.NsxtAppPortProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNsxtAppPortProfile", appPortProfileConfig)
+	ret0, _ := ret[0].(*govcd.NsxtAppPortProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNsxtAppPortProfile indicates an expected call of CreateNsxtAppPortProfile.
+func (mr *MockclientGoVCDOrgMockRecorder) CreateNsxtAppPortProfile(appPortProfileConfig any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNsxtAppPortProfile", reflect.TypeOf((*MockclientGoVCDOrg)(nil).CreateNsxtAppPortProfile), appPortProfileConfig)
+}
+
+// GetAllNsxtAppPortProfiles mocks base method.
+func (m *MockclientGoVCDOrg) GetAllNsxtAppPortProfiles(queryParameters url.Values, scope string) ([]*govcd.NsxtAppPortProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllNsxtAppPortProfiles", queryParameters, scope)
+	ret0, _ := ret[0].([]*govcd.NsxtAppPortProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllNsxtAppPortProfiles indicates an expected call of GetAllNsxtAppPortProfiles.
+func (mr *MockclientGoVCDOrgMockRecorder) GetAllNsxtAppPortProfiles(queryParameters, scope any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllNsxtAppPortProfiles", reflect.TypeOf((*MockclientGoVCDOrg)(nil).GetAllNsxtAppPortProfiles), queryParameters, scope)
+}
+
+// GetAllOpenApiOrgVdcNetworks mocks base method.
+func (m *MockclientGoVCDOrg) GetAllOpenApiOrgVdcNetworks(queryParameters url.Values) ([]*govcd.OpenApiOrgVdcNetwork, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllOpenApiOrgVdcNetworks", queryParameters)
+	ret0, _ := ret[0].([]*govcd.OpenApiOrgVdcNetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllOpenApiOrgVdcNetworks indicates an expected call of GetAllOpenApiOrgVdcNetworks.
+func (mr *MockclientGoVCDOrgMockRecorder) GetAllOpenApiOrgVdcNetworks(queryParameters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllOpenApiOrgVdcNetworks", reflect.TypeOf((*MockclientGoVCDOrg)(nil).GetAllOpenApiOrgVdcNetworks), queryParameters)
+}
+
+// GetNsxtEdgeGatewayById mocks base method.
+func (m *MockclientGoVCDOrg) GetNsxtEdgeGatewayById(id string) (*govcd.NsxtEdgeGateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNsxtEdgeGatewayById", id)
+	ret0, _ := ret[0].(*govcd.NsxtEdgeGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNsxtEdgeGatewayById indicates an expected call of GetNsxtEdgeGatewayById.
+func (mr *MockclientGoVCDOrgMockRecorder) GetNsxtEdgeGatewayById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNsxtEdgeGatewayById", reflect.TypeOf((*MockclientGoVCDOrg)(nil).GetNsxtEdgeGatewayById), id)
+}
+
+// GetNsxtEdgeGatewayByName mocks base method.
+func (m *MockclientGoVCDOrg) GetNsxtEdgeGatewayByName(name string) (*govcd.NsxtEdgeGateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNsxtEdgeGatewayByName", name)
+	ret0, _ := ret[0].(*govcd.NsxtEdgeGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNsxtEdgeGatewayByName indicates an expected call of GetNsxtEdgeGatewayByName.
+func (mr *MockclientGoVCDOrgMockRecorder) GetNsxtEdgeGatewayByName(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNsxtEdgeGatewayByName", reflect.TypeOf((*MockclientGoVCDOrg)(nil).GetNsxtEdgeGatewayByName), name)
+}
+
+// GetVDCById mocks base method.
+func (m *MockclientGoVCDOrg) GetVDCById(vdcID string, refresh bool) (*govcd.Vdc, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVDCById", vdcID, refresh)
+	ret0, _ := ret[0].(*govcd.Vdc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVDCById indicates an expected call of GetVDCById.
+func (mr *MockclientGoVCDOrgMockRecorder) GetVDCById(vdcID, refresh any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVDCById", reflect.TypeOf((*MockclientGoVCDOrg)(nil).GetVDCById), vdcID, refresh)
+}
+
+// GetVdcGroupById mocks base method.
+func (m *MockclientGoVCDOrg) GetVdcGroupById(id string) (*govcd.VdcGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVdcGroupById", id)
+	ret0, _ := ret[0].(*govcd.VdcGroup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -364,6 +1348,393 @@ func (mr *MockclientGoVCDOrgMockRecorder) GetVdcGroupById(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVdcGroupById", reflect.TypeOf((*MockclientGoVCDOrg)(nil).GetVdcGroupById), id)
 }
 
+// MockclientOrg is a mock of clientOrg interface.
+type MockclientOrg struct {
+	ctrl     *gomock.Controller
+	recorder *MockclientOrgMockRecorder
+	isgomock struct{}
+}
+
+// MockclientOrgMockRecorder is the mock recorder for MockclientOrg.
+type MockclientOrgMockRecorder struct {
+	mock *MockclientOrg
+}
+
+// NewMockclientOrg creates a new mock instance.
+func NewMockclientOrg(ctrl *gomock.Controller) *MockclientOrg {
+	mock := &MockclientOrg{ctrl: ctrl}
+	mock.recorder = &MockclientOrgMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockclientOrg) EXPECT() *MockclientOrgMockRecorder {
+	return m.recorder
+}
+
+// CreateCertificateInLibrary mocks base method.
+func (m *MockclientOrg) CreateCertificateInLibrary(ctx context.Context, cert *org.CertificateCreateRequest) (*org.CertificateModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCertificateInLibrary", ctx, cert)
+	ret0, _ := ret[0].(*org.CertificateModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCertificateInLibrary indicates an expected call of CreateCertificateInLibrary.
+func (mr *MockclientOrgMockRecorder) CreateCertificateInLibrary(ctx, cert any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCertificateInLibrary", reflect.TypeOf((*MockclientOrg)(nil).CreateCertificateInLibrary), ctx, cert)
+}
+
+// DeleteCertificateFromLibrary mocks base method.
+func (m *MockclientOrg) DeleteCertificateFromLibrary(ctx context.Context, certificateID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCertificateFromLibrary", ctx, certificateID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCertificateFromLibrary indicates an expected call of DeleteCertificateFromLibrary.
+func (mr *MockclientOrgMockRecorder) DeleteCertificateFromLibrary(ctx, certificateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCertificateFromLibrary", reflect.TypeOf((*MockclientOrg)(nil).DeleteCertificateFromLibrary), ctx, certificateID)
+}
+
+// ListCertificatesInLibrary mocks base method.
+func (m *MockclientOrg) ListCertificatesInLibrary(ctx context.Context) (org.CertificatesModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCertificatesInLibrary", ctx)
+	ret0, _ := ret[0].(org.CertificatesModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCertificatesInLibrary indicates an expected call of ListCertificatesInLibrary.
+func (mr *MockclientOrgMockRecorder) ListCertificatesInLibrary(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCertificatesInLibrary", reflect.TypeOf((*MockclientOrg)(nil).ListCertificatesInLibrary), ctx)
+}
+
+// MockclientLoadBalancer is a mock of clientLoadBalancer interface.
+type MockclientLoadBalancer struct {
+	ctrl     *gomock.Controller
+	recorder *MockclientLoadBalancerMockRecorder
+	isgomock struct{}
+}
+
+// MockclientLoadBalancerMockRecorder is the mock recorder for MockclientLoadBalancer.
+type MockclientLoadBalancerMockRecorder struct {
+	mock *MockclientLoadBalancer
+}
+
+// NewMockclientLoadBalancer creates a new mock instance.
+func NewMockclientLoadBalancer(ctrl *gomock.Controller) *MockclientLoadBalancer {
+	mock := &MockclientLoadBalancer{ctrl: ctrl}
+	mock.recorder = &MockclientLoadBalancerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockclientLoadBalancer) EXPECT() *MockclientLoadBalancerMockRecorder {
+	return m.recorder
+}
+
+// CreatePool mocks base method.
+func (m *MockclientLoadBalancer) CreatePool(ctx context.Context, pool edgeloadbalancer.PoolModelRequest) (*edgeloadbalancer.PoolModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePool", ctx, pool)
+	ret0, _ := ret[0].(*edgeloadbalancer.PoolModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePool indicates an expected call of CreatePool.
+func (mr *MockclientLoadBalancerMockRecorder) CreatePool(ctx, pool any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePool", reflect.TypeOf((*MockclientLoadBalancer)(nil).CreatePool), ctx, pool)
+}
+
+// CreateVirtualService mocks base method.
+func (m *MockclientLoadBalancer) CreateVirtualService(ctx context.Context, vsr edgeloadbalancer.VirtualServiceModelRequest) (*edgeloadbalancer.VirtualServiceModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVirtualService", ctx, vsr)
+	ret0, _ := ret[0].(*edgeloadbalancer.VirtualServiceModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVirtualService indicates an expected call of CreateVirtualService.
+func (mr *MockclientLoadBalancerMockRecorder) CreateVirtualService(ctx, vsr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualService", reflect.TypeOf((*MockclientLoadBalancer)(nil).CreateVirtualService), ctx, vsr)
+}
+
+// DeletePoliciesHTTPRequest mocks base method.
+func (m *MockclientLoadBalancer) DeletePoliciesHTTPRequest(ctx context.Context, virtualServiceID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePoliciesHTTPRequest", ctx, virtualServiceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePoliciesHTTPRequest indicates an expected call of DeletePoliciesHTTPRequest.
+func (mr *MockclientLoadBalancerMockRecorder) DeletePoliciesHTTPRequest(ctx, virtualServiceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePoliciesHTTPRequest", reflect.TypeOf((*MockclientLoadBalancer)(nil).DeletePoliciesHTTPRequest), ctx, virtualServiceID)
+}
+
+// DeletePoliciesHTTPResponse mocks base method.
+func (m *MockclientLoadBalancer) DeletePoliciesHTTPResponse(ctx context.Context, virtualServiceID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePoliciesHTTPResponse", ctx, virtualServiceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePoliciesHTTPResponse indicates an expected call of DeletePoliciesHTTPResponse.
+func (mr *MockclientLoadBalancerMockRecorder) DeletePoliciesHTTPResponse(ctx, virtualServiceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePoliciesHTTPResponse", reflect.TypeOf((*MockclientLoadBalancer)(nil).DeletePoliciesHTTPResponse), ctx, virtualServiceID)
+}
+
+// DeletePoliciesHTTPSecurity mocks base method.
+func (m *MockclientLoadBalancer) DeletePoliciesHTTPSecurity(ctx context.Context, virtualServiceID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePoliciesHTTPSecurity", ctx, virtualServiceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePoliciesHTTPSecurity indicates an expected call of DeletePoliciesHTTPSecurity.
+func (mr *MockclientLoadBalancerMockRecorder) DeletePoliciesHTTPSecurity(ctx, virtualServiceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePoliciesHTTPSecurity", reflect.TypeOf((*MockclientLoadBalancer)(nil).DeletePoliciesHTTPSecurity), ctx, virtualServiceID)
+}
+
+// DeletePool mocks base method.
+func (m *MockclientLoadBalancer) DeletePool(ctx context.Context, poolID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePool", ctx, poolID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePool indicates an expected call of DeletePool.
+func (mr *MockclientLoadBalancerMockRecorder) DeletePool(ctx, poolID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePool", reflect.TypeOf((*MockclientLoadBalancer)(nil).DeletePool), ctx, poolID)
+}
+
+// DeleteVirtualService mocks base method.
+func (m *MockclientLoadBalancer) DeleteVirtualService(ctx context.Context, virtualServiceID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVirtualService", ctx, virtualServiceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVirtualService indicates an expected call of DeleteVirtualService.
+func (mr *MockclientLoadBalancerMockRecorder) DeleteVirtualService(ctx, virtualServiceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVirtualService", reflect.TypeOf((*MockclientLoadBalancer)(nil).DeleteVirtualService), ctx, virtualServiceID)
+}
+
+// GetPoliciesHTTPRequest mocks base method.
+func (m *MockclientLoadBalancer) GetPoliciesHTTPRequest(ctx context.Context, virtualServiceID string) (*edgeloadbalancer.PoliciesHTTPRequestModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPoliciesHTTPRequest", ctx, virtualServiceID)
+	ret0, _ := ret[0].(*edgeloadbalancer.PoliciesHTTPRequestModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPoliciesHTTPRequest indicates an expected call of GetPoliciesHTTPRequest.
+func (mr *MockclientLoadBalancerMockRecorder) GetPoliciesHTTPRequest(ctx, virtualServiceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoliciesHTTPRequest", reflect.TypeOf((*MockclientLoadBalancer)(nil).GetPoliciesHTTPRequest), ctx, virtualServiceID)
+}
+
+// GetPoliciesHTTPResponse mocks base method.
+func (m *MockclientLoadBalancer) GetPoliciesHTTPResponse(ctx context.Context, virtualServiceID string) (*edgeloadbalancer.PoliciesHTTPResponseModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPoliciesHTTPResponse", ctx, virtualServiceID)
+	ret0, _ := ret[0].(*edgeloadbalancer.PoliciesHTTPResponseModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPoliciesHTTPResponse indicates an expected call of GetPoliciesHTTPResponse.
+func (mr *MockclientLoadBalancerMockRecorder) GetPoliciesHTTPResponse(ctx, virtualServiceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoliciesHTTPResponse", reflect.TypeOf((*MockclientLoadBalancer)(nil).GetPoliciesHTTPResponse), ctx, virtualServiceID)
+}
+
+// GetPoliciesHTTPSecurity mocks base method.
+func (m *MockclientLoadBalancer) GetPoliciesHTTPSecurity(ctx context.Context, virtualServiceID string) (*edgeloadbalancer.PoliciesHTTPSecurityModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPoliciesHTTPSecurity", ctx, virtualServiceID)
+	ret0, _ := ret[0].(*edgeloadbalancer.PoliciesHTTPSecurityModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPoliciesHTTPSecurity indicates an expected call of GetPoliciesHTTPSecurity.
+func (mr *MockclientLoadBalancerMockRecorder) GetPoliciesHTTPSecurity(ctx, virtualServiceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoliciesHTTPSecurity", reflect.TypeOf((*MockclientLoadBalancer)(nil).GetPoliciesHTTPSecurity), ctx, virtualServiceID)
+}
+
+// GetPool mocks base method.
+func (m *MockclientLoadBalancer) GetPool(ctx context.Context, edgeGatewayID, poolNameOrID string) (*edgeloadbalancer.PoolModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPool", ctx, edgeGatewayID, poolNameOrID)
+	ret0, _ := ret[0].(*edgeloadbalancer.PoolModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPool indicates an expected call of GetPool.
+func (mr *MockclientLoadBalancerMockRecorder) GetPool(ctx, edgeGatewayID, poolNameOrID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPool", reflect.TypeOf((*MockclientLoadBalancer)(nil).GetPool), ctx, edgeGatewayID, poolNameOrID)
+}
+
+// ListPools mocks base method.
+func (m *MockclientLoadBalancer) ListPools(ctx context.Context, edgeGatewayID string) ([]*edgeloadbalancer.PoolModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPools", ctx, edgeGatewayID)
+	ret0, _ := ret[0].([]*edgeloadbalancer.PoolModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPools indicates an expected call of ListPools.
+func (mr *MockclientLoadBalancerMockRecorder) ListPools(ctx, edgeGatewayID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPools", reflect.TypeOf((*MockclientLoadBalancer)(nil).ListPools), ctx, edgeGatewayID)
+}
+
+// ListServiceEngineGroups mocks base method.
+func (m *MockclientLoadBalancer) ListServiceEngineGroups(ctx context.Context, edgeGatewayID string) ([]*edgeloadbalancer.ServiceEngineGroupModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServiceEngineGroups", ctx, edgeGatewayID)
+	ret0, _ := ret[0].([]*edgeloadbalancer.ServiceEngineGroupModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceEngineGroups indicates an expected call of ListServiceEngineGroups.
+func (mr *MockclientLoadBalancerMockRecorder) ListServiceEngineGroups(ctx, edgeGatewayID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceEngineGroups", reflect.TypeOf((*MockclientLoadBalancer)(nil).ListServiceEngineGroups), ctx, edgeGatewayID)
+}
+
+// ListVirtualServices mocks base method.
+func (m *MockclientLoadBalancer) ListVirtualServices(ctx context.Context, edgeGatewayID string) ([]*edgeloadbalancer.VirtualServiceModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVirtualServices", ctx, edgeGatewayID)
+	ret0, _ := ret[0].([]*edgeloadbalancer.VirtualServiceModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVirtualServices indicates an expected call of ListVirtualServices.
+func (mr *MockclientLoadBalancerMockRecorder) ListVirtualServices(ctx, edgeGatewayID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualServices", reflect.TypeOf((*MockclientLoadBalancer)(nil).ListVirtualServices), ctx, edgeGatewayID)
+}
+
+// UpdatePoliciesHTTPRequest mocks base method.
+func (m *MockclientLoadBalancer) UpdatePoliciesHTTPRequest(ctx context.Context, policies *edgeloadbalancer.PoliciesHTTPRequestModel, opts ...edgeloadbalancer.PoliciesHTTPUpdateOptions) (*edgeloadbalancer.PoliciesHTTPRequestModel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, policies}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdatePoliciesHTTPRequest", varargs...)
+	ret0, _ := ret[0].(*edgeloadbalancer.PoliciesHTTPRequestModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePoliciesHTTPRequest indicates an expected call of UpdatePoliciesHTTPRequest.
+func (mr *MockclientLoadBalancerMockRecorder) UpdatePoliciesHTTPRequest(ctx, policies any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, policies}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePoliciesHTTPRequest", reflect.TypeOf((*MockclientLoadBalancer)(nil).UpdatePoliciesHTTPRequest), varargs...)
+}
+
+// UpdatePoliciesHTTPResponse mocks base method.
+func (m *MockclientLoadBalancer) UpdatePoliciesHTTPResponse(ctx context.Context, policies *edgeloadbalancer.PoliciesHTTPResponseModel, opts ...edgeloadbalancer.PoliciesHTTPUpdateOptions) (*edgeloadbalancer.PoliciesHTTPResponseModel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, policies}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdatePoliciesHTTPResponse", varargs...)
+	ret0, _ := ret[0].(*edgeloadbalancer.PoliciesHTTPResponseModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePoliciesHTTPResponse indicates an expected call of UpdatePoliciesHTTPResponse.
+func (mr *MockclientLoadBalancerMockRecorder) UpdatePoliciesHTTPResponse(ctx, policies any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, policies}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePoliciesHTTPResponse", reflect.TypeOf((*MockclientLoadBalancer)(nil).UpdatePoliciesHTTPResponse), varargs...)
+}
+
+// UpdatePoliciesHTTPSecurity mocks base method.
+func (m *MockclientLoadBalancer) UpdatePoliciesHTTPSecurity(ctx context.Context, policies *edgeloadbalancer.PoliciesHTTPSecurityModel, opts ...edgeloadbalancer.PoliciesHTTPUpdateOptions) (*edgeloadbalancer.PoliciesHTTPSecurityModel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, policies}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdatePoliciesHTTPSecurity", varargs...)
+	ret0, _ := ret[0].(*edgeloadbalancer.PoliciesHTTPSecurityModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePoliciesHTTPSecurity indicates an expected call of UpdatePoliciesHTTPSecurity.
+func (mr *MockclientLoadBalancerMockRecorder) UpdatePoliciesHTTPSecurity(ctx, policies any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, policies}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePoliciesHTTPSecurity", reflect.TypeOf((*MockclientLoadBalancer)(nil).UpdatePoliciesHTTPSecurity), varargs...)
+}
+
+// UpdatePool mocks base method.
+func (m *MockclientLoadBalancer) UpdatePool(ctx context.Context, poolID string, pool edgeloadbalancer.PoolModelRequest) (*edgeloadbalancer.PoolModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePool", ctx, poolID, pool)
+	ret0, _ := ret[0].(*edgeloadbalancer.PoolModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePool indicates an expected call of UpdatePool.
+func (mr *MockclientLoadBalancerMockRecorder) UpdatePool(ctx, poolID, pool any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePool", reflect.TypeOf((*MockclientLoadBalancer)(nil).UpdatePool), ctx, poolID, pool)
+}
+
+// UpdateVirtualService mocks base method.
+func (m *MockclientLoadBalancer) UpdateVirtualService(ctx context.Context, virtualServiceID string, vsr edgeloadbalancer.VirtualServiceModelRequest) (*edgeloadbalancer.VirtualServiceModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVirtualService", ctx, virtualServiceID, vsr)
+	ret0, _ := ret[0].(*edgeloadbalancer.VirtualServiceModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVirtualService indicates an expected call of UpdateVirtualService.
+func (mr *MockclientLoadBalancerMockRecorder) UpdateVirtualService(ctx, virtualServiceID, vsr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVirtualService", reflect.TypeOf((*MockclientLoadBalancer)(nil).UpdateVirtualService), ctx, virtualServiceID, vsr)
+}
+
 // MockclientCloudavenue is a mock of clientCloudavenue interface.
 type MockclientCloudavenue struct {
 	ctrl     *gomock.Controller
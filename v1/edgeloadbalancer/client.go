@@ -30,6 +30,14 @@ type (
 		ListServiceEngineGroups(ctx context.Context, edgeGatewayID string) ([]*ServiceEngineGroupModel, error)
 		GetServiceEngineGroup(ctx context.Context, edgeGatewayID, nameOrID string) (*ServiceEngineGroupModel, error)
 		GetFirstServiceEngineGroup(ctx context.Context, edgeGatewayID string) (*ServiceEngineGroupModel, error)
+		AssignServiceEngineGroup(ctx context.Context, edgeGatewayID string, assignment ServiceEngineGroupAssignmentModelRequest) (*ServiceEngineGroupModel, error)
+		UnassignServiceEngineGroup(ctx context.Context, edgeGatewayID, nameOrID string) error
+		GetServiceEngineGroupsCapacity(ctx context.Context, edgeGatewayID string) (*ServiceEngineGroupCapacityModel, error)
+
+		// * Load Balancer
+		GetLoadBalancerConfig(ctx context.Context, edgeGatewayID string) (*LoadBalancerConfigModel, error)
+		EnableLoadBalancer(ctx context.Context, edgeGatewayID string, config LoadBalancerConfigModelRequest) (*LoadBalancerConfigModel, error)
+		DisableLoadBalancer(ctx context.Context, edgeGatewayID string) error
 
 		// * Pools
 		CreatePool(ctx context.Context, pool PoolModelRequest) (*PoolModel, error)
@@ -37,43 +45,70 @@ type (
 		GetPool(ctx context.Context, edgeGatewayID, poolNameOrID string) (*PoolModel, error)
 		UpdatePool(ctx context.Context, poolID string, pool PoolModelRequest) (*PoolModel, error)
 		DeletePool(ctx context.Context, poolID string) error
+		// ? Members
+		AddPoolMember(ctx context.Context, poolID string, member PoolModelMember) (*PoolModel, error)
+		RemovePoolMember(ctx context.Context, poolID, ipAddress string, port int) (*PoolModel, error)
+		SetPoolMemberEnabled(ctx context.Context, poolID, ipAddress string, port int, enabled bool) (*PoolModel, error)
+		SetPoolMemberRatio(ctx context.Context, poolID, ipAddress string, port, ratio int) (*PoolModel, error)
+		RollingMaintenance(ctx context.Context, poolID string, fn PoolMemberMaintenanceFunc) error
+		GetEffectiveMembers(ctx context.Context, poolID string) ([]PoolModelMember, error)
+		MigratePoolMembersToIPSet(ctx context.Context, poolID, ipSetName string, opts ...PoolMigrateToIPSetOptions) (*PoolModel, error)
 
 		// * Virtual Services
 		ListVirtualServices(ctx context.Context, edgeGatewayID string) ([]*VirtualServiceModel, error)
 		GetVirtualService(ctx context.Context, edgeGatewayID, virtualServiceNameOrID string) (*VirtualServiceModel, error)
 		CreateVirtualService(ctx context.Context, vsr VirtualServiceModelRequest) (*VirtualServiceModel, error)
+		CreateVirtualServiceWithOptions(ctx context.Context, vsr VirtualServiceModelRequest, opts VirtualServiceCreateOptions) (*VirtualServiceModel, error)
 		UpdateVirtualService(ctx context.Context, virtualServiceID string, vsr VirtualServiceModelRequest) (*VirtualServiceModel, error)
 		DeleteVirtualService(ctx context.Context, virtualServiceID string) error
+		SwitchPool(ctx context.Context, virtualServiceID, newPoolID string, opts VirtualServiceSwitchPoolOptions) (*VirtualServiceModel, error)
 
 		// * Policies
 		// ? Request
 		GetPoliciesHTTPRequest(ctx context.Context, virtualServiceID string) (*PoliciesHTTPRequestModel, error)
-		UpdatePoliciesHTTPRequest(ctx context.Context, policies *PoliciesHTTPRequestModel) (*PoliciesHTTPRequestModel, error)
+		UpdatePoliciesHTTPRequest(ctx context.Context, policies *PoliciesHTTPRequestModel, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPRequestModel, error)
 		DeletePoliciesHTTPRequest(ctx context.Context, virtualServiceID string) error
+		AddPolicyHTTPRequest(ctx context.Context, virtualServiceID string, policy *PoliciesHTTPRequestModelPolicy, position PoliciesHTTPPolicyPosition, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPRequestModel, error)
+		UpdatePolicyHTTPRequestByName(ctx context.Context, virtualServiceID, name string, policy *PoliciesHTTPRequestModelPolicy, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPRequestModel, error)
+		DeletePolicyHTTPRequestByName(ctx context.Context, virtualServiceID, name string, opts ...PoliciesHTTPUpdateOptions) error
+		MovePolicyHTTPRequest(ctx context.Context, virtualServiceID, name string, position PoliciesHTTPPolicyPosition, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPRequestModel, error)
 		// ? Response
 		GetPoliciesHTTPResponse(ctx context.Context, virtualServiceID string) (*PoliciesHTTPResponseModel, error)
-		UpdatePoliciesHTTPResponse(ctx context.Context, policies *PoliciesHTTPResponseModel) (*PoliciesHTTPResponseModel, error)
+		UpdatePoliciesHTTPResponse(ctx context.Context, policies *PoliciesHTTPResponseModel, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPResponseModel, error)
 		DeletePoliciesHTTPResponse(ctx context.Context, virtualServiceID string) error
+		AddPolicyHTTPResponse(ctx context.Context, virtualServiceID string, policy *PoliciesHTTPResponseModelPolicy, position PoliciesHTTPPolicyPosition, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPResponseModel, error)
+		UpdatePolicyHTTPResponseByName(ctx context.Context, virtualServiceID, name string, policy *PoliciesHTTPResponseModelPolicy, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPResponseModel, error)
+		DeletePolicyHTTPResponseByName(ctx context.Context, virtualServiceID, name string, opts ...PoliciesHTTPUpdateOptions) error
+		MovePolicyHTTPResponse(ctx context.Context, virtualServiceID, name string, position PoliciesHTTPPolicyPosition, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPResponseModel, error)
 		// ? Security
 		GetPoliciesHTTPSecurity(ctx context.Context, virtualServiceID string) (*PoliciesHTTPSecurityModel, error)
-		UpdatePoliciesHTTPSecurity(ctx context.Context, policies *PoliciesHTTPSecurityModel) (*PoliciesHTTPSecurityModel, error)
+		UpdatePoliciesHTTPSecurity(ctx context.Context, policies *PoliciesHTTPSecurityModel, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPSecurityModel, error)
 		DeletePoliciesHTTPSecurity(ctx context.Context, virtualServiceID string) error
+		AddPolicyHTTPSecurity(ctx context.Context, virtualServiceID string, policy *PoliciesHTTPSecurityModelPolicy, position PoliciesHTTPPolicyPosition, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPSecurityModel, error)
+		UpdatePolicyHTTPSecurityByName(ctx context.Context, virtualServiceID, name string, policy *PoliciesHTTPSecurityModelPolicy, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPSecurityModel, error)
+		DeletePolicyHTTPSecurityByName(ctx context.Context, virtualServiceID, name string, opts ...PoliciesHTTPUpdateOptions) error
+		MovePolicyHTTPSecurity(ctx context.Context, virtualServiceID, name string, position PoliciesHTTPPolicyPosition, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPSecurityModel, error)
 	}
 
 	// Internal client interfaces.
 	clientFake interface {
 		clientGoVCD
+		clientGoVCDOrg
 		clientCloudavenue
 	}
 
 	client struct {
 		clientGoVCD       clientGoVCD
+		clientGoVCDOrg    clientGoVCDOrg
 		clientCloudavenue clientCloudavenue
 	}
 
 	clientGoVCD interface {
 		// Service Engine Groups
 		GetAllAlbServiceEngineGroupAssignments(queryParameters url.Values) ([]*govcd.NsxtAlbServiceEngineGroupAssignment, error)
+		CreateAlbServiceEngineGroupAssignment(assignmentConfig *govcdtypes.NsxtAlbServiceEngineGroupAssignment) (*govcd.NsxtAlbServiceEngineGroupAssignment, error)
+		GetAlbServiceEngineGroupById(id string) (*govcd.NsxtAlbServiceEngineGroup, error)
+		GetAlbServiceEngineGroupByName(optionalContext, name string) (*govcd.NsxtAlbServiceEngineGroup, error)
 
 		// Pools
 		GetAlbPoolById(id string) (*govcd.NsxtAlbPool, error)
@@ -86,6 +121,14 @@ type (
 		GetAlbVirtualServiceById(id string) (*govcd.NsxtAlbVirtualService, error)
 		GetAllAlbVirtualServiceSummaries(edgeGatewayID string, queryParameters url.Values) ([]*govcd.NsxtAlbVirtualService, error)
 		CreateNsxtAlbVirtualService(albVirtualServiceConfig *govcdtypes.NsxtAlbVirtualService) (*govcd.NsxtAlbVirtualService, error)
+
+		// Queries
+		QueryWithNotEncodedParams(params map[string]string, notEncodedParams map[string]string) (govcd.Results, error)
+	}
+
+	clientGoVCDOrg interface {
+		// Edge Gateways
+		GetNsxtEdgeGatewayById(id string) (*govcd.NsxtEdgeGateway, error)
 	}
 
 	clientCloudavenue interface {
@@ -104,6 +147,7 @@ func NewClient() (Client, error) {
 	return &client{
 		clientCloudavenue: c,
 		clientGoVCD:       c.Vmware,
+		clientGoVCDOrg:    c.Org,
 	}, nil
 }
 
@@ -112,5 +156,6 @@ func NewFakeClient(i clientFake) (Client, error) {
 	return &client{
 		clientCloudavenue: i,
 		clientGoVCD:       i,
+		clientGoVCDOrg:    i,
 	}, nil
 }
@@ -0,0 +1,31 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgeloadbalancer
+
+import (
+	"fmt"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+)
+
+// edgeGatewayIDValidator validates the given edgeGatewayID.
+// It returns an error if the edgeGatewayID is empty or not in the correct format.
+func (*client) edgeGatewayIDValidator(edgeGatewayID string) error {
+	if edgeGatewayID == "" {
+		return fmt.Errorf("edgeGatewayID is %w. Please provide a valid edgeGatewayID", errors.ErrEmpty)
+	}
+
+	if !urn.IsEdgeGateway(edgeGatewayID) {
+		return fmt.Errorf("edgeGatewayID has %w. Please provide a valid edgeGatewayID", errors.ErrInvalidFormat)
+	}
+
+	return nil
+}
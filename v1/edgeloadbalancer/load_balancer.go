@@ -0,0 +1,184 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgeloadbalancer
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/orange-cloudavenue/common-go/validators"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+)
+
+// GetLoadBalancerConfig retrieves the ALB service configuration of an edge gateway
+// with the service engine groups assigned to it.
+func (c *client) GetLoadBalancerConfig(_ context.Context, edgeGatewayID string) (*LoadBalancerConfigModel, error) {
+	if err := c.edgeGatewayIDValidator(edgeGatewayID); err != nil {
+		return nil, err
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return nil, err
+	}
+
+	egw, err := c.clientGoVCDOrg.GetNsxtEdgeGatewayById(edgeGatewayID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving edge gateway: %w", err)
+	}
+
+	return c.getLoadBalancerConfig(egw, edgeGatewayID)
+}
+
+func (c *client) getLoadBalancerConfig(egw fakeLoadBalancerEdgeGatewayClient, edgeGatewayID string) (*LoadBalancerConfigModel, error) {
+	config, err := getAlbSettings(egw)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving load balancer settings: %w", err)
+	}
+
+	assignments, err := c.getServiceEngineGroupAssignments(edgeGatewayID)
+	if err != nil {
+		return nil, err
+	}
+
+	segs := make([]*ServiceEngineGroupModel, len(assignments))
+	for i, a := range assignments {
+		segs[i] = (&ServiceEngineGroupModel{}).fromVCD(a.NsxtAlbServiceEngineGroupAssignment)
+	}
+
+	return (&LoadBalancerConfigModel{}).fromVCD(edgeGatewayID, config, segs), nil
+}
+
+var getAlbSettings = func(egw fakeLoadBalancerEdgeGatewayClient) (*govcdtypes.NsxtAlbConfig, error) {
+	return egw.GetAlbSettings()
+}
+
+// EnableLoadBalancer enables the ALB service on an edge gateway and assigns the service engine groups.
+// If the service is already enabled, the settings are updated. The service network definitions
+// cannot be changed once the service is enabled.
+func (c *client) EnableLoadBalancer(ctx context.Context, edgeGatewayID string, config LoadBalancerConfigModelRequest) (*LoadBalancerConfigModel, error) {
+	if err := c.edgeGatewayIDValidator(edgeGatewayID); err != nil {
+		return nil, err
+	}
+
+	if err := validators.New().StructCtx(ctx, &config); err != nil {
+		return nil, err
+	}
+
+	if config.ServiceNetworkDefinition != "" {
+		if _, ipNet, err := net.ParseCIDR(config.ServiceNetworkDefinition); err == nil {
+			if ones, _ := ipNet.Mask.Size(); ones != serviceNetworkDefinitionPrefixLength {
+				return nil, fmt.Errorf("serviceNetworkDefinition has %w. The prefix length must be %d", errors.ErrInvalidFormat, serviceNetworkDefinitionPrefixLength)
+			}
+		}
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return nil, err
+	}
+
+	egw, err := c.clientGoVCDOrg.GetNsxtEdgeGatewayById(edgeGatewayID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving edge gateway: %w", err)
+	}
+
+	current, err := getAlbSettings(egw)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving load balancer settings: %w", err)
+	}
+
+	settings := &govcdtypes.NsxtAlbConfig{
+		Enabled:                      true,
+		SupportedFeatureSet:          config.SupportedFeatureSet,
+		LoadBalancerCloudRef:         current.LoadBalancerCloudRef,
+		ServiceNetworkDefinition:     config.ServiceNetworkDefinition,
+		Ipv6ServiceNetworkDefinition: config.IPv6ServiceNetworkDefinition,
+		TransparentModeEnabled:       config.TransparentModeEnabled,
+	}
+
+	if current.Enabled {
+		// * The service network definitions cannot be updated
+		if config.ServiceNetworkDefinition != "" && config.ServiceNetworkDefinition != current.ServiceNetworkDefinition {
+			return nil, fmt.Errorf("the service network definition cannot be updated once the load balancer is enabled (current: %s)", current.ServiceNetworkDefinition)
+		}
+
+		if config.IPv6ServiceNetworkDefinition != "" && config.IPv6ServiceNetworkDefinition != current.Ipv6ServiceNetworkDefinition {
+			return nil, fmt.Errorf("the IPv6 service network definition cannot be updated once the load balancer is enabled (current: %s)", current.Ipv6ServiceNetworkDefinition)
+		}
+
+		settings.ServiceNetworkDefinition = current.ServiceNetworkDefinition
+		settings.Ipv6ServiceNetworkDefinition = current.Ipv6ServiceNetworkDefinition
+
+		if settings.SupportedFeatureSet == "" {
+			settings.SupportedFeatureSet = current.SupportedFeatureSet
+		}
+
+		if settings.TransparentModeEnabled == nil {
+			settings.TransparentModeEnabled = current.TransparentModeEnabled
+		}
+	}
+
+	if _, err := updateAlbSettings(egw, settings); err != nil {
+		return nil, fmt.Errorf("error enabling load balancer: %w", err)
+	}
+
+	for _, seg := range config.ServiceEngineGroups {
+		if _, err := c.assignServiceEngineGroup(ctx, edgeGatewayID, seg); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.getLoadBalancerConfig(egw, edgeGatewayID)
+}
+
+var updateAlbSettings = func(egw fakeLoadBalancerEdgeGatewayClient, config *govcdtypes.NsxtAlbConfig) (*govcdtypes.NsxtAlbConfig, error) {
+	return egw.UpdateAlbSettings(config)
+}
+
+// DisableLoadBalancer unassigns all the service engine groups of an edge gateway and disables the ALB service.
+// The virtual services of the edge gateway must be deleted beforehand.
+func (c *client) DisableLoadBalancer(_ context.Context, edgeGatewayID string) error {
+	if err := c.edgeGatewayIDValidator(edgeGatewayID); err != nil {
+		return err
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return err
+	}
+
+	egw, err := c.clientGoVCDOrg.GetNsxtEdgeGatewayById(edgeGatewayID)
+	if err != nil {
+		return fmt.Errorf("error retrieving edge gateway: %w", err)
+	}
+
+	assignments, err := c.getServiceEngineGroupAssignments(edgeGatewayID)
+	if err != nil {
+		return err
+	}
+
+	for _, a := range assignments {
+		if err := deleteServiceEngineGroupAssignment(a); err != nil {
+			return fmt.Errorf("error unassigning service engine group %s: %w", a.NsxtAlbServiceEngineGroupAssignment.ServiceEngineGroupRef.Name, err)
+		}
+	}
+
+	if err := disableAlb(egw); err != nil {
+		return fmt.Errorf("error disabling load balancer: %w", err)
+	}
+
+	return nil
+}
+
+var disableAlb = func(egw fakeLoadBalancerEdgeGatewayClient) error {
+	return egw.DisableAlb()
+}
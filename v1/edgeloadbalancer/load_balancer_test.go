@@ -0,0 +1,411 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgeloadbalancer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/utils"
+	sdkerrors "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+)
+
+const (
+	testServiceNetwork     = "192.168.255.1/25"
+	testIPv6ServiceNetwork = "fd00::1/120"
+)
+
+// testSEGAssignment returns a service engine group assignment for an edge gateway.
+func testSEGAssignment(edgeGatewayID, segID string) *govcd.NsxtAlbServiceEngineGroupAssignment {
+	return &govcd.NsxtAlbServiceEngineGroupAssignment{
+		NsxtAlbServiceEngineGroupAssignment: &govcdtypes.NsxtAlbServiceEngineGroupAssignment{
+			ID:                    uuid.New().String(),
+			ServiceEngineGroupRef: &govcdtypes.OpenApiReference{ID: segID, Name: testName},
+			GatewayRef:            &govcdtypes.OpenApiReference{ID: edgeGatewayID, Name: testEdgeName},
+			MinVirtualServices:    utils.ToPTR(1),
+			MaxVirtualServices:    utils.ToPTR(10),
+		},
+	}
+}
+
+func TestClient_GetLoadBalancerConfig(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientFake(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	urnEdgeGateway := urn.Gateway.String() + uuid.New().String()
+	urnServiceEngineGroup := urn.ServiceEngineGroup.String() + uuid.New().String()
+
+	tests := []struct {
+		name          string
+		edgeGatewayID string
+		mockFunc      func()
+		expectedValue *LoadBalancerConfigModel
+		expectedErr   bool
+		err           error
+	}{
+		{
+			name:          testSuccess,
+			edgeGatewayID: urnEdgeGateway,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(urnEdgeGateway).Return(&govcd.NsxtEdgeGateway{}, nil)
+				getAlbSettings = func(_ fakeLoadBalancerEdgeGatewayClient) (*govcdtypes.NsxtAlbConfig, error) {
+					return &govcdtypes.NsxtAlbConfig{
+						Enabled:                      true,
+						SupportedFeatureSet:          "PREMIUM",
+						ServiceNetworkDefinition:     testServiceNetwork,
+						Ipv6ServiceNetworkDefinition: testIPv6ServiceNetwork,
+						TransparentModeEnabled:       utils.ToPTR(true),
+					}, nil
+				}
+				clientCAV.EXPECT().GetAllAlbServiceEngineGroupAssignments(gomock.Any()).Return([]*govcd.NsxtAlbServiceEngineGroupAssignment{
+					testSEGAssignment(urnEdgeGateway, urnServiceEngineGroup),
+				}, nil)
+			},
+			expectedValue: &LoadBalancerConfigModel{
+				EdgeGatewayID:                urnEdgeGateway,
+				Enabled:                      true,
+				SupportedFeatureSet:          "PREMIUM",
+				ServiceNetworkDefinition:     testServiceNetwork,
+				IPv6ServiceNetworkDefinition: testIPv6ServiceNetwork,
+				TransparentModeEnabled:       true,
+				ServiceEngineGroups: []*ServiceEngineGroupModel{
+					{
+						ID:                 urnServiceEngineGroup,
+						Name:               testName,
+						GatewayRef:         &govcdtypes.OpenApiReference{ID: urnEdgeGateway, Name: testEdgeName},
+						MinVirtualServices: utils.ToPTR(1),
+						MaxVirtualServices: utils.ToPTR(10),
+					},
+				},
+			},
+		},
+		{
+			name:          "success-disabled",
+			edgeGatewayID: urnEdgeGateway,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(urnEdgeGateway).Return(&govcd.NsxtEdgeGateway{}, nil)
+				getAlbSettings = func(_ fakeLoadBalancerEdgeGatewayClient) (*govcdtypes.NsxtAlbConfig, error) {
+					return &govcdtypes.NsxtAlbConfig{}, nil
+				}
+				clientCAV.EXPECT().GetAllAlbServiceEngineGroupAssignments(gomock.Any()).Return(nil, nil)
+			},
+			expectedValue: &LoadBalancerConfigModel{
+				EdgeGatewayID:       urnEdgeGateway,
+				ServiceEngineGroups: []*ServiceEngineGroupModel{},
+			},
+		},
+		{
+			name:          "error-empty-edge-gateway-id",
+			edgeGatewayID: "",
+			mockFunc:      func() {},
+			expectedErr:   true,
+			err:           sdkerrors.ErrEmpty,
+		},
+		{
+			name:          "error-invalid-edge-gateway-id",
+			edgeGatewayID: "invalid",
+			mockFunc:      func() {},
+			expectedErr:   true,
+			err:           sdkerrors.ErrInvalidFormat,
+		},
+		{
+			name:          "error-get-settings",
+			edgeGatewayID: urnEdgeGateway,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(urnEdgeGateway).Return(&govcd.NsxtEdgeGateway{}, nil)
+				getAlbSettings = func(_ fakeLoadBalancerEdgeGatewayClient) (*govcdtypes.NsxtAlbConfig, error) {
+					return nil, errors.New("error")
+				}
+			},
+			expectedErr: true,
+			err:         errors.New("error retrieving load balancer settings"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			config, err := c.GetLoadBalancerConfig(context.Background(), tt.edgeGatewayID)
+			if !tt.expectedErr {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedValue, config)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Nil(t, config)
+			if errors.Is(err, tt.err) {
+				return
+			}
+			assert.Contains(t, err.Error(), tt.err.Error())
+		})
+	}
+}
+
+func TestClient_EnableLoadBalancer(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientFake(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	urnEdgeGateway := urn.Gateway.String() + uuid.New().String()
+	urnServiceEngineGroup := urn.ServiceEngineGroup.String() + uuid.New().String()
+
+	var updated *govcdtypes.NsxtAlbConfig
+	updateAlbSettings = func(_ fakeLoadBalancerEdgeGatewayClient, config *govcdtypes.NsxtAlbConfig) (*govcdtypes.NsxtAlbConfig, error) {
+		updated = config
+		return config, nil
+	}
+
+	tests := []struct {
+		name          string
+		request       LoadBalancerConfigModelRequest
+		current       *govcdtypes.NsxtAlbConfig
+		mockFunc      func()
+		expectedValue *govcdtypes.NsxtAlbConfig
+		expectedErr   bool
+		err           error
+	}{
+		{
+			name: testSuccess,
+			request: LoadBalancerConfigModelRequest{
+				ServiceNetworkDefinition:     testServiceNetwork,
+				IPv6ServiceNetworkDefinition: testIPv6ServiceNetwork,
+				ServiceEngineGroups: []ServiceEngineGroupAssignmentModelRequest{
+					{NameOrID: testName, MinVirtualServices: utils.ToPTR(1), MaxVirtualServices: utils.ToPTR(10)},
+				},
+			},
+			current: &govcdtypes.NsxtAlbConfig{},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(urnEdgeGateway).Return(&govcd.NsxtEdgeGateway{}, nil)
+				gomock.InOrder(
+					clientCAV.EXPECT().GetAllAlbServiceEngineGroupAssignments(gomock.Any()).Return(nil, nil),
+					clientCAV.EXPECT().GetAlbServiceEngineGroupByName(urnEdgeGateway, testName).Return(&govcd.NsxtAlbServiceEngineGroup{
+						NsxtAlbServiceEngineGroup: &govcdtypes.NsxtAlbServiceEngineGroup{ID: urnServiceEngineGroup, Name: testName},
+					}, nil),
+					clientCAV.EXPECT().CreateAlbServiceEngineGroupAssignment(gomock.Any()).DoAndReturn(func(config *govcdtypes.NsxtAlbServiceEngineGroupAssignment) (*govcd.NsxtAlbServiceEngineGroupAssignment, error) {
+						assert.Equal(t, urnServiceEngineGroup, config.ServiceEngineGroupRef.ID)
+						assert.Equal(t, urnEdgeGateway, config.GatewayRef.ID)
+						assert.Equal(t, 1, *config.MinVirtualServices)
+						assert.Equal(t, 10, *config.MaxVirtualServices)
+						return &govcd.NsxtAlbServiceEngineGroupAssignment{NsxtAlbServiceEngineGroupAssignment: config}, nil
+					}),
+					clientCAV.EXPECT().GetAllAlbServiceEngineGroupAssignments(gomock.Any()).Return([]*govcd.NsxtAlbServiceEngineGroupAssignment{
+						testSEGAssignment(urnEdgeGateway, urnServiceEngineGroup),
+					}, nil),
+				)
+			},
+			expectedValue: &govcdtypes.NsxtAlbConfig{
+				Enabled:                      true,
+				ServiceNetworkDefinition:     testServiceNetwork,
+				Ipv6ServiceNetworkDefinition: testIPv6ServiceNetwork,
+			},
+		},
+		{
+			name: "success-already-enabled",
+			request: LoadBalancerConfigModelRequest{
+				TransparentModeEnabled: utils.ToPTR(true),
+			},
+			current: &govcdtypes.NsxtAlbConfig{
+				Enabled:                  true,
+				SupportedFeatureSet:      "STANDARD",
+				ServiceNetworkDefinition: testServiceNetwork,
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(urnEdgeGateway).Return(&govcd.NsxtEdgeGateway{}, nil)
+				clientCAV.EXPECT().GetAllAlbServiceEngineGroupAssignments(gomock.Any()).Return(nil, nil)
+			},
+			expectedValue: &govcdtypes.NsxtAlbConfig{
+				Enabled:                  true,
+				SupportedFeatureSet:      "STANDARD",
+				ServiceNetworkDefinition: testServiceNetwork,
+				TransparentModeEnabled:   utils.ToPTR(true),
+			},
+		},
+		{
+			name: "error-update-service-network",
+			request: LoadBalancerConfigModelRequest{
+				ServiceNetworkDefinition: "192.168.254.1/25",
+			},
+			current: &govcdtypes.NsxtAlbConfig{
+				Enabled:                  true,
+				ServiceNetworkDefinition: testServiceNetwork,
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(urnEdgeGateway).Return(&govcd.NsxtEdgeGateway{}, nil)
+			},
+			expectedErr: true,
+			err:         errors.New("the service network definition cannot be updated"),
+		},
+		{
+			name: "error-invalid-service-network",
+			request: LoadBalancerConfigModelRequest{
+				ServiceNetworkDefinition: "192.168.255.1",
+			},
+			mockFunc:    func() {},
+			expectedErr: true,
+			err:         errors.New("ServiceNetworkDefinition"),
+		},
+		{
+			name: "error-service-network-prefix-length",
+			request: LoadBalancerConfigModelRequest{
+				ServiceNetworkDefinition: "192.168.255.1/24",
+			},
+			mockFunc:    func() {},
+			expectedErr: true,
+			err:         sdkerrors.ErrInvalidFormat,
+		},
+		{
+			name: "error-min-greater-than-max",
+			request: LoadBalancerConfigModelRequest{
+				ServiceEngineGroups: []ServiceEngineGroupAssignmentModelRequest{
+					{NameOrID: testName, MinVirtualServices: utils.ToPTR(10), MaxVirtualServices: utils.ToPTR(1)},
+				},
+			},
+			current: &govcdtypes.NsxtAlbConfig{},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(urnEdgeGateway).Return(&govcd.NsxtEdgeGateway{}, nil)
+			},
+			expectedErr: true,
+			err:         errors.New("MinVirtualServices (10) cannot be greater than MaxVirtualServices (1)"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated = nil
+			getAlbSettings = func(_ fakeLoadBalancerEdgeGatewayClient) (*govcdtypes.NsxtAlbConfig, error) {
+				if updated != nil {
+					return updated, nil
+				}
+				return tt.current, nil
+			}
+			tt.mockFunc()
+
+			config, err := c.EnableLoadBalancer(context.Background(), urnEdgeGateway, tt.request)
+			if !tt.expectedErr {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedValue, updated)
+				assert.True(t, config.Enabled)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Nil(t, config)
+			assert.Contains(t, err.Error(), tt.err.Error())
+		})
+	}
+}
+
+func TestClient_DisableLoadBalancer(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientFake(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	urnEdgeGateway := urn.Gateway.String() + uuid.New().String()
+
+	var (
+		unassigned int
+		disabled   bool
+	)
+	deleteServiceEngineGroupAssignment = func(_ fakeServiceEngineGroupAssignmentClient) error {
+		unassigned++
+		return nil
+	}
+
+	tests := []struct {
+		name          string
+		mockFunc      func()
+		expectedCount int
+		expectedErr   bool
+		err           error
+	}{
+		{
+			name: testSuccess,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(urnEdgeGateway).Return(&govcd.NsxtEdgeGateway{}, nil)
+				clientCAV.EXPECT().GetAllAlbServiceEngineGroupAssignments(gomock.Any()).Return([]*govcd.NsxtAlbServiceEngineGroupAssignment{
+					testSEGAssignment(urnEdgeGateway, urn.ServiceEngineGroup.String()+uuid.New().String()),
+					testSEGAssignment(urnEdgeGateway, urn.ServiceEngineGroup.String()+uuid.New().String()),
+				}, nil)
+				disableAlb = func(_ fakeLoadBalancerEdgeGatewayClient) error {
+					disabled = true
+					return nil
+				}
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "error-disable",
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(urnEdgeGateway).Return(&govcd.NsxtEdgeGateway{}, nil)
+				clientCAV.EXPECT().GetAllAlbServiceEngineGroupAssignments(gomock.Any()).Return(nil, nil)
+				disableAlb = func(_ fakeLoadBalancerEdgeGatewayClient) error {
+					return errors.New("error")
+				}
+			},
+			expectedErr: true,
+			err:         errors.New("error disabling load balancer"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unassigned = 0
+			disabled = false
+			tt.mockFunc()
+
+			err := c.DisableLoadBalancer(context.Background(), urnEdgeGateway)
+			if !tt.expectedErr {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedCount, unassigned)
+				assert.True(t, disabled)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err.Error())
+		})
+	}
+}
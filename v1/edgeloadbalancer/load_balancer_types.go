@@ -0,0 +1,104 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgeloadbalancer
+
+import (
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+type (
+	fakeLoadBalancerEdgeGatewayClient interface {
+		GetAlbSettings() (*govcdtypes.NsxtAlbConfig, error)
+		UpdateAlbSettings(config *govcdtypes.NsxtAlbConfig) (*govcdtypes.NsxtAlbConfig, error)
+		DisableAlb() error
+	}
+
+	fakeServiceEngineGroupAssignmentClient interface {
+		Update(assignmentConfig *govcdtypes.NsxtAlbServiceEngineGroupAssignment) (*govcd.NsxtAlbServiceEngineGroupAssignment, error)
+		Delete() error
+	}
+
+	// LoadBalancerConfigModel represents the ALB service configuration of an Edge Gateway.
+	LoadBalancerConfigModel struct {
+		// EdgeGatewayID is the urn of the edge gateway
+		EdgeGatewayID string
+
+		// Enabled indicates whether the load balancer service is enabled
+		Enabled bool
+
+		// SupportedFeatureSet is the feature set of the load balancer (STANDARD or PREMIUM)
+		SupportedFeatureSet string
+
+		// ServiceNetworkDefinition is the IPv4 network (gateway CIDR format) used by the service engines
+		ServiceNetworkDefinition string
+
+		// IPv6ServiceNetworkDefinition is the IPv6 network (gateway CIDR format) used by the service engines
+		IPv6ServiceNetworkDefinition string
+
+		// TransparentModeEnabled allows to preserve the client IP on the virtual services
+		TransparentModeEnabled bool
+
+		// ServiceEngineGroups are the service engine groups assigned to the edge gateway
+		ServiceEngineGroups []*ServiceEngineGroupModel
+	}
+
+	// LoadBalancerConfigModelRequest is used to enable the ALB service on an Edge Gateway.
+	LoadBalancerConfigModelRequest struct {
+		// SupportedFeatureSet is the feature set of the load balancer.
+		// If empty, the default feature set of the load balancer cloud is used.
+		SupportedFeatureSet string `validate:"omitempty,oneof=STANDARD PREMIUM"`
+
+		// ServiceNetworkDefinition is the IPv4 network in gateway CIDR format (e.g. 192.168.255.1/25)
+		// used by the service engines. The prefix length must be 25.
+		// If both network definitions are empty, the default 192.168.255.1/25 is used.
+		// This field cannot be updated once the load balancer is enabled.
+		ServiceNetworkDefinition string `validate:"omitempty,cidr"`
+
+		// IPv6ServiceNetworkDefinition is the IPv6 network in gateway CIDR format used by the service engines.
+		// If both network definitions are set, the service network is dual stack.
+		// This field cannot be updated once the load balancer is enabled.
+		IPv6ServiceNetworkDefinition string `validate:"omitempty,cidr"`
+
+		// TransparentModeEnabled allows to preserve the client IP on the virtual services
+		TransparentModeEnabled *bool `validate:"omitempty"`
+
+		// ServiceEngineGroups are the service engine groups to assign to the edge gateway.
+		// Service engine groups already assigned and not listed here are kept.
+		ServiceEngineGroups []ServiceEngineGroupAssignmentModelRequest `validate:"omitempty,dive"`
+	}
+
+	// ServiceEngineGroupAssignmentModelRequest is used to assign a Service Engine Group to an Edge Gateway.
+	ServiceEngineGroupAssignmentModelRequest struct {
+		// NameOrID is the name or the urn of the service engine group
+		NameOrID string `validate:"required"`
+
+		// MinVirtualServices is the number of virtual services reserved on a shared service engine group
+		MinVirtualServices *int `validate:"omitempty,min=0"`
+
+		// MaxVirtualServices is the maximum number of virtual services on a shared service engine group
+		MaxVirtualServices *int `validate:"omitempty,min=1"`
+	}
+)
+
+// serviceNetworkDefinitionPrefixLength is the prefix length of the IPv4 service network of the service engines.
+const serviceNetworkDefinitionPrefixLength = 25
+
+func (m *LoadBalancerConfigModel) fromVCD(edgeGatewayID string, config *govcdtypes.NsxtAlbConfig, segs []*ServiceEngineGroupModel) *LoadBalancerConfigModel {
+	m.EdgeGatewayID = edgeGatewayID
+	m.Enabled = config.Enabled
+	m.SupportedFeatureSet = config.SupportedFeatureSet
+	m.ServiceNetworkDefinition = config.ServiceNetworkDefinition
+	m.IPv6ServiceNetworkDefinition = config.Ipv6ServiceNetworkDefinition
+	m.TransparentModeEnabled = config.TransparentModeEnabled != nil && *config.TransparentModeEnabled
+	m.ServiceEngineGroups = segs
+
+	return m
+}
@@ -0,0 +1,396 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgeloadbalancer
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/orange-cloudavenue/common-go/validators"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+)
+
+// EvaluatePoliciesHTTP evaluates a synthetic request against the HTTP security and HTTP request
+// policies of a virtual service without calling Cloud Director. Either set of policies can be nil.
+//
+// The evaluation mimics the load balancer:
+//   - HTTP security policies are evaluated before HTTP request policies.
+//   - Inactive policies are ignored.
+//   - All the criteria of a policy must match. Only the first matching policy of each kind is applied.
+//   - A connection close, a send response or a redirect ends the request and the
+//     remaining policies are not applied.
+//
+// Rate limits are returned as actions, the evaluator does not count the requests.
+func EvaluatePoliciesHTTP(request PoliciesHTTPEvaluationRequest, security *PoliciesHTTPSecurityModel, requestPolicies *PoliciesHTTPRequestModel) (*PoliciesHTTPEvaluationResult, error) {
+	if err := validators.New().Struct(&request); err != nil {
+		return nil, err
+	}
+
+	result := &PoliciesHTTPEvaluationResult{}
+
+	if security != nil {
+		applied := false
+		for _, policy := range security.Policies {
+			if policy == nil || !policy.Active {
+				continue
+			}
+
+			ok, err := policy.MatchCriteria.match(request)
+			if err != nil {
+				return nil, fmt.Errorf("error evaluating HTTP security policy %s: %w", policy.Name, err)
+			}
+			if !ok {
+				continue
+			}
+
+			result.Matches = append(result.Matches, PoliciesHTTPEvaluationMatch{Kind: policiesHTTPSecurityKindName, Name: policy.Name, Applied: !applied})
+			if !applied {
+				applied = true
+				policy.apply(request, result)
+			}
+		}
+	}
+
+	if requestPolicies != nil {
+		applied := result.Terminated
+		for _, policy := range requestPolicies.Policies {
+			if policy == nil || !policy.Active {
+				continue
+			}
+
+			ok, err := policy.MatchCriteria.match(request)
+			if err != nil {
+				return nil, fmt.Errorf("error evaluating HTTP request policy %s: %w", policy.Name, err)
+			}
+			if !ok {
+				continue
+			}
+
+			result.Matches = append(result.Matches, PoliciesHTTPEvaluationMatch{Kind: policiesHTTPRequestKindName, Name: policy.Name, Applied: !applied})
+			if !applied {
+				applied = true
+				policy.apply(result)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// Evaluate evaluates a synthetic request against the HTTP request policies. See EvaluatePoliciesHTTP.
+func (p *PoliciesHTTPRequestModel) Evaluate(request PoliciesHTTPEvaluationRequest) (*PoliciesHTTPEvaluationResult, error) {
+	return EvaluatePoliciesHTTP(request, nil, p)
+}
+
+// Evaluate evaluates a synthetic request against the HTTP security policies. See EvaluatePoliciesHTTP.
+func (p *PoliciesHTTPSecurityModel) Evaluate(request PoliciesHTTPEvaluationRequest) (*PoliciesHTTPEvaluationResult, error) {
+	return EvaluatePoliciesHTTP(request, p, nil)
+}
+
+// * Actions
+
+func (p *PoliciesHTTPSecurityModelPolicy) apply(request PoliciesHTTPEvaluationRequest, result *PoliciesHTTPEvaluationResult) {
+	if p.RateLimitAction != nil {
+		result.Actions = append(result.Actions, PoliciesHTTPEvaluationAction{Policy: p.Name, Type: PoliciesHTTPEvaluationActionTypeRateLimit, RateLimit: p.RateLimitAction})
+	}
+
+	switch p.ConnectionAction {
+	case PoliciesHTTPConnectionActionALLOW:
+		result.Actions = append(result.Actions, PoliciesHTTPEvaluationAction{Policy: p.Name, Type: PoliciesHTTPEvaluationActionTypeConnectionAllow})
+	case PoliciesHTTPConnectionActionCLOSE:
+		result.Actions = append(result.Actions, PoliciesHTTPEvaluationAction{Policy: p.Name, Type: PoliciesHTTPEvaluationActionTypeConnectionClose})
+		result.Terminated = true
+		return
+	}
+
+	if p.RedirectToHTTPSAction != nil && request.Protocol != PoliciesHTTPProtocolHTTPS {
+		result.Actions = append(result.Actions, PoliciesHTTPEvaluationAction{Policy: p.Name, Type: PoliciesHTTPEvaluationActionTypeRedirectToHTTPS, RedirectToHTTPSPort: p.RedirectToHTTPSAction})
+		result.Terminated = true
+		return
+	}
+
+	if p.SendResponseAction != nil {
+		result.Actions = append(result.Actions, PoliciesHTTPEvaluationAction{Policy: p.Name, Type: PoliciesHTTPEvaluationActionTypeSendResponse, SendResponse: p.SendResponseAction})
+		result.Terminated = true
+	}
+}
+
+func (p *PoliciesHTTPRequestModelPolicy) apply(result *PoliciesHTTPEvaluationResult) {
+	if p.RedirectAction != nil {
+		result.Actions = append(result.Actions, PoliciesHTTPEvaluationAction{Policy: p.Name, Type: PoliciesHTTPEvaluationActionTypeRedirect, Redirect: p.RedirectAction})
+		result.Terminated = true
+		return
+	}
+
+	for _, header := range p.HeaderRewriteActions {
+		if header == nil {
+			continue
+		}
+		result.Actions = append(result.Actions, PoliciesHTTPEvaluationAction{Policy: p.Name, Type: PoliciesHTTPEvaluationActionTypeHeaderRewrite, HeaderRewrite: header})
+	}
+
+	if p.URLRewriteAction != nil {
+		result.Actions = append(result.Actions, PoliciesHTTPEvaluationAction{Policy: p.Name, Type: PoliciesHTTPEvaluationActionTypeURLRewrite, URLRewrite: p.URLRewriteAction})
+	}
+}
+
+// * Match criteria
+
+func (p PoliciesHTTPSecurityMatchCriteria) match(request PoliciesHTTPEvaluationRequest) (bool, error) {
+	return policiesHTTPMatchRequest(request, string(p.Protocol), p.ClientIPMatch, p.ServicePortMatch, p.MethodMatch, p.PathMatch, p.CookieMatch, p.HeaderMatch, p.QueryMatch)
+}
+
+func (p PoliciesHTTPRequestMatchCriteria) match(request PoliciesHTTPEvaluationRequest) (bool, error) {
+	return policiesHTTPMatchRequest(request, p.Protocol, p.ClientIPMatch, p.ServicePortMatch, p.MethodMatch, p.PathMatch, p.CookieMatch, p.HeaderMatch, p.QueryMatch)
+}
+
+// policiesHTTPMatchRequest returns true if the request matches all the criteria that are set.
+func policiesHTTPMatchRequest(request PoliciesHTTPEvaluationRequest, protocol string, clientIP *PoliciesHTTPClientIPMatch, servicePort *PoliciesHTTPServicePortMatch, method *PoliciesHTTPMethodMatch, path *PoliciesHTTPPathMatch, cookie *PoliciesHTTPCookieMatch, headers PoliciesHTTPHeadersMatch, query []string) (bool, error) {
+	if protocol != "" && protocol != string(request.Protocol) {
+		return false, nil
+	}
+
+	matchers := []func() (bool, error){
+		func() (bool, error) { return clientIP.match(request.ClientIP) },
+		func() (bool, error) { return servicePort.match(request.Port), nil },
+		func() (bool, error) { return method.match(request.Method), nil },
+		func() (bool, error) { return path.match(request.Path) },
+		func() (bool, error) { return cookie.match(request.Cookies) },
+		func() (bool, error) { return headers.match(request.Headers) },
+		func() (bool, error) { return policiesHTTPMatchQuery(query, request), nil },
+	}
+
+	for _, m := range matchers {
+		ok, err := m()
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// match returns true if the criteria is not set or if the client IP matches it.
+func (p *PoliciesHTTPClientIPMatch) match(clientIP string) (bool, error) {
+	if p == nil {
+		return true, nil
+	}
+
+	ip := net.ParseIP(clientIP)
+	found := false
+	for _, address := range p.Addresses {
+		ok, err := policiesHTTPMatchIP(ip, address)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			found = true
+			break
+		}
+	}
+
+	return policiesHTTPMatchIsIn(p.Criteria, found), nil
+}
+
+// match returns true if the criteria is not set or if the port matches it.
+func (p *PoliciesHTTPServicePortMatch) match(port int) bool {
+	if p == nil {
+		return true
+	}
+
+	return policiesHTTPMatchIsIn(p.Criteria, slices.Contains(p.Ports, port))
+}
+
+// match returns true if the criteria is not set or if the method matches it.
+func (p *PoliciesHTTPMethodMatch) match(method PoliciesHTTPMethod) bool {
+	if p == nil {
+		return true
+	}
+
+	return policiesHTTPMatchIsIn(p.Criteria, slices.Contains(p.Methods, string(method)))
+}
+
+// match returns true if the criteria is not set or if the path matches it.
+func (p *PoliciesHTTPPathMatch) match(path string) (bool, error) {
+	if p == nil {
+		return true, nil
+	}
+
+	return policiesHTTPMatchString(p.Criteria, p.MatchStrings, []string{path})
+}
+
+// match returns true if the criteria is not set or if the cookie matches it.
+func (p *PoliciesHTTPCookieMatch) match(cookies map[string]string) (bool, error) {
+	if p == nil {
+		return true, nil
+	}
+
+	value, ok := cookies[p.Name]
+	if !ok {
+		// * A missing cookie only matches the negative criteria
+		return policiesHTTPNegativeCriteria(p.Criteria), nil
+	}
+
+	return policiesHTTPMatchString(p.Criteria, []string{p.Value}, []string{value})
+}
+
+// match returns true if all the headers match.
+func (p PoliciesHTTPHeadersMatch) match(headers http.Header) (bool, error) {
+	for _, h := range p {
+		values := headers.Values(h.Name)
+
+		var (
+			ok  bool
+			err error
+		)
+
+		switch PoliciesHTTPMatchCriteriaCriteria(h.Criteria) {
+		case PoliciesHTTPMatchCriteriaCriteriaEXISTS:
+			ok = len(values) > 0
+		case PoliciesHTTPMatchCriteriaCriteriaDOESNOTEXIST:
+			ok = len(values) == 0
+		default:
+			if len(values) == 0 {
+				// * A missing header only matches the negative criteria
+				ok = policiesHTTPNegativeCriteria(h.Criteria)
+				break
+			}
+			ok, err = policiesHTTPMatchString(h.Criteria, h.Values, values)
+		}
+
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// policiesHTTPMatchQuery returns true if no query is set or if the query of the request
+// contains one of the key=value pairs.
+func policiesHTTPMatchQuery(query []string, request PoliciesHTTPEvaluationRequest) bool {
+	if len(query) == 0 {
+		return true
+	}
+
+	for _, q := range query {
+		key, value, _ := strings.Cut(q, "=")
+		if slices.Contains(request.Query[key], value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// policiesHTTPMatchIsIn applies the IS_IN / IS_NOT_IN criteria.
+func policiesHTTPMatchIsIn(criteria string, found bool) bool {
+	if PoliciesHTTPMatchCriteriaCriteria(criteria) == PoliciesHTTPMatchCriteriaCriteriaISNOTIN {
+		return !found
+	}
+	return found
+}
+
+// policiesHTTPNegativeCriteria returns true if the criteria is a negation (e.g. DOES_NOT_CONTAIN).
+func policiesHTTPNegativeCriteria(criteria string) bool {
+	switch PoliciesHTTPMatchCriteriaCriteria(criteria) {
+	case PoliciesHTTPMatchCriteriaCriteriaDOESNOTBEGINWITH,
+		PoliciesHTTPMatchCriteriaCriteriaDOESNOTCONTAIN,
+		PoliciesHTTPMatchCriteriaCriteriaDOESNOTENDWITH,
+		PoliciesHTTPMatchCriteriaCriteriaDOESNOTEQUAL,
+		PoliciesHTTPMatchCriteriaCriteriaREGEXDOESNOTMATCH,
+		PoliciesHTTPMatchCriteriaCriteriaDOESNOTEXIST:
+		return true
+	default:
+		return false
+	}
+}
+
+// policiesHTTPMatchString applies a string criteria. The positive criteria match if one of the
+// values matches one of the match strings, the negative criteria match if none of them does.
+func policiesHTTPMatchString(criteria string, matchStrings, values []string) (bool, error) {
+	var compare func(value, match string) (bool, error)
+
+	switch PoliciesHTTPMatchCriteriaCriteria(criteria) {
+	case PoliciesHTTPMatchCriteriaCriteriaBEGINSWITH, PoliciesHTTPMatchCriteriaCriteriaDOESNOTBEGINWITH:
+		compare = func(value, match string) (bool, error) { return strings.HasPrefix(value, match), nil }
+	case PoliciesHTTPMatchCriteriaCriteriaCONTAINS, PoliciesHTTPMatchCriteriaCriteriaDOESNOTCONTAIN:
+		compare = func(value, match string) (bool, error) { return strings.Contains(value, match), nil }
+	case PoliciesHTTPMatchCriteriaCriteriaENDSWITH, PoliciesHTTPMatchCriteriaCriteriaDOESNOTENDWITH:
+		compare = func(value, match string) (bool, error) { return strings.HasSuffix(value, match), nil }
+	case PoliciesHTTPMatchCriteriaCriteriaEQUALS, PoliciesHTTPMatchCriteriaCriteriaDOESNOTEQUAL:
+		compare = func(value, match string) (bool, error) { return value == match, nil }
+	case PoliciesHTTPMatchCriteriaCriteriaREGEXMATCH, PoliciesHTTPMatchCriteriaCriteriaREGEXDOESNOTMATCH:
+		compare = func(value, match string) (bool, error) {
+			re, err := regexp.Compile(match)
+			if err != nil {
+				return false, fmt.Errorf("regex %q has %w: %w", match, errors.ErrInvalidFormat, err)
+			}
+			return re.MatchString(value), nil
+		}
+	default:
+		return false, fmt.Errorf("criteria %s has %w", criteria, errors.ErrInvalidFormat)
+	}
+
+	found := false
+	for _, value := range values {
+		for _, match := range matchStrings {
+			ok, err := compare(value, match)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				found = true
+			}
+		}
+	}
+
+	if policiesHTTPNegativeCriteria(criteria) {
+		return !found, nil
+	}
+	return found, nil
+}
+
+// policiesHTTPMatchIP returns true if the IP address is the address, in the CIDR or in the
+// range (e.g. 192.168.0.1-192.168.0.10).
+func policiesHTTPMatchIP(ip net.IP, address string) (bool, error) {
+	if ip == nil {
+		return false, nil
+	}
+
+	switch {
+	case strings.Contains(address, "/"):
+		_, ipNet, err := net.ParseCIDR(address)
+		if err != nil {
+			return false, fmt.Errorf("address %s has %w", address, errors.ErrInvalidFormat)
+		}
+		return ipNet.Contains(ip), nil
+
+	case strings.Contains(address, "-"):
+		start, end, _ := strings.Cut(address, "-")
+		startIP, endIP := net.ParseIP(strings.TrimSpace(start)), net.ParseIP(strings.TrimSpace(end))
+		if startIP == nil || endIP == nil {
+			return false, fmt.Errorf("address %s has %w", address, errors.ErrInvalidFormat)
+		}
+		return ipInRange(ip, startIP, endIP), nil
+
+	default:
+		addressIP := net.ParseIP(address)
+		if addressIP == nil {
+			return false, fmt.Errorf("address %s has %w", address, errors.ErrInvalidFormat)
+		}
+		return addressIP.Equal(ip), nil
+	}
+}
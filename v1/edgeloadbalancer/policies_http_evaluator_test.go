@@ -0,0 +1,291 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgeloadbalancer
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/utils"
+	sdkerrors "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+)
+
+func TestEvaluatePoliciesHTTP(t *testing.T) {
+	security := &PoliciesHTTPSecurityModel{
+		Policies: []*PoliciesHTTPSecurityModelPolicy{
+			{
+				Name:   "inactive",
+				Active: false,
+				MatchCriteria: PoliciesHTTPSecurityMatchCriteria{
+					Protocol: PoliciesHTTPProtocolHTTP,
+				},
+				ConnectionAction: PoliciesHTTPConnectionActionCLOSE,
+			},
+			{
+				Name:   "block-admin",
+				Active: true,
+				MatchCriteria: PoliciesHTTPSecurityMatchCriteria{
+					ClientIPMatch: &PoliciesHTTPClientIPMatch{
+						Criteria:  string(PoliciesHTTPMatchCriteriaCriteriaISNOTIN),
+						Addresses: []string{"10.0.0.0/8", "192.168.0.1-192.168.0.10"},
+					},
+					PathMatch: &PoliciesHTTPPathMatch{
+						Criteria:     string(PoliciesHTTPMatchCriteriaCriteriaBEGINSWITH),
+						MatchStrings: []string{"/admin"},
+					},
+				},
+				SendResponseAction: &PoliciesHTTPActionSendResponse{
+					StatusCode:  403,
+					ContentType: "text/plain",
+					Content:     "Zm9yYmlkZGVu",
+				},
+			},
+			{
+				Name:   "rate-limit-api",
+				Active: true,
+				MatchCriteria: PoliciesHTTPSecurityMatchCriteria{
+					PathMatch: &PoliciesHTTPPathMatch{
+						Criteria:     string(PoliciesHTTPMatchCriteriaCriteriaREGEXMATCH),
+						MatchStrings: []string{"^/api/v[0-9]+/"},
+					},
+				},
+				RateLimitAction: &PoliciesHTTPActionRateLimit{Count: 100, Period: 60},
+			},
+			{
+				Name:   "https-only",
+				Active: true,
+				MatchCriteria: PoliciesHTTPSecurityMatchCriteria{
+					Protocol: PoliciesHTTPProtocolHTTP,
+				},
+				RedirectToHTTPSAction: utils.ToPTR(443),
+			},
+		},
+	}
+
+	requestPolicies := &PoliciesHTTPRequestModel{
+		Policies: []*PoliciesHTTPRequestModelPolicy{
+			{
+				Name:   "old-api",
+				Active: true,
+				MatchCriteria: PoliciesHTTPRequestMatchCriteria{
+					PathMatch: &PoliciesHTTPPathMatch{
+						Criteria:     string(PoliciesHTTPMatchCriteriaCriteriaBEGINSWITH),
+						MatchStrings: []string{"/api/v1/"},
+					},
+					MethodMatch: &PoliciesHTTPMethodMatch{
+						Criteria: string(PoliciesHTTPMatchCriteriaCriteriaISIN),
+						Methods:  []string{string(PoliciesHTTPMethodGET)},
+					},
+				},
+				RedirectAction: &PoliciesHTTPActionRedirect{
+					Port:       utils.ToPTR(443),
+					Protocol:   string(PoliciesHTTPProtocolHTTPS),
+					Path:       "/api/v2/",
+					StatusCode: 301,
+				},
+			},
+			{
+				Name:   "tenant-header",
+				Active: true,
+				MatchCriteria: PoliciesHTTPRequestMatchCriteria{
+					HeaderMatch: PoliciesHTTPHeadersMatch{
+						{
+							Criteria: string(PoliciesHTTPMatchCriteriaCriteriaEXISTS),
+							Name:     "X-Tenant",
+						},
+					},
+					CookieMatch: &PoliciesHTTPCookieMatch{
+						Criteria: string(PoliciesHTTPMatchCriteriaCriteriaDOESNOTEQUAL),
+						Name:     "beta",
+						Value:    "true",
+					},
+					QueryMatch: []string{"debug=1"},
+				},
+				HeaderRewriteActions: PoliciesHTTPActionHeadersRewrite{
+					{Action: string(PoliciesHTTPActionHeaderRewriteActionREMOVE), Name: "X-Debug"},
+				},
+				URLRewriteAction: &PoliciesHTTPActionURLRewrite{HostHeader: "backend", Path: "/debug"},
+			},
+			{
+				Name:   "catch-all",
+				Active: true,
+				MatchCriteria: PoliciesHTTPRequestMatchCriteria{
+					ServicePortMatch: &PoliciesHTTPServicePortMatch{
+						Criteria: string(PoliciesHTTPMatchCriteriaCriteriaISIN),
+						Ports:    []int{443},
+					},
+				},
+				HeaderRewriteActions: PoliciesHTTPActionHeadersRewrite{
+					{Action: string(PoliciesHTTPActionHeaderRewriteActionADD), Name: "X-Forwarded-Proto", Value: "https"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name               string
+		request            PoliciesHTTPEvaluationRequest
+		expectedMatches    []PoliciesHTTPEvaluationMatch
+		expectedActions    []PoliciesHTTPEvaluationActionType
+		expectedTerminated bool
+		expectedErr        bool
+		err                error
+	}{
+		{
+			name: "send-response-admin-from-outside",
+			request: PoliciesHTTPEvaluationRequest{
+				ClientIP: "8.8.8.8",
+				Port:     443,
+				Protocol: PoliciesHTTPProtocolHTTPS,
+				Method:   PoliciesHTTPMethodGET,
+				Path:     "/admin/users",
+			},
+			expectedMatches: []PoliciesHTTPEvaluationMatch{
+				{Kind: "HTTP security", Name: "block-admin", Applied: true},
+				{Kind: "HTTP request", Name: "catch-all", Applied: false},
+			},
+			expectedActions:    []PoliciesHTTPEvaluationActionType{PoliciesHTTPEvaluationActionTypeSendResponse},
+			expectedTerminated: true,
+		},
+		{
+			name: "admin-from-range",
+			request: PoliciesHTTPEvaluationRequest{
+				ClientIP: "192.168.0.5",
+				Port:     443,
+				Protocol: PoliciesHTTPProtocolHTTPS,
+				Method:   PoliciesHTTPMethodGET,
+				Path:     "/admin/users",
+			},
+			expectedMatches: []PoliciesHTTPEvaluationMatch{
+				{Kind: "HTTP request", Name: "catch-all", Applied: true},
+			},
+			expectedActions: []PoliciesHTTPEvaluationActionType{PoliciesHTTPEvaluationActionTypeHeaderRewrite},
+		},
+		{
+			name: "rate-limit-and-redirect",
+			request: PoliciesHTTPEvaluationRequest{
+				ClientIP: "10.0.0.1",
+				Port:     443,
+				Protocol: PoliciesHTTPProtocolHTTPS,
+				Method:   PoliciesHTTPMethodGET,
+				Path:     "/api/v1/items",
+			},
+			expectedMatches: []PoliciesHTTPEvaluationMatch{
+				{Kind: "HTTP security", Name: "rate-limit-api", Applied: true},
+				{Kind: "HTTP request", Name: "old-api", Applied: true},
+				{Kind: "HTTP request", Name: "catch-all", Applied: false},
+			},
+			expectedActions:    []PoliciesHTTPEvaluationActionType{PoliciesHTTPEvaluationActionTypeRateLimit, PoliciesHTTPEvaluationActionTypeRedirect},
+			expectedTerminated: true,
+		},
+		{
+			name: "redirect-to-https",
+			request: PoliciesHTTPEvaluationRequest{
+				ClientIP: "10.0.0.1",
+				Port:     80,
+				Protocol: PoliciesHTTPProtocolHTTP,
+				Method:   PoliciesHTTPMethodGET,
+				Path:     "/",
+			},
+			expectedMatches: []PoliciesHTTPEvaluationMatch{
+				{Kind: "HTTP security", Name: "https-only", Applied: true},
+			},
+			expectedActions:    []PoliciesHTTPEvaluationActionType{PoliciesHTTPEvaluationActionTypeRedirectToHTTPS},
+			expectedTerminated: true,
+		},
+		{
+			name: "header-cookie-query-match",
+			request: PoliciesHTTPEvaluationRequest{
+				ClientIP: "10.0.0.1",
+				Port:     8443,
+				Protocol: PoliciesHTTPProtocolHTTPS,
+				Method:   PoliciesHTTPMethodPOST,
+				Path:     "/app",
+				Headers:  http.Header{"X-Tenant": []string{"orange"}},
+				Cookies:  map[string]string{"session": "abc"},
+				Query:    url.Values{"debug": []string{"1"}},
+			},
+			expectedMatches: []PoliciesHTTPEvaluationMatch{
+				{Kind: "HTTP request", Name: "tenant-header", Applied: true},
+			},
+			expectedActions: []PoliciesHTTPEvaluationActionType{PoliciesHTTPEvaluationActionTypeHeaderRewrite, PoliciesHTTPEvaluationActionTypeURLRewrite},
+		},
+		{
+			name: "cookie-does-not-match",
+			request: PoliciesHTTPEvaluationRequest{
+				ClientIP: "10.0.0.1",
+				Port:     8443,
+				Protocol: PoliciesHTTPProtocolHTTPS,
+				Method:   PoliciesHTTPMethodPOST,
+				Path:     "/app",
+				Headers:  http.Header{"X-Tenant": []string{"orange"}},
+				Cookies:  map[string]string{"beta": "true"},
+				Query:    url.Values{"debug": []string{"1"}},
+			},
+		},
+		{
+			name: "error-invalid-client-ip",
+			request: PoliciesHTTPEvaluationRequest{
+				ClientIP: "not-an-ip",
+			},
+			expectedErr: true,
+			err:         errors.New("Field validation for 'ClientIP'"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := EvaluatePoliciesHTTP(tc.request, security, requestPolicies)
+			if !tc.expectedErr {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedMatches, result.Matches)
+				var actions []PoliciesHTTPEvaluationActionType
+				for _, a := range result.Actions {
+					actions = append(actions, a.Type)
+				}
+				assert.Equal(t, tc.expectedActions, actions)
+				assert.Equal(t, tc.expectedTerminated, result.Terminated)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Nil(t, result)
+			if errors.Is(err, tc.err) {
+				return
+			}
+			assert.Contains(t, err.Error(), tc.err.Error())
+		})
+	}
+}
+
+func TestPoliciesHTTPRequestModel_Evaluate_InvalidRegex(t *testing.T) {
+	policies := &PoliciesHTTPRequestModel{
+		Policies: []*PoliciesHTTPRequestModelPolicy{
+			{
+				Name:   "invalid-regex",
+				Active: true,
+				MatchCriteria: PoliciesHTTPRequestMatchCriteria{
+					PathMatch: &PoliciesHTTPPathMatch{
+						Criteria:     string(PoliciesHTTPMatchCriteriaCriteriaREGEXMATCH),
+						MatchStrings: []string{"("},
+					},
+				},
+			},
+		},
+	}
+
+	result, err := policies.Evaluate(PoliciesHTTPEvaluationRequest{Path: "/"})
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidFormat)
+	assert.Nil(t, result)
+}
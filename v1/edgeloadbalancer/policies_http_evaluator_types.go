@@ -0,0 +1,89 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgeloadbalancer
+
+import (
+	"net/http"
+	"net/url"
+)
+
+type (
+	// PoliciesHTTPEvaluationActionType is the type of an action returned by the evaluator.
+	PoliciesHTTPEvaluationActionType string
+
+	// PoliciesHTTPEvaluationRequest is a synthetic HTTP request evaluated against the HTTP policies.
+	PoliciesHTTPEvaluationRequest struct {
+		// ClientIP is the IP address of the client
+		ClientIP string `validate:"omitempty,ipv4"`
+		// Port is the port of the virtual service receiving the request
+		Port int `validate:"omitempty,tcp_udp_port"`
+		// Protocol of the request (HTTP or HTTPS)
+		Protocol PoliciesHTTPProtocol `validate:"omitempty,oneof=HTTP HTTPS"`
+		// Method of the request (e.g. GET)
+		Method PoliciesHTTPMethod `validate:"omitempty"`
+		// Path of the request (e.g. /api/v1)
+		Path string `validate:"omitempty"`
+		// Headers of the request. Header names are case insensitive.
+		Headers http.Header
+		// Cookies of the request by name
+		Cookies map[string]string
+		// Query of the request
+		Query url.Values
+	}
+
+	// PoliciesHTTPEvaluationResult is the result of the evaluation of a request.
+	PoliciesHTTPEvaluationResult struct {
+		// Matches contains the active policies matching the request in the order of evaluation
+		Matches []PoliciesHTTPEvaluationMatch
+		// Actions contains the actions applied to the request in the order of execution
+		Actions []PoliciesHTTPEvaluationAction
+		// Terminated is true if an action ends the request before it reaches the pool
+		// (connection close, send response or redirect).
+		Terminated bool
+	}
+
+	// PoliciesHTTPEvaluationMatch is a policy matching the request.
+	PoliciesHTTPEvaluationMatch struct {
+		// Kind of the policy ("HTTP security" or "HTTP request")
+		Kind string
+		// Name of the policy
+		Name string
+		// Applied is true if the actions of the policy are applied. Only the first
+		// matching policy of each kind is applied.
+		Applied bool
+	}
+
+	// PoliciesHTTPEvaluationAction is an action applied to the request.
+	// Only the field corresponding to the Type is set.
+	PoliciesHTTPEvaluationAction struct {
+		// Policy is the name of the policy defining the action
+		Policy string
+		// Type of the action
+		Type PoliciesHTTPEvaluationActionType
+
+		Redirect            *PoliciesHTTPActionRedirect
+		HeaderRewrite       *PoliciesHTTPActionHeaderRewrite
+		URLRewrite          *PoliciesHTTPActionURLRewrite
+		RateLimit           *PoliciesHTTPActionRateLimit
+		SendResponse        *PoliciesHTTPActionSendResponse
+		RedirectToHTTPSPort *int
+	}
+)
+
+const (
+	PoliciesHTTPEvaluationActionTypeRedirect        PoliciesHTTPEvaluationActionType = "REDIRECT"
+	PoliciesHTTPEvaluationActionTypeHeaderRewrite   PoliciesHTTPEvaluationActionType = "HEADER_REWRITE"
+	PoliciesHTTPEvaluationActionTypeURLRewrite      PoliciesHTTPEvaluationActionType = "URL_REWRITE"
+	PoliciesHTTPEvaluationActionTypeRateLimit       PoliciesHTTPEvaluationActionType = "RATE_LIMIT"
+	PoliciesHTTPEvaluationActionTypeConnectionAllow PoliciesHTTPEvaluationActionType = "CONNECTION_ALLOW"
+	PoliciesHTTPEvaluationActionTypeConnectionClose PoliciesHTTPEvaluationActionType = "CONNECTION_CLOSE"
+	PoliciesHTTPEvaluationActionTypeSendResponse    PoliciesHTTPEvaluationActionType = "SEND_RESPONSE"
+	PoliciesHTTPEvaluationActionTypeRedirectToHTTPS PoliciesHTTPEvaluationActionType = "REDIRECT_TO_HTTPS"
+)
@@ -0,0 +1,268 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgeloadbalancer
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+)
+
+// policiesHTTPLintEntry contains the parts of a policy checked by all the kinds of policies.
+type policiesHTTPLintEntry struct {
+	name        string
+	active      bool
+	criteria    any
+	protocol    string
+	servicePort *PoliciesHTTPServicePortMatch
+}
+
+// Lint checks the HTTP request policies against the virtual service without calling Cloud Director.
+// The virtual service is optional, the checks on the service ports and the protocol are skipped if nil.
+func (p *PoliciesHTTPRequestModel) Lint(virtualService *VirtualServiceModel) PoliciesHTTPLintFindings {
+	kind := policiesHTTPRequestKindName
+
+	entries := make([]policiesHTTPLintEntry, 0, len(p.Policies))
+	findings := PoliciesHTTPLintFindings{}
+
+	for _, policy := range p.Policies {
+		if policy == nil {
+			continue
+		}
+
+		entries = append(entries, policiesHTTPLintEntry{
+			name:        policy.Name,
+			active:      policy.Active,
+			criteria:    policy.MatchCriteria,
+			protocol:    policy.MatchCriteria.Protocol,
+			servicePort: policy.MatchCriteria.ServicePortMatch,
+		})
+
+		if policy.RedirectAction != nil && (len(policy.HeaderRewriteActions) > 0 || policy.URLRewriteAction != nil) {
+			findings.add(PoliciesHTTPLintSeverityError, kind, policy.Name, "a redirect action cannot be configured in combination with other actions")
+		}
+
+		if policy.RedirectAction == nil && len(policy.HeaderRewriteActions) == 0 && policy.URLRewriteAction == nil {
+			findings.add(PoliciesHTTPLintSeverityWarning, kind, policy.Name, "the policy has no action")
+		}
+	}
+
+	return append(lintPoliciesHTTPEntries(kind, virtualService, entries), findings...)
+}
+
+// Lint checks the HTTP response policies against the virtual service without calling Cloud Director.
+// The virtual service is optional, the checks on the service ports and the protocol are skipped if nil.
+func (p *PoliciesHTTPResponseModel) Lint(virtualService *VirtualServiceModel) PoliciesHTTPLintFindings {
+	kind := policiesHTTPResponseKindName
+
+	entries := make([]policiesHTTPLintEntry, 0, len(p.Policies))
+	findings := PoliciesHTTPLintFindings{}
+
+	for _, policy := range p.Policies {
+		if policy == nil {
+			continue
+		}
+
+		entries = append(entries, policiesHTTPLintEntry{
+			name:        policy.Name,
+			active:      policy.Active,
+			criteria:    policy.MatchCriteria,
+			protocol:    policy.MatchCriteria.Protocol,
+			servicePort: policy.MatchCriteria.ServicePortMatch,
+		})
+
+		if len(policy.HeaderRewriteActions) == 0 && policy.LocationRewriteAction == nil {
+			findings.add(PoliciesHTTPLintSeverityWarning, kind, policy.Name, "the policy has no action")
+		}
+	}
+
+	return append(lintPoliciesHTTPEntries(kind, virtualService, entries), findings...)
+}
+
+// Lint checks the HTTP security policies against the virtual service without calling Cloud Director.
+// The virtual service is optional, the checks on the service ports and the protocol are skipped if nil.
+func (p *PoliciesHTTPSecurityModel) Lint(virtualService *VirtualServiceModel) PoliciesHTTPLintFindings {
+	kind := policiesHTTPSecurityKindName
+
+	entries := make([]policiesHTTPLintEntry, 0, len(p.Policies))
+	findings := PoliciesHTTPLintFindings{}
+
+	for _, policy := range p.Policies {
+		if policy == nil {
+			continue
+		}
+
+		entries = append(entries, policiesHTTPLintEntry{
+			name:        policy.Name,
+			active:      policy.Active,
+			criteria:    policy.MatchCriteria,
+			protocol:    string(policy.MatchCriteria.Protocol),
+			servicePort: policy.MatchCriteria.ServicePortMatch,
+		})
+
+		if rl := policy.RateLimitAction; rl != nil {
+			actions := 0
+			if rl.RedirectAction != nil {
+				actions++
+			}
+			if rl.CloseConnectionAction != nil && *rl.CloseConnectionAction {
+				actions++
+			}
+			if rl.LocalResponseAction != nil {
+				actions++
+			}
+			if actions > 1 {
+				findings.add(PoliciesHTTPLintSeverityError, kind, policy.Name, "the rate limit redirect, close connection and local response actions cannot be configured together")
+			}
+		}
+
+		if policy.ConnectionAction == PoliciesHTTPConnectionActionCLOSE && (policy.RedirectToHTTPSAction != nil || policy.SendResponseAction != nil) {
+			findings.add(PoliciesHTTPLintSeverityWarning, kind, policy.Name, "the connection is closed, the redirect to HTTPS and send response actions are never applied")
+		}
+
+		if policy.RedirectToHTTPSAction != nil && virtualService != nil && !virtualServiceExposesPort(virtualService, *policy.RedirectToHTTPSAction) {
+			findings.add(PoliciesHTTPLintSeverityWarning, kind, policy.Name, fmt.Sprintf("the redirect to HTTPS uses the port %d which is not exposed by the virtual service", *policy.RedirectToHTTPSAction))
+		}
+
+		if policy.ConnectionAction == "" && policy.RateLimitAction == nil && policy.RedirectToHTTPSAction == nil && policy.SendResponseAction == nil {
+			findings.add(PoliciesHTTPLintSeverityWarning, kind, policy.Name, "the policy has no action")
+		}
+	}
+
+	return append(lintPoliciesHTTPEntries(kind, virtualService, entries), findings...)
+}
+
+// HasErrors returns true if one of the findings is an error.
+func (f PoliciesHTTPLintFindings) HasErrors() bool {
+	for _, finding := range f {
+		if finding.Severity == PoliciesHTTPLintSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Err returns an error listing the findings with the error severity, or nil if there is none.
+func (f PoliciesHTTPLintFindings) Err() error {
+	var reasons []string
+	for _, finding := range f {
+		if finding.Severity != PoliciesHTTPLintSeverityError {
+			continue
+		}
+		reasons = append(reasons, finding.String())
+	}
+
+	if len(reasons) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("policies have %w: %s", errors.ErrInvalidFormat, strings.Join(reasons, "; "))
+}
+
+// String returns a human readable representation of the finding.
+func (f PoliciesHTTPLintFinding) String() string {
+	if f.Policy == "" {
+		return fmt.Sprintf("%s %s policies: %s", f.Severity, f.Kind, f.Reason)
+	}
+	return fmt.Sprintf("%s %s policy %s: %s", f.Severity, f.Kind, f.Policy, f.Reason)
+}
+
+func (f *PoliciesHTTPLintFindings) add(severity PoliciesHTTPLintSeverity, kind, policy, reason string) {
+	*f = append(*f, PoliciesHTTPLintFinding{
+		Severity: severity,
+		Kind:     kind,
+		Policy:   policy,
+		Reason:   reason,
+	})
+}
+
+// lintPoliciesHTTPEntries runs the checks common to all the kinds of policies.
+func lintPoliciesHTTPEntries(kind string, virtualService *VirtualServiceModel, entries []policiesHTTPLintEntry) PoliciesHTTPLintFindings {
+	findings := PoliciesHTTPLintFindings{}
+
+	if virtualService != nil {
+		switch virtualService.ApplicationProfile {
+		case VirtualServiceApplicationProfileHTTP, VirtualServiceApplicationProfileHTTPS:
+		default:
+			if len(entries) > 0 {
+				findings.add(PoliciesHTTPLintSeverityError, kind, "", fmt.Sprintf("HTTP policies require an HTTP or HTTPS virtual service (application profile is %s)", virtualService.ApplicationProfile))
+			}
+		}
+	}
+
+	seen := map[string]bool{}
+
+	for i, entry := range entries {
+		if seen[entry.name] {
+			findings.add(PoliciesHTTPLintSeverityError, kind, entry.name, "the name is used by several policies")
+		}
+		seen[entry.name] = true
+
+		if !entry.active {
+			continue
+		}
+
+		// * Shadowed by an earlier policy matching all the requests or matching the same requests
+		for _, previous := range entries[:i] {
+			if !previous.active {
+				continue
+			}
+
+			if reflect.ValueOf(previous.criteria).IsZero() {
+				findings.add(PoliciesHTTPLintSeverityWarning, kind, entry.name, fmt.Sprintf("the policy is unreachable, the policy %s matches all the requests", previous.name))
+				break
+			}
+
+			if reflect.DeepEqual(previous.criteria, entry.criteria) {
+				findings.add(PoliciesHTTPLintSeverityWarning, kind, entry.name, fmt.Sprintf("the policy is unreachable, the policy %s has the same match criteria", previous.name))
+				break
+			}
+		}
+
+		if virtualService == nil {
+			continue
+		}
+
+		if entry.protocol == string(PoliciesHTTPProtocolHTTPS) && virtualService.ApplicationProfile == VirtualServiceApplicationProfileHTTP {
+			findings.add(PoliciesHTTPLintSeverityWarning, kind, entry.name, "the policy matches the HTTPS protocol but the virtual service only serves HTTP")
+		}
+
+		if entry.servicePort != nil {
+			for _, port := range entry.servicePort.Ports {
+				if !virtualServiceExposesPort(virtualService, port) {
+					findings.add(PoliciesHTTPLintSeverityWarning, kind, entry.name, fmt.Sprintf("the service port %d is not exposed by the virtual service", port))
+				}
+			}
+		}
+	}
+
+	return findings
+}
+
+// virtualServiceExposesPort returns true if the port is one of the service ports of the virtual service.
+func virtualServiceExposesPort(virtualService *VirtualServiceModel, port int) bool {
+	for _, sp := range virtualService.ServicePorts {
+		if sp.Start == nil {
+			continue
+		}
+
+		end := *sp.Start
+		if sp.End != nil {
+			end = *sp.End
+		}
+
+		if port >= *sp.Start && port <= end {
+			return true
+		}
+	}
+	return false
+}
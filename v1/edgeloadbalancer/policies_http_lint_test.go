@@ -0,0 +1,350 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgeloadbalancer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/utils"
+	sdkerrors "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+)
+
+func testLintVirtualService(profile VirtualServiceModelApplicationProfile) *VirtualServiceModel {
+	return &VirtualServiceModel{
+		ApplicationProfile: profile,
+		ServicePorts: []VirtualServiceModelServicePort{
+			{Start: utils.ToPTR(80)},
+			{Start: utils.ToPTR(8080), End: utils.ToPTR(8090)},
+		},
+	}
+}
+
+func TestPoliciesHTTPRequestModel_Lint(t *testing.T) {
+	redirect := &PoliciesHTTPActionRedirect{Port: utils.ToPTR(443), Protocol: "HTTPS", StatusCode: 301}
+	pathMatch := &PoliciesHTTPPathMatch{Criteria: "BEGINS_WITH", MatchStrings: []string{"/api"}}
+
+	tests := []struct {
+		name             string
+		virtualService   *VirtualServiceModel
+		policies         []*PoliciesHTTPRequestModelPolicy
+		expectedFindings PoliciesHTTPLintFindings
+	}{
+		{
+			name:           testSuccess,
+			virtualService: testLintVirtualService(VirtualServiceApplicationProfileHTTP),
+			policies: []*PoliciesHTTPRequestModelPolicy{
+				{Name: "a", Active: true, MatchCriteria: PoliciesHTTPRequestMatchCriteria{PathMatch: pathMatch}, RedirectAction: redirect},
+				{Name: "b", Active: true, MatchCriteria: PoliciesHTTPRequestMatchCriteria{ServicePortMatch: &PoliciesHTTPServicePortMatch{Criteria: "IS_IN", Ports: []int{80, 8085}}}, URLRewriteAction: &PoliciesHTTPActionURLRewrite{HostHeader: "h", Path: "/"}},
+			},
+			expectedFindings: PoliciesHTTPLintFindings{},
+		},
+		{
+			name:           "redirect-combined",
+			virtualService: testLintVirtualService(VirtualServiceApplicationProfileHTTP),
+			policies: []*PoliciesHTTPRequestModelPolicy{
+				{Name: "a", Active: true, MatchCriteria: PoliciesHTTPRequestMatchCriteria{PathMatch: pathMatch}, RedirectAction: redirect, URLRewriteAction: &PoliciesHTTPActionURLRewrite{HostHeader: "h", Path: "/"}},
+			},
+			expectedFindings: PoliciesHTTPLintFindings{
+				{Severity: PoliciesHTTPLintSeverityError, Kind: "HTTP request", Policy: "a", Reason: "a redirect action cannot be configured in combination with other actions"},
+			},
+		},
+		{
+			name:           "shadowed-by-catch-all",
+			virtualService: testLintVirtualService(VirtualServiceApplicationProfileHTTP),
+			policies: []*PoliciesHTTPRequestModelPolicy{
+				{Name: "catch-all", Active: true, RedirectAction: redirect},
+				{Name: "inactive", Active: false, MatchCriteria: PoliciesHTTPRequestMatchCriteria{PathMatch: pathMatch}, RedirectAction: redirect},
+				{Name: "api", Active: true, MatchCriteria: PoliciesHTTPRequestMatchCriteria{PathMatch: pathMatch}, RedirectAction: redirect},
+			},
+			expectedFindings: PoliciesHTTPLintFindings{
+				{Severity: PoliciesHTTPLintSeverityWarning, Kind: "HTTP request", Policy: "api", Reason: "the policy is unreachable, the policy catch-all matches all the requests"},
+			},
+		},
+		{
+			name:           "shadowed-by-same-criteria",
+			virtualService: testLintVirtualService(VirtualServiceApplicationProfileHTTP),
+			policies: []*PoliciesHTTPRequestModelPolicy{
+				{Name: "a", Active: true, MatchCriteria: PoliciesHTTPRequestMatchCriteria{PathMatch: pathMatch}, RedirectAction: redirect},
+				{Name: "b", Active: true, MatchCriteria: PoliciesHTTPRequestMatchCriteria{PathMatch: &PoliciesHTTPPathMatch{Criteria: "BEGINS_WITH", MatchStrings: []string{"/api"}}}, RedirectAction: redirect},
+			},
+			expectedFindings: PoliciesHTTPLintFindings{
+				{Severity: PoliciesHTTPLintSeverityWarning, Kind: "HTTP request", Policy: "b", Reason: "the policy is unreachable, the policy a has the same match criteria"},
+			},
+		},
+		{
+			name:           "port-not-exposed-https-and-duplicate-name",
+			virtualService: testLintVirtualService(VirtualServiceApplicationProfileHTTP),
+			policies: []*PoliciesHTTPRequestModelPolicy{
+				{Name: "a", Active: true, MatchCriteria: PoliciesHTTPRequestMatchCriteria{Protocol: "HTTPS", ServicePortMatch: &PoliciesHTTPServicePortMatch{Criteria: "IS_IN", Ports: []int{443}}}, RedirectAction: redirect},
+				{Name: "a", Active: true, MatchCriteria: PoliciesHTTPRequestMatchCriteria{PathMatch: pathMatch}},
+			},
+			expectedFindings: PoliciesHTTPLintFindings{
+				{Severity: PoliciesHTTPLintSeverityWarning, Kind: "HTTP request", Policy: "a", Reason: "the policy matches the HTTPS protocol but the virtual service only serves HTTP"},
+				{Severity: PoliciesHTTPLintSeverityWarning, Kind: "HTTP request", Policy: "a", Reason: "the service port 443 is not exposed by the virtual service"},
+				{Severity: PoliciesHTTPLintSeverityError, Kind: "HTTP request", Policy: "a", Reason: "the name is used by several policies"},
+				{Severity: PoliciesHTTPLintSeverityWarning, Kind: "HTTP request", Policy: "a", Reason: "the policy has no action"},
+			},
+		},
+		{
+			name:           "l4-virtual-service",
+			virtualService: testLintVirtualService(VirtualServiceApplicationProfileL4TCP),
+			policies: []*PoliciesHTTPRequestModelPolicy{
+				{Name: "a", Active: true, MatchCriteria: PoliciesHTTPRequestMatchCriteria{PathMatch: pathMatch}, RedirectAction: redirect},
+			},
+			expectedFindings: PoliciesHTTPLintFindings{
+				{Severity: PoliciesHTTPLintSeverityError, Kind: "HTTP request", Reason: "HTTP policies require an HTTP or HTTPS virtual service (application profile is L4_TCP)"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			findings := (&PoliciesHTTPRequestModel{Policies: tc.policies}).Lint(tc.virtualService)
+			assert.Equal(t, tc.expectedFindings, findings)
+		})
+	}
+}
+
+func TestPoliciesHTTPSecurityModel_Lint(t *testing.T) {
+	policies := &PoliciesHTTPSecurityModel{
+		Policies: []*PoliciesHTTPSecurityModelPolicy{
+			{
+				Name:   "rate-limit",
+				Active: true,
+				MatchCriteria: PoliciesHTTPSecurityMatchCriteria{
+					PathMatch: &PoliciesHTTPPathMatch{Criteria: "BEGINS_WITH", MatchStrings: []string{"/api"}},
+				},
+				RateLimitAction: &PoliciesHTTPActionRateLimit{
+					Count:                 10,
+					Period:                1,
+					CloseConnectionAction: utils.ToPTR(true),
+					LocalResponseAction:   &PoliciesHTTPActionSendResponse{StatusCode: 429, ContentType: "text/plain", Content: "dG9vIG1hbnk="},
+				},
+			},
+			{
+				Name:                  "https",
+				Active:                true,
+				MatchCriteria:         PoliciesHTTPSecurityMatchCriteria{Protocol: PoliciesHTTPProtocolHTTP},
+				RedirectToHTTPSAction: utils.ToPTR(443),
+			},
+		},
+	}
+
+	findings := policies.Lint(testLintVirtualService(VirtualServiceApplicationProfileHTTP))
+	assert.Equal(t, PoliciesHTTPLintFindings{
+		{Severity: PoliciesHTTPLintSeverityError, Kind: "HTTP security", Policy: "rate-limit", Reason: "the rate limit redirect, close connection and local response actions cannot be configured together"},
+		{Severity: PoliciesHTTPLintSeverityWarning, Kind: "HTTP security", Policy: "https", Reason: "the redirect to HTTPS uses the port 443 which is not exposed by the virtual service"},
+	}, findings)
+	assert.True(t, findings.HasErrors())
+	assert.ErrorIs(t, findings.Err(), sdkerrors.ErrInvalidFormat)
+}
+
+func TestPoliciesHTTPResponseModel_Lint(t *testing.T) {
+	policies := &PoliciesHTTPResponseModel{
+		Policies: []*PoliciesHTTPResponseModelPolicy{
+			{Name: "a", Active: true},
+		},
+	}
+
+	findings := policies.Lint(nil)
+	assert.Equal(t, PoliciesHTTPLintFindings{
+		{Severity: PoliciesHTTPLintSeverityWarning, Kind: "HTTP response", Policy: "a", Reason: "the policy has no action"},
+	}, findings)
+	assert.False(t, findings.HasErrors())
+	assert.NoError(t, findings.Err())
+}
+
+func TestClient_UpdatePoliciesHTTPRequest_Lint(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientFake(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	virtualServiceID := urn.LoadBalancerVirtualService.String() + uuid.New().String()
+
+	clientCAV.EXPECT().Refresh().Return(nil)
+	clientCAV.EXPECT().GetAlbVirtualServiceById(virtualServiceID).Return(&govcd.NsxtAlbVirtualService{
+		NsxtAlbVirtualService: &govcdtypes.NsxtAlbVirtualService{
+			ID:                 virtualServiceID,
+			ApplicationProfile: govcdtypes.NsxtAlbVirtualServiceApplicationProfile{Type: "HTTP"},
+		},
+	}, nil)
+
+	defer func(f func(fakeVirtualServiceClient, *govcdtypes.AlbVsHttpRequestRules) (*govcdtypes.AlbVsHttpRequestRules, error)) {
+		updatePoliciesHTTPRequest = f
+	}(updatePoliciesHTTPRequest)
+
+	updated := false
+	updatePoliciesHTTPRequest = func(_ fakeVirtualServiceClient, v *govcdtypes.AlbVsHttpRequestRules) (*govcdtypes.AlbVsHttpRequestRules, error) {
+		updated = true
+		return v, nil
+	}
+
+	redirect := &PoliciesHTTPActionRedirect{Port: utils.ToPTR(443), Protocol: "HTTPS", StatusCode: 301}
+
+	policies, err := c.UpdatePoliciesHTTPRequest(context.Background(), &PoliciesHTTPRequestModel{
+		VirtualServiceID: virtualServiceID,
+		Policies: []*PoliciesHTTPRequestModelPolicy{
+			{Name: "a", Active: true, MatchCriteria: PoliciesHTTPRequestMatchCriteria{Protocol: "HTTP"}, RedirectAction: redirect, URLRewriteAction: &PoliciesHTTPActionURLRewrite{HostHeader: "h", Path: "/"}},
+		},
+	}, PoliciesHTTPUpdateOptions{Lint: true})
+
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidFormat)
+	assert.Contains(t, err.Error(), "a redirect action cannot be configured in combination with other actions")
+	assert.Nil(t, policies)
+	assert.False(t, updated)
+}
+
+func TestClient_PolicyHTTP_Lint(t *testing.T) {
+	virtualServiceID := urn.LoadBalancerVirtualService.String() + uuid.New().String()
+
+	redirect := &PoliciesHTTPActionRedirect{Port: utils.ToPTR(443), Protocol: "HTTPS", StatusCode: 301}
+	invalidPolicy := testPolicyHTTPRequest("d")
+	invalidPolicy.RedirectAction = redirect
+	invalidPolicy.URLRewriteAction = &PoliciesHTTPActionURLRewrite{HostHeader: "h", Path: "/"}
+
+	tests := []struct {
+		name               string
+		applicationProfile string
+		run                func(c Client) (any, error)
+		expectedErr        bool
+		err                string
+	}{
+		{
+			name:               "success-add",
+			applicationProfile: "HTTP",
+			run: func(c Client) (any, error) {
+				return c.AddPolicyHTTPRequest(context.Background(), virtualServiceID, testPolicyHTTPRequest("d"), PoliciesHTTPPolicyPosition{}, PoliciesHTTPUpdateOptions{Lint: true})
+			},
+		},
+		{
+			name:               "error-add",
+			applicationProfile: "HTTP",
+			run: func(c Client) (any, error) {
+				return c.AddPolicyHTTPRequest(context.Background(), virtualServiceID, invalidPolicy, PoliciesHTTPPolicyPosition{}, PoliciesHTTPUpdateOptions{Lint: true})
+			},
+			expectedErr: true,
+			err:         "a redirect action cannot be configured in combination with other actions",
+		},
+		{
+			name:               "error-update-by-name",
+			applicationProfile: "HTTP",
+			run: func(c Client) (any, error) {
+				return c.UpdatePolicyHTTPRequestByName(context.Background(), virtualServiceID, "b", invalidPolicy, PoliciesHTTPUpdateOptions{Lint: true})
+			},
+			expectedErr: true,
+			err:         "a redirect action cannot be configured in combination with other actions",
+		},
+		{
+			name:               "success-move-without-lint",
+			applicationProfile: "L4",
+			run: func(c Client) (any, error) {
+				return c.MovePolicyHTTPRequest(context.Background(), virtualServiceID, "a", PoliciesHTTPPolicyPosition{})
+			},
+		},
+		{
+			name:               "error-move",
+			applicationProfile: "L4",
+			run: func(c Client) (any, error) {
+				return c.MovePolicyHTTPRequest(context.Background(), virtualServiceID, "a", PoliciesHTTPPolicyPosition{}, PoliciesHTTPUpdateOptions{Lint: true})
+			},
+			expectedErr: true,
+			err:         "HTTP policies require an HTTP or HTTPS virtual service",
+		},
+		{
+			name:               "error-add-response",
+			applicationProfile: "L4",
+			run: func(c Client) (any, error) {
+				return c.AddPolicyHTTPResponse(context.Background(), virtualServiceID, &PoliciesHTTPResponseModelPolicy{Name: "a", Active: true, MatchCriteria: PoliciesHTTPResponseMatchCriteria{Protocol: string(PoliciesHTTPProtocolHTTP)}}, PoliciesHTTPPolicyPosition{}, PoliciesHTTPUpdateOptions{Lint: true})
+			},
+			expectedErr: true,
+			err:         "HTTP response",
+		},
+		{
+			name:               "error-add-security",
+			applicationProfile: "L4",
+			run: func(c Client) (any, error) {
+				return c.AddPolicyHTTPSecurity(context.Background(), virtualServiceID, &PoliciesHTTPSecurityModelPolicy{Name: "a", Active: true, MatchCriteria: PoliciesHTTPSecurityMatchCriteria{Protocol: PoliciesHTTPProtocolHTTP}}, PoliciesHTTPPolicyPosition{}, PoliciesHTTPUpdateOptions{Lint: true})
+			},
+			expectedErr: true,
+			err:         "HTTP security",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Mock controller.
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock client for cloudavenue.
+			clientCAV := NewMockclientFake(ctrl)
+
+			c, _ := NewFakeClient(clientCAV)
+
+			clientCAV.EXPECT().Refresh().Return(nil)
+			clientCAV.EXPECT().GetAlbVirtualServiceById(virtualServiceID).Return(&govcd.NsxtAlbVirtualService{
+				NsxtAlbVirtualService: &govcdtypes.NsxtAlbVirtualService{
+					ID:                 virtualServiceID,
+					ApplicationProfile: govcdtypes.NsxtAlbVirtualServiceApplicationProfile{Type: tc.applicationProfile},
+				},
+			}, nil)
+
+			store := &testPoliciesHTTPRequestStore{rules: testPoliciesHTTPRequestRules("a", "b", "c")}
+			store.install(t)
+
+			defer func(get func(fakeVirtualServiceClient) ([]*govcdtypes.AlbVsHttpResponseRule, error), update func(fakeVirtualServiceClient, *govcdtypes.AlbVsHttpResponseRules) (*govcdtypes.AlbVsHttpResponseRules, error)) {
+				getPoliciesHTTPResponse, updatePoliciesHTTPResponse = get, update
+			}(getPoliciesHTTPResponse, updatePoliciesHTTPResponse)
+			defer func(get func(fakeVirtualServiceClient) ([]*govcdtypes.AlbVsHttpSecurityRule, error), update func(fakeVirtualServiceClient, *govcdtypes.AlbVsHttpSecurityRules) (*govcdtypes.AlbVsHttpSecurityRules, error)) {
+				getPoliciesHTTPSecurity, updatePoliciesHTTPSecurity = get, update
+			}(getPoliciesHTTPSecurity, updatePoliciesHTTPSecurity)
+
+			getPoliciesHTTPResponse = func(_ fakeVirtualServiceClient) ([]*govcdtypes.AlbVsHttpResponseRule, error) {
+				return nil, nil
+			}
+			updatePoliciesHTTPResponse = func(_ fakeVirtualServiceClient, v *govcdtypes.AlbVsHttpResponseRules) (*govcdtypes.AlbVsHttpResponseRules, error) {
+				store.updated = true
+				return v, nil
+			}
+			getPoliciesHTTPSecurity = func(_ fakeVirtualServiceClient) ([]*govcdtypes.AlbVsHttpSecurityRule, error) {
+				return nil, nil
+			}
+			updatePoliciesHTTPSecurity = func(_ fakeVirtualServiceClient, v *govcdtypes.AlbVsHttpSecurityRules) (*govcdtypes.AlbVsHttpSecurityRules, error) {
+				store.updated = true
+				return v, nil
+			}
+
+			_, err := tc.run(c)
+			if !tc.expectedErr {
+				assert.NoError(t, err)
+				assert.True(t, store.updated)
+				return
+			}
+
+			assert.ErrorIs(t, err, sdkerrors.ErrInvalidFormat)
+			assert.Contains(t, err.Error(), tc.err)
+			assert.False(t, store.updated)
+		})
+	}
+}
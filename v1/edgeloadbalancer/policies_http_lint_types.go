@@ -0,0 +1,48 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgeloadbalancer
+
+type (
+	// PoliciesHTTPLintSeverity is the severity of a lint finding.
+	PoliciesHTTPLintSeverity string
+
+	// PoliciesHTTPLintFinding is a problem found in the HTTP policies of a virtual service.
+	PoliciesHTTPLintFinding struct {
+		// Severity of the finding. An error is rejected by Cloud Director or makes the
+		// policy behave differently than configured, a warning is most likely a mistake.
+		Severity PoliciesHTTPLintSeverity
+		// Kind of the policy ("HTTP request", "HTTP response" or "HTTP security")
+		Kind string
+		// Policy is the name of the policy. Empty if the finding concerns all the policies.
+		Policy string
+		// Reason describes the finding
+		Reason string
+	}
+
+	// PoliciesHTTPLintFindings is the list of findings returned by Lint.
+	PoliciesHTTPLintFindings []PoliciesHTTPLintFinding
+
+	// PoliciesHTTPUpdateOptions defines the behavior of an update of the HTTP policies.
+	PoliciesHTTPUpdateOptions struct {
+		// Lint runs Lint before updating the policies and rejects the update if an
+		// error is found. Warnings are ignored.
+		Lint bool
+
+		// IfMatch is the Version of the policies read by the caller (e.g. PoliciesHTTPRequestModel.Version).
+		// If set, the policies are not updated and errors.ErrLoadBalancerPoliciesConflict is returned
+		// if they have been modified since they were read.
+		IfMatch string
+	}
+)
+
+const (
+	PoliciesHTTPLintSeverityError   PoliciesHTTPLintSeverity = "ERROR"
+	PoliciesHTTPLintSeverityWarning PoliciesHTTPLintSeverity = "WARNING"
+)
@@ -0,0 +1,284 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgeloadbalancer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/orange-cloudavenue/common-go/validators"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+)
+
+// policiesHTTPKind describes how to read and write a kind of HTTP policies (request, response or security).
+// P is the type of a policy and M the type of the model returned once the policies are updated.
+type policiesHTTPKind[P, M any] struct {
+	// kind is used in the error messages (e.g. "HTTP request")
+	kind string
+
+	// name returns the name of a policy
+	name func(policy *P) string
+
+	// get retrieves the policies of the virtual service
+	get func(vs fakeVirtualServiceClient) ([]*P, error)
+
+	// update replaces the policies of the virtual service
+	update func(vs fakeVirtualServiceClient, virtualServiceID string, policies []*P) (M, error)
+
+	// policies returns the policies of the model returned by update
+	policies func(m M) []*P
+
+	// lint checks the policies against the virtual service
+	lint func(virtualService *VirtualServiceModel, policies []*P) PoliciesHTTPLintFindings
+}
+
+// policiesHTTPUpdateOptions merges the optional options of a policies update,
+// an option is enabled if it is set in any of them.
+func policiesHTTPUpdateOptions(opts []PoliciesHTTPUpdateOptions) (merged PoliciesHTTPUpdateOptions) {
+	for _, o := range opts {
+		merged.Lint = merged.Lint || o.Lint
+		if o.IfMatch != "" {
+			merged.IfMatch = o.IfMatch
+		}
+	}
+	return merged
+}
+
+// addPolicyHTTP adds a policy at the given position.
+// errors.ErrAlreadyExists is returned if a policy with the same name is present.
+func addPolicyHTTP[P, M any](ctx context.Context, c *client, kind policiesHTTPKind[P, M], virtualServiceID string, policy *P, position PoliciesHTTPPolicyPosition, opts PoliciesHTTPUpdateOptions) (m M, err error) {
+	if policy == nil {
+		return m, fmt.Errorf("policy is %w. Please provide a valid policy", errors.ErrEmpty)
+	}
+
+	if err := validators.New().StructCtx(ctx, policy); err != nil {
+		return m, err
+	}
+
+	return modifyPoliciesHTTP(ctx, c, kind, virtualServiceID, opts, func(policies []*P) ([]*P, error) {
+		if policiesHTTPIndex(kind, policies, kind.name(policy)) != -1 {
+			return nil, fmt.Errorf("%s policy %s %w", kind.kind, kind.name(policy), errors.ErrAlreadyExists)
+		}
+
+		return policiesHTTPInsert(kind, policies, policy, position)
+	})
+}
+
+// updatePolicyHTTPByName replaces the policy with the given name. The policy keeps its position.
+// errors.ErrAlreadyExists is returned if the policy is renamed after another policy.
+func updatePolicyHTTPByName[P, M any](ctx context.Context, c *client, kind policiesHTTPKind[P, M], virtualServiceID, name string, policy *P, opts PoliciesHTTPUpdateOptions) (m M, err error) {
+	if name == "" {
+		return m, fmt.Errorf("name is %w. Please provide a valid name", errors.ErrEmpty)
+	}
+
+	if policy == nil {
+		return m, fmt.Errorf("policy is %w. Please provide a valid policy", errors.ErrEmpty)
+	}
+
+	if err := validators.New().StructCtx(ctx, policy); err != nil {
+		return m, err
+	}
+
+	return modifyPoliciesHTTP(ctx, c, kind, virtualServiceID, opts, func(policies []*P) ([]*P, error) {
+		i := policiesHTTPIndex(kind, policies, name)
+		if i == -1 {
+			return nil, fmt.Errorf("%s policy %s %w", kind.kind, name, errors.ErrNotFound)
+		}
+
+		// * Renaming a policy must not create a duplicate
+		if j := policiesHTTPIndex(kind, policies, kind.name(policy)); j != -1 && j != i {
+			return nil, fmt.Errorf("%s policy %s %w", kind.kind, kind.name(policy), errors.ErrAlreadyExists)
+		}
+
+		policies[i] = policy
+		return policies, nil
+	})
+}
+
+// deletePolicyHTTPByName deletes the policy with the given name.
+func deletePolicyHTTPByName[P, M any](ctx context.Context, c *client, kind policiesHTTPKind[P, M], virtualServiceID, name string, opts PoliciesHTTPUpdateOptions) error {
+	if name == "" {
+		return fmt.Errorf("name is %w. Please provide a valid name", errors.ErrEmpty)
+	}
+
+	_, err := modifyPoliciesHTTP(ctx, c, kind, virtualServiceID, opts, func(policies []*P) ([]*P, error) {
+		i := policiesHTTPIndex(kind, policies, name)
+		if i == -1 {
+			return nil, fmt.Errorf("%s policy %s %w", kind.kind, name, errors.ErrNotFound)
+		}
+
+		return slices.Delete(policies, i, i+1), nil
+	})
+	return err
+}
+
+// movePolicyHTTP moves the policy with the given name to the given position.
+// The position is relative to the list of policies without the moved policy.
+func movePolicyHTTP[P, M any](ctx context.Context, c *client, kind policiesHTTPKind[P, M], virtualServiceID, name string, position PoliciesHTTPPolicyPosition, opts PoliciesHTTPUpdateOptions) (m M, err error) {
+	if name == "" {
+		return m, fmt.Errorf("name is %w. Please provide a valid name", errors.ErrEmpty)
+	}
+
+	if position.Before == name || position.After == name {
+		return m, fmt.Errorf("position has %w. A policy cannot be moved relative to itself", errors.ErrInvalidFormat)
+	}
+
+	return modifyPoliciesHTTP(ctx, c, kind, virtualServiceID, opts, func(policies []*P) ([]*P, error) {
+		i := policiesHTTPIndex(kind, policies, name)
+		if i == -1 {
+			return nil, fmt.Errorf("%s policy %s %w", kind.kind, name, errors.ErrNotFound)
+		}
+
+		policy := policies[i]
+		return policiesHTTPInsert(kind, slices.Delete(policies, i, i+1), policy, position)
+	})
+}
+
+// modifyPoliciesHTTP applies a read-modify-write on the policies of a virtual service.
+// VCD has no version or If-Match precondition on the HTTP policies, so the conflict detection is done by the SDK:
+//   - if opts.IfMatch is set, ErrLoadBalancerPoliciesConflict is returned without writing anything
+//     if the Version of the policies differs from it, i.e. they have been modified since the caller read them;
+//   - the policies are read back after writing, and ErrLoadBalancerPoliciesConflict is returned if they
+//     differ from the policies returned by the write. In that case the write has been applied and
+//     overwritten by a concurrent one.
+//
+// Without IfMatch, a modification made since the caller read the policies is overwritten by the write.
+// A concurrent write landing between the read and the write of this call is not detected.
+//
+// If opts.Lint is set, the resulting list of policies is checked with Lint before being written.
+func modifyPoliciesHTTP[P, M any](ctx context.Context, c *client, kind policiesHTTPKind[P, M], virtualServiceID string, opts PoliciesHTTPUpdateOptions, modify func(policies []*P) ([]*P, error)) (m M, err error) {
+	if err := c.virtualServiceIDValidator(virtualServiceID); err != nil {
+		return m, err
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return m, err
+	}
+
+	// * Get the virtual service
+	vs, err := c.getVirtualService(ctx, "", virtualServiceID)
+	if err != nil {
+		return m, fmt.Errorf("error retrieving virtual service: %w", err)
+	}
+
+	policies, err := kind.get(vs)
+	if err != nil {
+		return m, fmt.Errorf("error retrieving %s rules: %w", kind.kind, err)
+	}
+
+	if opts.IfMatch != "" && policiesHTTPVersion(policies) != opts.IfMatch {
+		return m, errors.ErrLoadBalancerPoliciesConflict
+	}
+
+	policies, err = modify(slices.Clone(policies))
+	if err != nil {
+		return m, err
+	}
+
+	if opts.Lint && vs.NsxtAlbVirtualService != nil {
+		if err := kind.lint(fromVCDNsxtAlbVirtualServiceToModel(*vs.NsxtAlbVirtualService), policies).Err(); err != nil {
+			return m, fmt.Errorf("%s %w", kind.kind, err)
+		}
+	}
+
+	updated, err := kind.update(vs, virtualServiceID, policies)
+	if err != nil {
+		return m, err
+	}
+
+	// * Detect a concurrent modification after writing
+	written, err := kind.get(vs)
+	if err != nil {
+		return m, fmt.Errorf("error retrieving %s rules: %w", kind.kind, err)
+	}
+
+	// The policies returned by the write are normalized by VCD like the ones read back
+	if policiesHTTPVersion(written) != policiesHTTPVersion(kind.policies(updated)) {
+		return m, fmt.Errorf("%s policies differ from the written ones: %w", kind.kind, errors.ErrLoadBalancerPoliciesConflict)
+	}
+
+	return updated, nil
+}
+
+// policiesHTTPIndex returns the index of the policy with the given name or -1.
+func policiesHTTPIndex[P, M any](kind policiesHTTPKind[P, M], policies []*P, name string) int {
+	return slices.IndexFunc(policies, func(p *P) bool {
+		return kind.name(p) == name
+	})
+}
+
+// policiesHTTPInsert inserts the policy at the given position.
+func policiesHTTPInsert[P, M any](kind policiesHTTPKind[P, M], policies []*P, policy *P, position PoliciesHTTPPolicyPosition) ([]*P, error) {
+	if err := validators.New().Struct(&position); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case position.Before != "":
+		i := policiesHTTPIndex(kind, policies, position.Before)
+		if i == -1 {
+			return nil, fmt.Errorf("%s policy %s %w", kind.kind, position.Before, errors.ErrNotFound)
+		}
+		return slices.Insert(policies, i, policy), nil
+
+	case position.After != "":
+		i := policiesHTTPIndex(kind, policies, position.After)
+		if i == -1 {
+			return nil, fmt.Errorf("%s policy %s %w", kind.kind, position.After, errors.ErrNotFound)
+		}
+		return slices.Insert(policies, i+1, policy), nil
+
+	case position.Index != nil:
+		if *position.Index > len(policies) {
+			return nil, fmt.Errorf("index has %w. Index must be lower than or equal to %d", errors.ErrInvalidFormat, len(policies))
+		}
+		return slices.Insert(policies, *position.Index, policy), nil
+
+	default:
+		return append(policies, policy), nil
+	}
+}
+
+// policiesHTTPVersion returns a hash of the ordered policies and of their content,
+// used to detect concurrent modifications.
+func policiesHTTPVersion[P any](policies []*P) string {
+	// An empty list has the same version whether it is nil or not
+	if len(policies) == 0 {
+		policies = nil
+	}
+
+	// The policies only hold JSON-encodable values, Marshal can't fail
+	b, _ := json.Marshal(policies)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// Version returns the version of the HTTP request policies.
+// It can be passed as IfMatch in PoliciesHTTPUpdateOptions to detect a modification made since the policies were read.
+func (p *PoliciesHTTPRequestModel) Version() string {
+	return policiesHTTPVersion(p.Policies)
+}
+
+// Version returns the version of the HTTP response policies.
+// It can be passed as IfMatch in PoliciesHTTPUpdateOptions to detect a modification made since the policies were read.
+func (p *PoliciesHTTPResponseModel) Version() string {
+	return policiesHTTPVersion(p.Policies)
+}
+
+// Version returns the version of the HTTP security policies.
+// It can be passed as IfMatch in PoliciesHTTPUpdateOptions to detect a modification made since the policies were read.
+func (p *PoliciesHTTPSecurityModel) Version() string {
+	return policiesHTTPVersion(p.Policies)
+}
@@ -0,0 +1,573 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgeloadbalancer
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/utils"
+	sdkerrors "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+)
+
+// testPoliciesHTTPRequestStore stubs the HTTP request rules of a virtual service.
+// The rules can be modified after the write with afterUpdate.
+type testPoliciesHTTPRequestStore struct {
+	rules       []*govcdtypes.AlbVsHttpRequestRule
+	afterUpdate func(s *testPoliciesHTTPRequestStore)
+	updated     bool
+}
+
+func (s *testPoliciesHTTPRequestStore) install(t *testing.T) {
+	t.Helper()
+
+	getOrig, updateOrig := getPoliciesHTTPRequest, updatePoliciesHTTPRequest
+	t.Cleanup(func() {
+		getPoliciesHTTPRequest, updatePoliciesHTTPRequest = getOrig, updateOrig
+	})
+
+	getPoliciesHTTPRequest = func(_ fakeVirtualServiceClient) ([]*govcdtypes.AlbVsHttpRequestRule, error) {
+		rules := make([]*govcdtypes.AlbVsHttpRequestRule, len(s.rules))
+		for i := range s.rules {
+			r := *s.rules[i]
+			rules[i] = &r
+		}
+		return rules, nil
+	}
+
+	updatePoliciesHTTPRequest = func(_ fakeVirtualServiceClient, v *govcdtypes.AlbVsHttpRequestRules) (*govcdtypes.AlbVsHttpRequestRules, error) {
+		s.updated = true
+		s.rules = nil
+		for i := range v.Values {
+			s.rules = append(s.rules, &v.Values[i])
+		}
+		if s.afterUpdate != nil {
+			s.afterUpdate(s)
+		}
+		return v, nil
+	}
+}
+
+func (s *testPoliciesHTTPRequestStore) names() []string {
+	names := make([]string, len(s.rules))
+	for i, r := range s.rules {
+		names[i] = r.Name
+	}
+	return names
+}
+
+func testPoliciesHTTPRequestRules(names ...string) []*govcdtypes.AlbVsHttpRequestRule {
+	rules := make([]*govcdtypes.AlbVsHttpRequestRule, len(names))
+	for i, name := range names {
+		rules[i] = &govcdtypes.AlbVsHttpRequestRule{
+			Name:   name,
+			Active: true,
+			MatchCriteria: govcdtypes.AlbVsHttpRequestAndSecurityRuleMatchCriteria{
+				Protocol: string(PoliciesHTTPProtocolHTTP),
+			},
+		}
+	}
+	return rules
+}
+
+// testPoliciesHTTPRequestVersion returns the Version of the rules, as read by GetPoliciesHTTPRequest.
+func testPoliciesHTTPRequestVersion(rules []*govcdtypes.AlbVsHttpRequestRule) string {
+	values := make([]govcdtypes.AlbVsHttpRequestRule, len(rules))
+	for i := range rules {
+		values[i] = *rules[i]
+	}
+	return (&PoliciesHTTPRequestModel{}).fromVCD("", &govcdtypes.AlbVsHttpRequestRules{Values: values}).Version()
+}
+
+func testPolicyHTTPRequest(name string) *PoliciesHTTPRequestModelPolicy {
+	return &PoliciesHTTPRequestModelPolicy{
+		Name:   name,
+		Active: true,
+		MatchCriteria: PoliciesHTTPRequestMatchCriteria{
+			Protocol: string(PoliciesHTTPProtocolHTTP),
+		},
+	}
+}
+
+func TestClient_AddPolicyHTTPRequest(t *testing.T) {
+	virtualServiceID := urn.LoadBalancerVirtualService.String() + uuid.New().String()
+
+	tests := []struct {
+		name          string
+		policy        *PoliciesHTTPRequestModelPolicy
+		position      PoliciesHTTPPolicyPosition
+		opts          []PoliciesHTTPUpdateOptions
+		afterUpdate   func(s *testPoliciesHTTPRequestStore)
+		expectedNames []string
+		expectedErr   bool
+		err           error
+	}{
+		{
+			name:          testSuccess,
+			policy:        testPolicyHTTPRequest("new"),
+			expectedNames: []string{"a", "b", "c", "new"},
+		},
+		{
+			name:          "success-before",
+			policy:        testPolicyHTTPRequest("new"),
+			position:      PoliciesHTTPPolicyPosition{Before: "b"},
+			expectedNames: []string{"a", "new", "b", "c"},
+		},
+		{
+			name:          "success-after",
+			policy:        testPolicyHTTPRequest("new"),
+			position:      PoliciesHTTPPolicyPosition{After: "c"},
+			expectedNames: []string{"a", "b", "c", "new"},
+		},
+		{
+			name:          "success-index",
+			policy:        testPolicyHTTPRequest("new"),
+			position:      PoliciesHTTPPolicyPosition{Index: utils.ToPTR(0)},
+			expectedNames: []string{"new", "a", "b", "c"},
+		},
+		{
+			name:        "error-duplicate",
+			policy:      testPolicyHTTPRequest("b"),
+			expectedErr: true,
+			err:         sdkerrors.ErrAlreadyExists,
+		},
+		{
+			name:        "error-before-not-found",
+			policy:      testPolicyHTTPRequest("new"),
+			position:    PoliciesHTTPPolicyPosition{Before: "unknown"},
+			expectedErr: true,
+			err:         sdkerrors.ErrNotFound,
+		},
+		{
+			name:        "error-index-out-of-range",
+			policy:      testPolicyHTTPRequest("new"),
+			position:    PoliciesHTTPPolicyPosition{Index: utils.ToPTR(4)},
+			expectedErr: true,
+			err:         sdkerrors.ErrInvalidFormat,
+		},
+		{
+			name:        "error-position-before-and-after",
+			policy:      testPolicyHTTPRequest("new"),
+			position:    PoliciesHTTPPolicyPosition{Before: "a", After: "b"},
+			expectedErr: true,
+			err:         errors.New("Field validation for 'Before'"),
+		},
+		{
+			name:          "success-if-match",
+			policy:        testPolicyHTTPRequest("new"),
+			opts:          []PoliciesHTTPUpdateOptions{{IfMatch: testPoliciesHTTPRequestVersion(testPoliciesHTTPRequestRules("a", "b", "c"))}},
+			expectedNames: []string{"a", "b", "c", "new"},
+		},
+		{
+			name:        "error-conflict",
+			policy:      testPolicyHTTPRequest("new"),
+			opts:        []PoliciesHTTPUpdateOptions{{IfMatch: testPoliciesHTTPRequestVersion(testPoliciesHTTPRequestRules("a", "b"))}},
+			expectedErr: true,
+			err:         sdkerrors.ErrLoadBalancerPoliciesConflict,
+		},
+		{
+			name:   "error-conflict-content",
+			policy: testPolicyHTTPRequest("new"),
+			opts: []PoliciesHTTPUpdateOptions{{IfMatch: testPoliciesHTTPRequestVersion(func() []*govcdtypes.AlbVsHttpRequestRule {
+				// The caller read the policy b before it was disabled
+				rules := testPoliciesHTTPRequestRules("a", "b", "c")
+				rules[1].Active = false
+				return rules
+			}())}},
+			expectedErr: true,
+			err:         sdkerrors.ErrLoadBalancerPoliciesConflict,
+		},
+		{
+			name:   "error-concurrent-write",
+			policy: testPolicyHTTPRequest("new"),
+			afterUpdate: func(s *testPoliciesHTTPRequestStore) {
+				s.rules = testPoliciesHTTPRequestRules("a", "b", "c", "other")
+			},
+			expectedErr: true,
+			err:         sdkerrors.ErrLoadBalancerPoliciesConflict,
+		},
+		{
+			name:   "error-concurrent-write-content",
+			policy: testPolicyHTTPRequest("new"),
+			afterUpdate: func(s *testPoliciesHTTPRequestStore) {
+				// The names and the order are unchanged, only the content of a policy
+				s.rules = testPoliciesHTTPRequestRules("a", "b", "c", "new")
+				s.rules[0].Active = false
+			},
+			expectedErr: true,
+			err:         sdkerrors.ErrLoadBalancerPoliciesConflict,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Mock controller.
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock client for cloudavenue.
+			clientCAV := NewMockclientFake(ctrl)
+
+			c, _ := NewFakeClient(clientCAV)
+
+			clientCAV.EXPECT().Refresh().Return(nil)
+			clientCAV.EXPECT().GetAlbVirtualServiceById(virtualServiceID).Return(&govcd.NsxtAlbVirtualService{
+				NsxtAlbVirtualService: &govcdtypes.NsxtAlbVirtualService{ID: virtualServiceID},
+			}, nil)
+
+			store := &testPoliciesHTTPRequestStore{
+				rules:       testPoliciesHTTPRequestRules("a", "b", "c"),
+				afterUpdate: tc.afterUpdate,
+			}
+			store.install(t)
+
+			policies, err := c.AddPolicyHTTPRequest(context.Background(), virtualServiceID, tc.policy, tc.position, tc.opts...)
+			if !tc.expectedErr {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedNames, store.names())
+				assert.Equal(t, virtualServiceID, policies.VirtualServiceID)
+				assert.Len(t, policies.Policies, len(tc.expectedNames))
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Nil(t, policies)
+			// Only a conflict detected after the write leaves the policies updated
+			assert.Equal(t, tc.afterUpdate != nil, store.updated)
+			// A duplicate name is a permanent failure, it must not be retried as a conflict
+			assert.Equal(t, errors.Is(tc.err, sdkerrors.ErrConflict), sdkerrors.IsConflict(err))
+			if errors.Is(err, tc.err) {
+				return
+			}
+			assert.Contains(t, err.Error(), tc.err.Error())
+		})
+	}
+}
+
+func TestClient_UpdatePolicyHTTPRequestByName(t *testing.T) {
+	virtualServiceID := urn.LoadBalancerVirtualService.String() + uuid.New().String()
+
+	tests := []struct {
+		name          string
+		policyName    string
+		policy        *PoliciesHTTPRequestModelPolicy
+		expectedNames []string
+		expectedErr   bool
+		err           error
+	}{
+		{
+			name:          testSuccess,
+			policyName:    "b",
+			policy:        testPolicyHTTPRequest("b"),
+			expectedNames: []string{"a", "b", "c"},
+		},
+		{
+			name:          "success-rename",
+			policyName:    "b",
+			policy:        testPolicyHTTPRequest("renamed"),
+			expectedNames: []string{"a", "renamed", "c"},
+		},
+		{
+			name:        "error-rename-duplicate",
+			policyName:  "b",
+			policy:      testPolicyHTTPRequest("c"),
+			expectedErr: true,
+			err:         sdkerrors.ErrAlreadyExists,
+		},
+		{
+			name:        "error-not-found",
+			policyName:  "unknown",
+			policy:      testPolicyHTTPRequest("unknown"),
+			expectedErr: true,
+			err:         sdkerrors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Mock controller.
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock client for cloudavenue.
+			clientCAV := NewMockclientFake(ctrl)
+
+			c, _ := NewFakeClient(clientCAV)
+
+			clientCAV.EXPECT().Refresh().Return(nil)
+			clientCAV.EXPECT().GetAlbVirtualServiceById(virtualServiceID).Return(&govcd.NsxtAlbVirtualService{
+				NsxtAlbVirtualService: &govcdtypes.NsxtAlbVirtualService{ID: virtualServiceID},
+			}, nil)
+
+			store := &testPoliciesHTTPRequestStore{rules: testPoliciesHTTPRequestRules("a", "b", "c")}
+			store.install(t)
+
+			policies, err := c.UpdatePolicyHTTPRequestByName(context.Background(), virtualServiceID, tc.policyName, tc.policy)
+			if !tc.expectedErr {
+				assert.NoError(t, err)
+				assert.NotNil(t, policies)
+				assert.Equal(t, tc.expectedNames, store.names())
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Nil(t, policies)
+			assert.False(t, store.updated)
+			assert.False(t, sdkerrors.IsConflict(err))
+			if errors.Is(err, tc.err) {
+				return
+			}
+			assert.Contains(t, err.Error(), tc.err.Error())
+		})
+	}
+}
+
+func TestClient_DeletePolicyHTTPRequestByName(t *testing.T) {
+	virtualServiceID := urn.LoadBalancerVirtualService.String() + uuid.New().String()
+
+	tests := []struct {
+		name          string
+		policyName    string
+		opts          []PoliciesHTTPUpdateOptions
+		expectedNames []string
+		expectedErr   bool
+		err           error
+	}{
+		{
+			name:          testSuccess,
+			policyName:    "b",
+			expectedNames: []string{"a", "c"},
+		},
+		{
+			name:        "error-not-found",
+			policyName:  "unknown",
+			expectedErr: true,
+			err:         sdkerrors.ErrNotFound,
+		},
+		{
+			name:        "error-conflict",
+			policyName:  "b",
+			opts:        []PoliciesHTTPUpdateOptions{{IfMatch: testPoliciesHTTPRequestVersion(testPoliciesHTTPRequestRules("a", "b"))}},
+			expectedErr: true,
+			err:         sdkerrors.ErrLoadBalancerPoliciesConflict,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Mock controller.
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock client for cloudavenue.
+			clientCAV := NewMockclientFake(ctrl)
+
+			c, _ := NewFakeClient(clientCAV)
+
+			clientCAV.EXPECT().Refresh().Return(nil)
+			clientCAV.EXPECT().GetAlbVirtualServiceById(virtualServiceID).Return(&govcd.NsxtAlbVirtualService{
+				NsxtAlbVirtualService: &govcdtypes.NsxtAlbVirtualService{ID: virtualServiceID},
+			}, nil)
+
+			store := &testPoliciesHTTPRequestStore{rules: testPoliciesHTTPRequestRules("a", "b", "c")}
+			store.install(t)
+
+			err := c.DeletePolicyHTTPRequestByName(context.Background(), virtualServiceID, tc.policyName, tc.opts...)
+			if !tc.expectedErr {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedNames, store.names())
+				return
+			}
+
+			assert.Error(t, err)
+			assert.False(t, store.updated)
+			if errors.Is(err, tc.err) {
+				return
+			}
+			assert.Contains(t, err.Error(), tc.err.Error())
+		})
+	}
+}
+
+func TestClient_MovePolicyHTTPRequest(t *testing.T) {
+	virtualServiceID := urn.LoadBalancerVirtualService.String() + uuid.New().String()
+
+	tests := []struct {
+		name          string
+		policyName    string
+		position      PoliciesHTTPPolicyPosition
+		withMock      bool
+		expectedNames []string
+		expectedErr   bool
+		err           error
+	}{
+		{
+			name:          testSuccess,
+			policyName:    "a",
+			withMock:      true,
+			expectedNames: []string{"b", "c", "a"},
+		},
+		{
+			name:          "success-before",
+			policyName:    "c",
+			position:      PoliciesHTTPPolicyPosition{Before: "a"},
+			withMock:      true,
+			expectedNames: []string{"c", "a", "b"},
+		},
+		{
+			name:          "success-after",
+			policyName:    "a",
+			position:      PoliciesHTTPPolicyPosition{After: "b"},
+			withMock:      true,
+			expectedNames: []string{"b", "a", "c"},
+		},
+		{
+			name:          "success-index",
+			policyName:    "c",
+			position:      PoliciesHTTPPolicyPosition{Index: utils.ToPTR(1)},
+			withMock:      true,
+			expectedNames: []string{"a", "c", "b"},
+		},
+		{
+			name:        "error-not-found",
+			policyName:  "unknown",
+			withMock:    true,
+			expectedErr: true,
+			err:         sdkerrors.ErrNotFound,
+		},
+		{
+			name:        "error-relative-to-itself",
+			policyName:  "a",
+			position:    PoliciesHTTPPolicyPosition{After: "a"},
+			expectedErr: true,
+			err:         sdkerrors.ErrInvalidFormat,
+		},
+		{
+			name:        "error-empty-name",
+			expectedErr: true,
+			err:         sdkerrors.ErrEmpty,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Mock controller.
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Mock client for cloudavenue.
+			clientCAV := NewMockclientFake(ctrl)
+
+			c, _ := NewFakeClient(clientCAV)
+
+			if tc.withMock {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetAlbVirtualServiceById(virtualServiceID).Return(&govcd.NsxtAlbVirtualService{
+					NsxtAlbVirtualService: &govcdtypes.NsxtAlbVirtualService{ID: virtualServiceID},
+				}, nil)
+			}
+
+			store := &testPoliciesHTTPRequestStore{rules: testPoliciesHTTPRequestRules("a", "b", "c")}
+			store.install(t)
+
+			policies, err := c.MovePolicyHTTPRequest(context.Background(), virtualServiceID, tc.policyName, tc.position)
+			if !tc.expectedErr {
+				assert.NoError(t, err)
+				assert.NotNil(t, policies)
+				assert.Equal(t, tc.expectedNames, store.names())
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Nil(t, policies)
+			assert.False(t, store.updated)
+			if errors.Is(err, tc.err) {
+				return
+			}
+			assert.Contains(t, err.Error(), tc.err.Error())
+		})
+	}
+}
+
+func TestClient_AddPolicyHTTPResponseAndSecurity(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientFake(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	virtualServiceID := urn.LoadBalancerVirtualService.String() + uuid.New().String()
+
+	clientCAV.EXPECT().Refresh().Return(nil).Times(2)
+	clientCAV.EXPECT().GetAlbVirtualServiceById(virtualServiceID).Return(&govcd.NsxtAlbVirtualService{
+		NsxtAlbVirtualService: &govcdtypes.NsxtAlbVirtualService{ID: virtualServiceID},
+	}, nil).Times(2)
+
+	defer func(get func(fakeVirtualServiceClient) ([]*govcdtypes.AlbVsHttpResponseRule, error), update func(fakeVirtualServiceClient, *govcdtypes.AlbVsHttpResponseRules) (*govcdtypes.AlbVsHttpResponseRules, error)) {
+		getPoliciesHTTPResponse, updatePoliciesHTTPResponse = get, update
+	}(getPoliciesHTTPResponse, updatePoliciesHTTPResponse)
+
+	responseRules := []govcdtypes.AlbVsHttpResponseRule{{Name: "a", Active: true}}
+	getPoliciesHTTPResponse = func(_ fakeVirtualServiceClient) ([]*govcdtypes.AlbVsHttpResponseRule, error) {
+		rules := make([]*govcdtypes.AlbVsHttpResponseRule, len(responseRules))
+		for i := range responseRules {
+			rules[i] = &responseRules[i]
+		}
+		return rules, nil
+	}
+	updatePoliciesHTTPResponse = func(_ fakeVirtualServiceClient, v *govcdtypes.AlbVsHttpResponseRules) (*govcdtypes.AlbVsHttpResponseRules, error) {
+		responseRules = slices.Clone(v.Values)
+		return v, nil
+	}
+
+	response, err := c.AddPolicyHTTPResponse(context.Background(), virtualServiceID, &PoliciesHTTPResponseModelPolicy{Name: "b", Active: true, MatchCriteria: PoliciesHTTPResponseMatchCriteria{Protocol: string(PoliciesHTTPProtocolHTTP)}}, PoliciesHTTPPolicyPosition{Before: "a"})
+	assert.NoError(t, err)
+	if assert.NotNil(t, response) && assert.Len(t, response.Policies, 2) {
+		assert.Equal(t, "b", response.Policies[0].Name)
+		assert.Equal(t, "a", response.Policies[1].Name)
+	}
+
+	defer func(get func(fakeVirtualServiceClient) ([]*govcdtypes.AlbVsHttpSecurityRule, error), update func(fakeVirtualServiceClient, *govcdtypes.AlbVsHttpSecurityRules) (*govcdtypes.AlbVsHttpSecurityRules, error)) {
+		getPoliciesHTTPSecurity, updatePoliciesHTTPSecurity = get, update
+	}(getPoliciesHTTPSecurity, updatePoliciesHTTPSecurity)
+
+	securityRules := []govcdtypes.AlbVsHttpSecurityRule{{Name: "a", Active: true}}
+	getPoliciesHTTPSecurity = func(_ fakeVirtualServiceClient) ([]*govcdtypes.AlbVsHttpSecurityRule, error) {
+		rules := make([]*govcdtypes.AlbVsHttpSecurityRule, len(securityRules))
+		for i := range securityRules {
+			rules[i] = &securityRules[i]
+		}
+		return rules, nil
+	}
+	updatePoliciesHTTPSecurity = func(_ fakeVirtualServiceClient, v *govcdtypes.AlbVsHttpSecurityRules) (*govcdtypes.AlbVsHttpSecurityRules, error) {
+		securityRules = slices.Clone(v.Values)
+		return v, nil
+	}
+
+	security, err := c.AddPolicyHTTPSecurity(context.Background(), virtualServiceID, &PoliciesHTTPSecurityModelPolicy{Name: "b", Active: true, MatchCriteria: PoliciesHTTPSecurityMatchCriteria{Protocol: PoliciesHTTPProtocolHTTP}}, PoliciesHTTPPolicyPosition{})
+	assert.NoError(t, err)
+	if assert.NotNil(t, security) && assert.Len(t, security.Policies, 2) {
+		assert.Equal(t, "a", security.Policies[0].Name)
+		assert.Equal(t, "b", security.Policies[1].Name)
+	}
+}
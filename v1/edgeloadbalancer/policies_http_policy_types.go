@@ -0,0 +1,27 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgeloadbalancer
+
+type (
+	// PoliciesHTTPPolicyPosition defines where a policy is placed in the list of policies.
+	// Only one of Before, After or Index can be set. If none is set, the policy is placed
+	// at the end of the list.
+	PoliciesHTTPPolicyPosition struct {
+		// Before places the policy before the policy with this name
+		Before string `validate:"omitempty,excluded_with=After Index"`
+
+		// After places the policy after the policy with this name
+		After string `validate:"omitempty,excluded_with=Before Index"`
+
+		// Index places the policy at this index (0 is the first policy).
+		// The index must be lower than or equal to the number of policies.
+		Index *int `validate:"omitempty,gte=0,excluded_with=Before After"`
+	}
+)
@@ -58,7 +58,9 @@ var getPoliciesHTTPRequest = func(virtualServiceClient fakeVirtualServiceClient)
 	return virtualServiceClient.GetAllHttpRequestRules(nil)
 }
 
-func (c *client) UpdatePoliciesHTTPRequest(ctx context.Context, policies *PoliciesHTTPRequestModel) (*PoliciesHTTPRequestModel, error) {
+// UpdatePoliciesHTTPRequest replaces the HTTP request policies of the virtual service.
+// If the Lint option is set, the policies are checked with Lint before the update.
+func (c *client) UpdatePoliciesHTTPRequest(ctx context.Context, policies *PoliciesHTTPRequestModel, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPRequestModel, error) {
 	if err := validators.New().StructCtx(ctx, policies); err != nil {
 		return nil, err
 	}
@@ -73,6 +75,12 @@ func (c *client) UpdatePoliciesHTTPRequest(ctx context.Context, policies *Polici
 		return nil, fmt.Errorf("error retrieving virtual service: %w", err)
 	}
 
+	if policiesHTTPUpdateOptions(opts).Lint && vs.NsxtAlbVirtualService != nil {
+		if err := policies.Lint(fromVCDNsxtAlbVirtualServiceToModel(*vs.NsxtAlbVirtualService)).Err(); err != nil {
+			return nil, fmt.Errorf("HTTP request %w", err)
+		}
+	}
+
 	policiesUpdated, err := updatePoliciesHTTPRequest(vs, policies.toVCD())
 	if err != nil {
 		return nil, fmt.Errorf("error updating HTTP request rules: %w", err)
@@ -110,3 +118,65 @@ func (c *client) DeletePoliciesHTTPRequest(ctx context.Context, virtualServiceID
 
 	return nil
 }
+
+// policiesHTTPRequestKindName is the name of the HTTP request policies used in the errors and findings.
+const policiesHTTPRequestKindName = "HTTP request"
+
+// policiesHTTPRequestKind reads and writes the HTTP request policies one by one.
+var policiesHTTPRequestKind = policiesHTTPKind[PoliciesHTTPRequestModelPolicy, *PoliciesHTTPRequestModel]{
+	kind: policiesHTTPRequestKindName,
+	name: func(policy *PoliciesHTTPRequestModelPolicy) string {
+		return policy.Name
+	},
+	get: func(vs fakeVirtualServiceClient) ([]*PoliciesHTTPRequestModelPolicy, error) {
+		rules, err := getPoliciesHTTPRequest(vs)
+		if err != nil {
+			return nil, err
+		}
+
+		policies := make([]*PoliciesHTTPRequestModelPolicy, len(rules))
+		for i := range rules {
+			policies[i] = (&PoliciesHTTPRequestModelPolicy{}).fromVCD(rules[i])
+		}
+		return policies, nil
+	},
+	update: func(vs fakeVirtualServiceClient, virtualServiceID string, policies []*PoliciesHTTPRequestModelPolicy) (*PoliciesHTTPRequestModel, error) {
+		policiesUpdated, err := updatePoliciesHTTPRequest(vs, (&PoliciesHTTPRequestModel{Policies: policies}).toVCD())
+		if err != nil {
+			return nil, fmt.Errorf("error updating HTTP request rules: %w", err)
+		}
+
+		return (&PoliciesHTTPRequestModel{}).fromVCD(virtualServiceID, policiesUpdated), nil
+	},
+	policies: func(m *PoliciesHTTPRequestModel) []*PoliciesHTTPRequestModelPolicy {
+		return m.Policies
+	},
+	lint: func(virtualService *VirtualServiceModel, policies []*PoliciesHTTPRequestModelPolicy) PoliciesHTTPLintFindings {
+		return (&PoliciesHTTPRequestModel{VirtualServiceID: virtualService.ID, Policies: policies}).Lint(virtualService)
+	},
+}
+
+// AddPolicyHTTPRequest adds a single HTTP request policy at the given position without
+// overwriting the other policies of the virtual service.
+// If the Lint option is set, the resulting policies are checked with Lint before the update.
+func (c *client) AddPolicyHTTPRequest(ctx context.Context, virtualServiceID string, policy *PoliciesHTTPRequestModelPolicy, position PoliciesHTTPPolicyPosition, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPRequestModel, error) {
+	return addPolicyHTTP(ctx, c, policiesHTTPRequestKind, virtualServiceID, policy, position, policiesHTTPUpdateOptions(opts))
+}
+
+// UpdatePolicyHTTPRequestByName replaces the HTTP request policy with the given name.
+// If the Lint option is set, the resulting policies are checked with Lint before the update.
+func (c *client) UpdatePolicyHTTPRequestByName(ctx context.Context, virtualServiceID, name string, policy *PoliciesHTTPRequestModelPolicy, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPRequestModel, error) {
+	return updatePolicyHTTPByName(ctx, c, policiesHTTPRequestKind, virtualServiceID, name, policy, policiesHTTPUpdateOptions(opts))
+}
+
+// DeletePolicyHTTPRequestByName deletes the HTTP request policy with the given name.
+// Only the IfMatch option is used.
+func (c *client) DeletePolicyHTTPRequestByName(ctx context.Context, virtualServiceID, name string, opts ...PoliciesHTTPUpdateOptions) error {
+	return deletePolicyHTTPByName(ctx, c, policiesHTTPRequestKind, virtualServiceID, name, policiesHTTPUpdateOptions(opts))
+}
+
+// MovePolicyHTTPRequest moves the HTTP request policy with the given name to the given position.
+// If the Lint option is set, the resulting policies are checked with Lint before the update.
+func (c *client) MovePolicyHTTPRequest(ctx context.Context, virtualServiceID, name string, position PoliciesHTTPPolicyPosition, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPRequestModel, error) {
+	return movePolicyHTTP(ctx, c, policiesHTTPRequestKind, virtualServiceID, name, position, policiesHTTPUpdateOptions(opts))
+}
@@ -22,45 +22,45 @@ type (
 
 	PoliciesHTTPRequestModelPolicy struct {
 		// Name of the rule
-		Name string `validate:"required"`
+		Name string `json:"name" validate:"required"`
 		// Whether the rule is active or not.
-		Active bool `validate:"omitempty"`
+		Active bool `json:"active" validate:"omitempty"`
 		// Whether to enable logging for the rule (policy)
-		Logging bool `validate:"omitempty"`
+		Logging bool `json:"logging" validate:"omitempty"`
 		// MatchCriteria for the HTTP Request
-		MatchCriteria PoliciesHTTPRequestMatchCriteria `validate:"required"`
+		MatchCriteria PoliciesHTTPRequestMatchCriteria `json:"matchCriteria" validate:"required"`
 
 		// Action to take when the rule matches
 
 		// HTTP Redirect Action
 		// It cannot be configured in combination with other actions
-		RedirectAction *PoliciesHTTPActionRedirect `validate:"omitempty"`
+		RedirectAction *PoliciesHTTPActionRedirect `json:"redirectAction" validate:"omitempty"`
 		// HTTP header rewrite action
 		// It can be configured in combination with rewrite URL action
-		HeaderRewriteActions PoliciesHTTPActionHeadersRewrite `validate:"omitempty"`
+		HeaderRewriteActions PoliciesHTTPActionHeadersRewrite `json:"headerRewriteActions" validate:"omitempty"`
 		// HTTP request URL rewrite action
 		// It can be configured in combination with multiple header actions
-		URLRewriteAction *PoliciesHTTPActionURLRewrite `validate:"omitempty"`
+		URLRewriteAction *PoliciesHTTPActionURLRewrite `json:"urlRewriteAction" validate:"omitempty"`
 	}
 
 	PoliciesHTTPRequestMatchCriteria struct {
 		// Protocol
 		// var PoliciesHTTPMatchCriteriaProtocols and PoliciesHTTPMatchCriteriaProtocolsString are defined get the list of valid values.
-		Protocol string `validate:"omitempty,oneof=HTTP HTTPS"`
+		Protocol string `json:"protocol" validate:"omitempty,oneof=HTTP HTTPS"`
 		// Client IP addresses
-		ClientIPMatch *PoliciesHTTPClientIPMatch `validate:"omitempty"`
+		ClientIPMatch *PoliciesHTTPClientIPMatch `json:"clientIPMatch" validate:"omitempty"`
 		// Service Ports
-		ServicePortMatch *PoliciesHTTPServicePortMatch `validate:"omitempty"`
+		ServicePortMatch *PoliciesHTTPServicePortMatch `json:"servicePortMatch" validate:"omitempty"`
 		// HTTP Methods
-		MethodMatch *PoliciesHTTPMethodMatch `validate:"omitempty"`
+		MethodMatch *PoliciesHTTPMethodMatch `json:"methodMatch" validate:"omitempty"`
 		// Path Match
-		PathMatch *PoliciesHTTPPathMatch `validate:"omitempty"`
+		PathMatch *PoliciesHTTPPathMatch `json:"pathMatch" validate:"omitempty"`
 		// HTTP request cookies
-		CookieMatch *PoliciesHTTPCookieMatch `validate:"omitempty"`
+		CookieMatch *PoliciesHTTPCookieMatch `json:"cookieMatch" validate:"omitempty"`
 		// HTTP request headers
-		HeaderMatch PoliciesHTTPHeadersMatch `validate:"omitempty"`
+		HeaderMatch PoliciesHTTPHeadersMatch `json:"headerMatch" validate:"omitempty"`
 		// HTTP request query strings in key=value format
-		QueryMatch []string `validate:"omitempty,dive,str_key_value"`
+		QueryMatch []string `json:"queryMatch" validate:"omitempty,dive,str_key_value"`
 	}
 )
 
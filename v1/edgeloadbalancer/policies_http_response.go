@@ -58,7 +58,9 @@ var getPoliciesHTTPResponse = func(virtualServiceClient fakeVirtualServiceClient
 	return virtualServiceClient.GetAllHttpResponseRules(nil)
 }
 
-func (c *client) UpdatePoliciesHTTPResponse(ctx context.Context, policies *PoliciesHTTPResponseModel) (*PoliciesHTTPResponseModel, error) {
+// UpdatePoliciesHTTPResponse replaces the HTTP response policies of the virtual service.
+// If the Lint option is set, the policies are checked with Lint before the update.
+func (c *client) UpdatePoliciesHTTPResponse(ctx context.Context, policies *PoliciesHTTPResponseModel, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPResponseModel, error) {
 	if err := validators.New().StructCtx(ctx, policies); err != nil {
 		return nil, err
 	}
@@ -73,6 +75,12 @@ func (c *client) UpdatePoliciesHTTPResponse(ctx context.Context, policies *Polic
 		return nil, fmt.Errorf("error retrieving virtual service: %w", err)
 	}
 
+	if policiesHTTPUpdateOptions(opts).Lint && vs.NsxtAlbVirtualService != nil {
+		if err := policies.Lint(fromVCDNsxtAlbVirtualServiceToModel(*vs.NsxtAlbVirtualService)).Err(); err != nil {
+			return nil, fmt.Errorf("HTTP response %w", err)
+		}
+	}
+
 	policiesUpdated, err := updatePoliciesHTTPResponse(vs, policies.toVCD())
 	if err != nil {
 		return nil, fmt.Errorf("error updating HTTP response rules: %w", err)
@@ -106,3 +114,65 @@ func (c *client) DeletePoliciesHTTPResponse(ctx context.Context, virtualServiceI
 
 	return nil
 }
+
+// policiesHTTPResponseKindName is the name of the HTTP response policies used in the errors and findings.
+const policiesHTTPResponseKindName = "HTTP response"
+
+// policiesHTTPResponseKind reads and writes the HTTP response policies one by one.
+var policiesHTTPResponseKind = policiesHTTPKind[PoliciesHTTPResponseModelPolicy, *PoliciesHTTPResponseModel]{
+	kind: policiesHTTPResponseKindName,
+	name: func(policy *PoliciesHTTPResponseModelPolicy) string {
+		return policy.Name
+	},
+	get: func(vs fakeVirtualServiceClient) ([]*PoliciesHTTPResponseModelPolicy, error) {
+		rules, err := getPoliciesHTTPResponse(vs)
+		if err != nil {
+			return nil, err
+		}
+
+		policies := make([]*PoliciesHTTPResponseModelPolicy, len(rules))
+		for i := range rules {
+			policies[i] = (&PoliciesHTTPResponseModelPolicy{}).fromVCD(*rules[i])
+		}
+		return policies, nil
+	},
+	update: func(vs fakeVirtualServiceClient, virtualServiceID string, policies []*PoliciesHTTPResponseModelPolicy) (*PoliciesHTTPResponseModel, error) {
+		policiesUpdated, err := updatePoliciesHTTPResponse(vs, (&PoliciesHTTPResponseModel{Policies: policies}).toVCD())
+		if err != nil {
+			return nil, fmt.Errorf("error updating HTTP response rules: %w", err)
+		}
+
+		return (&PoliciesHTTPResponseModel{}).fromVCD(virtualServiceID, policiesUpdated), nil
+	},
+	policies: func(m *PoliciesHTTPResponseModel) []*PoliciesHTTPResponseModelPolicy {
+		return m.Policies
+	},
+	lint: func(virtualService *VirtualServiceModel, policies []*PoliciesHTTPResponseModelPolicy) PoliciesHTTPLintFindings {
+		return (&PoliciesHTTPResponseModel{VirtualServiceID: virtualService.ID, Policies: policies}).Lint(virtualService)
+	},
+}
+
+// AddPolicyHTTPResponse adds a single HTTP response policy at the given position without
+// overwriting the other policies of the virtual service.
+// If the Lint option is set, the resulting policies are checked with Lint before the update.
+func (c *client) AddPolicyHTTPResponse(ctx context.Context, virtualServiceID string, policy *PoliciesHTTPResponseModelPolicy, position PoliciesHTTPPolicyPosition, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPResponseModel, error) {
+	return addPolicyHTTP(ctx, c, policiesHTTPResponseKind, virtualServiceID, policy, position, policiesHTTPUpdateOptions(opts))
+}
+
+// UpdatePolicyHTTPResponseByName replaces the HTTP response policy with the given name.
+// If the Lint option is set, the resulting policies are checked with Lint before the update.
+func (c *client) UpdatePolicyHTTPResponseByName(ctx context.Context, virtualServiceID, name string, policy *PoliciesHTTPResponseModelPolicy, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPResponseModel, error) {
+	return updatePolicyHTTPByName(ctx, c, policiesHTTPResponseKind, virtualServiceID, name, policy, policiesHTTPUpdateOptions(opts))
+}
+
+// DeletePolicyHTTPResponseByName deletes the HTTP response policy with the given name.
+// Only the IfMatch option is used.
+func (c *client) DeletePolicyHTTPResponseByName(ctx context.Context, virtualServiceID, name string, opts ...PoliciesHTTPUpdateOptions) error {
+	return deletePolicyHTTPByName(ctx, c, policiesHTTPResponseKind, virtualServiceID, name, policiesHTTPUpdateOptions(opts))
+}
+
+// MovePolicyHTTPResponse moves the HTTP response policy with the given name to the given position.
+// If the Lint option is set, the resulting policies are checked with Lint before the update.
+func (c *client) MovePolicyHTTPResponse(ctx context.Context, virtualServiceID, name string, position PoliciesHTTPPolicyPosition, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPResponseModel, error) {
+	return movePolicyHTTP(ctx, c, policiesHTTPResponseKind, virtualServiceID, name, position, policiesHTTPUpdateOptions(opts))
+}
@@ -22,45 +22,45 @@ type (
 
 	PoliciesHTTPResponseModelPolicy struct {
 		// Name of the rule
-		Name string `validate:"required"`
+		Name string `json:"name" validate:"required"`
 		// Whether the rule is active or not.
-		Active bool `validate:"omitempty"`
+		Active bool `json:"active" validate:"omitempty"`
 		// Whether to enable logging with headers on rule match or not
-		Logging bool `validate:"omitempty"`
+		Logging bool `json:"logging" validate:"omitempty"`
 		// MatchCriteria for the HTTP Response
-		MatchCriteria PoliciesHTTPResponseMatchCriteria `validate:"required"`
+		MatchCriteria PoliciesHTTPResponseMatchCriteria `json:"matchCriteria" validate:"required"`
 		// HTTP header rewrite action
 		// It can be configured in combination with rewrite URL action
-		HeaderRewriteActions PoliciesHTTPActionHeadersRewrite `validate:"omitempty"`
+		HeaderRewriteActions PoliciesHTTPActionHeadersRewrite `json:"headerRewriteActions" validate:"omitempty"`
 		// HTTP location rewrite action
-		LocationRewriteAction *PoliciesHTTPActionLocationRewrite `validate:"omitempty"`
+		LocationRewriteAction *PoliciesHTTPActionLocationRewrite `json:"locationRewriteAction" validate:"omitempty"`
 	}
 
 	PoliciesHTTPResponseMatchCriteria struct {
 		// Client IP addresses.
-		ClientIPMatch *PoliciesHTTPClientIPMatch `validate:"omitempty"`
+		ClientIPMatch *PoliciesHTTPClientIPMatch `json:"clientIPMatch" validate:"omitempty"`
 		// Virtual service ports.
-		ServicePortMatch *PoliciesHTTPServicePortMatch `validate:"omitempty"`
+		ServicePortMatch *PoliciesHTTPServicePortMatch `json:"servicePortMatch" validate:"omitempty"`
 		// HTTP methods such as GET, PUT, DELETE, POST etc.
-		MethodMatch *PoliciesHTTPMethodMatch `validate:"omitempty"`
+		MethodMatch *PoliciesHTTPMethodMatch `json:"methodMatch" validate:"omitempty"`
 		// Protocol
 		// var PoliciesHTTPPathMatchCriteria and PoliciesHTTPPathMatchCriteriaString are defined get the list of valid values.
-		Protocol string `validate:"omitempty,oneof=HTTP HTTPS"`
+		Protocol string `json:"protocol" validate:"omitempty,oneof=HTTP HTTPS"`
 		// Path Match
-		PathMatch *PoliciesHTTPPathMatch `validate:"omitempty"`
+		PathMatch *PoliciesHTTPPathMatch `json:"pathMatch" validate:"omitempty"`
 		// HTTP request query strings in key=value format
-		QueryMatch []string `validate:"omitempty,dive,str_key_value"`
+		QueryMatch []string `json:"queryMatch" validate:"omitempty,dive,str_key_value"`
 		// HTTP request cookies
-		CookieMatch *PoliciesHTTPCookieMatch `validate:"omitempty"`
+		CookieMatch *PoliciesHTTPCookieMatch `json:"cookieMatch" validate:"omitempty"`
 
 		// Defines match criteria based on response location header
-		LocationMatch *PoliciesHTTPLocationMatch `validate:"omitempty"`
+		LocationMatch *PoliciesHTTPLocationMatch `json:"locationMatch" validate:"omitempty"`
 		// Defines match criteria based on the request headers
-		RequestHeaderMatch PoliciesHTTPHeadersMatch `validate:"omitempty"`
+		RequestHeaderMatch PoliciesHTTPHeadersMatch `json:"requestHeaderMatch" validate:"omitempty"`
 		// Defines match criteria based on the response headers
-		ResponseHeaderMatch PoliciesHTTPHeadersMatch `validate:"omitempty"`
+		ResponseHeaderMatch PoliciesHTTPHeadersMatch `json:"responseHeaderMatch" validate:"omitempty"`
 		// Defines match criteria based on response status codes
-		StatusCodeMatch *PoliciesHTTPStatusCodeMatch `validate:"omitempty"`
+		StatusCodeMatch *PoliciesHTTPStatusCodeMatch `json:"statusCodeMatch" validate:"omitempty"`
 	}
 )
 
@@ -45,7 +45,9 @@ var getPoliciesHTTPSecurity = func(virtualServiceClient fakeVirtualServiceClient
 	return virtualServiceClient.GetAllHttpSecurityRules(nil)
 }
 
-func (c *client) UpdatePoliciesHTTPSecurity(ctx context.Context, policies *PoliciesHTTPSecurityModel) (*PoliciesHTTPSecurityModel, error) {
+// UpdatePoliciesHTTPSecurity replaces the HTTP security policies of the virtual service.
+// If the Lint option is set, the policies are checked with Lint before the update.
+func (c *client) UpdatePoliciesHTTPSecurity(ctx context.Context, policies *PoliciesHTTPSecurityModel, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPSecurityModel, error) {
 	if err := validators.New().StructCtx(ctx, policies); err != nil {
 		return nil, fmt.Errorf("validation error: %w", err)
 	}
@@ -60,6 +62,12 @@ func (c *client) UpdatePoliciesHTTPSecurity(ctx context.Context, policies *Polic
 		return nil, fmt.Errorf("error retrieving virtual service: %w", err)
 	}
 
+	if policiesHTTPUpdateOptions(opts).Lint && vs.NsxtAlbVirtualService != nil {
+		if err := policies.Lint(fromVCDNsxtAlbVirtualServiceToModel(*vs.NsxtAlbVirtualService)).Err(); err != nil {
+			return nil, fmt.Errorf("HTTP security %w", err)
+		}
+	}
+
 	policiesUpdated, err := updatePoliciesHTTPSecurity(vs, policies.toVCD())
 	if err != nil {
 		return nil, fmt.Errorf("error updating HTTP security rules: %w", err)
@@ -99,3 +107,70 @@ func (c *client) DeletePoliciesHTTPSecurity(ctx context.Context, virtualServiceI
 
 	return nil
 }
+
+// policiesHTTPSecurityKindName is the name of the HTTP security policies used in the errors and findings.
+const policiesHTTPSecurityKindName = "HTTP security"
+
+// policiesHTTPSecurityKind reads and writes the HTTP security policies one by one.
+var policiesHTTPSecurityKind = policiesHTTPKind[PoliciesHTTPSecurityModelPolicy, *PoliciesHTTPSecurityModel]{
+	kind: policiesHTTPSecurityKindName,
+	name: func(policy *PoliciesHTTPSecurityModelPolicy) string {
+		return policy.Name
+	},
+	get: func(vs fakeVirtualServiceClient) ([]*PoliciesHTTPSecurityModelPolicy, error) {
+		rules, err := getPoliciesHTTPSecurity(vs)
+		if err != nil {
+			return nil, err
+		}
+
+		policies := make([]*PoliciesHTTPSecurityModelPolicy, len(rules))
+		for i := range rules {
+			policies[i] = (&PoliciesHTTPSecurityModelPolicy{}).fromVCD(rules[i])
+		}
+		return policies, nil
+	},
+	update: func(vs fakeVirtualServiceClient, virtualServiceID string, policies []*PoliciesHTTPSecurityModelPolicy) (*PoliciesHTTPSecurityModel, error) {
+		policiesUpdated, err := updatePoliciesHTTPSecurity(vs, (&PoliciesHTTPSecurityModel{Policies: policies}).toVCD())
+		if err != nil {
+			return nil, fmt.Errorf("error updating HTTP security rules: %w", err)
+		}
+
+		var rulesUpdated []*govcdtypes.AlbVsHttpSecurityRule
+		for i := range policiesUpdated.Values {
+			rulesUpdated = append(rulesUpdated, &policiesUpdated.Values[i])
+		}
+
+		return (&PoliciesHTTPSecurityModel{}).fromVCD(virtualServiceID, rulesUpdated), nil
+	},
+	policies: func(m *PoliciesHTTPSecurityModel) []*PoliciesHTTPSecurityModelPolicy {
+		return m.Policies
+	},
+	lint: func(virtualService *VirtualServiceModel, policies []*PoliciesHTTPSecurityModelPolicy) PoliciesHTTPLintFindings {
+		return (&PoliciesHTTPSecurityModel{VirtualServiceID: virtualService.ID, Policies: policies}).Lint(virtualService)
+	},
+}
+
+// AddPolicyHTTPSecurity adds a single HTTP security policy at the given position without
+// overwriting the other policies of the virtual service.
+// If the Lint option is set, the resulting policies are checked with Lint before the update.
+func (c *client) AddPolicyHTTPSecurity(ctx context.Context, virtualServiceID string, policy *PoliciesHTTPSecurityModelPolicy, position PoliciesHTTPPolicyPosition, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPSecurityModel, error) {
+	return addPolicyHTTP(ctx, c, policiesHTTPSecurityKind, virtualServiceID, policy, position, policiesHTTPUpdateOptions(opts))
+}
+
+// UpdatePolicyHTTPSecurityByName replaces the HTTP security policy with the given name.
+// If the Lint option is set, the resulting policies are checked with Lint before the update.
+func (c *client) UpdatePolicyHTTPSecurityByName(ctx context.Context, virtualServiceID, name string, policy *PoliciesHTTPSecurityModelPolicy, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPSecurityModel, error) {
+	return updatePolicyHTTPByName(ctx, c, policiesHTTPSecurityKind, virtualServiceID, name, policy, policiesHTTPUpdateOptions(opts))
+}
+
+// DeletePolicyHTTPSecurityByName deletes the HTTP security policy with the given name.
+// Only the IfMatch option is used.
+func (c *client) DeletePolicyHTTPSecurityByName(ctx context.Context, virtualServiceID, name string, opts ...PoliciesHTTPUpdateOptions) error {
+	return deletePolicyHTTPByName(ctx, c, policiesHTTPSecurityKind, virtualServiceID, name, policiesHTTPUpdateOptions(opts))
+}
+
+// MovePolicyHTTPSecurity moves the HTTP security policy with the given name to the given position.
+// If the Lint option is set, the resulting policies are checked with Lint before the update.
+func (c *client) MovePolicyHTTPSecurity(ctx context.Context, virtualServiceID, name string, position PoliciesHTTPPolicyPosition, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPSecurityModel, error) {
+	return movePolicyHTTP(ctx, c, policiesHTTPSecurityKind, virtualServiceID, name, position, policiesHTTPUpdateOptions(opts))
+}
@@ -22,51 +22,51 @@ type (
 
 	PoliciesHTTPSecurityModelPolicy struct {
 		// Name of the rule
-		Name string `validate:"required"`
+		Name string `json:"name" validate:"required"`
 		// Whether the rule is active or not.
-		Active bool `validate:"omitempty"`
+		Active bool `json:"active" validate:"omitempty"`
 		// Whether to enable logging for the rule (policy)
-		Logging bool `validate:"omitempty"`
+		Logging bool `json:"logging" validate:"omitempty"`
 		// MatchCriteria for the HTTP Request
-		MatchCriteria PoliciesHTTPSecurityMatchCriteria `validate:"required"`
+		MatchCriteria PoliciesHTTPSecurityMatchCriteria `json:"matchCriteria" validate:"required"`
 
 		// Action to take when the rule matches
 
 		// HTTP Connection Action
 		// If set, the rule will either allow or close the connection based on the action specified.
 		// It can be configured in combination with other actions
-		ConnectionAction PoliciesHTTPConnectionAction `validate:"omitempty,oneof=ALLOW CLOSE"`
+		ConnectionAction PoliciesHTTPConnectionAction `json:"connectionAction" validate:"omitempty,oneof=ALLOW CLOSE"`
 		// HTTP Rate Limit Action
 		// If set, the rule will limit the rate of requests from a client IP address.
 		// It can be configured in combination with other actions
-		RateLimitAction *PoliciesHTTPActionRateLimit `validate:"omitempty"`
+		RateLimitAction *PoliciesHTTPActionRateLimit `json:"rateLimitAction" validate:"omitempty"`
 		// HTTP Redirect to HTTPS Action
 		// If set, the rule will redirect HTTP requests to HTTPS on the specified port.
 		// It can be configured in combination with other actions
-		RedirectToHTTPSAction *int `validate:"omitempty"`
+		RedirectToHTTPSAction *int `json:"redirectToHTTPSAction" validate:"omitempty"`
 		// HTTP Send Response Action
 		// If set, the rule will send a custom response to the client.
 		// It can be configured in combination with other actions
-		SendResponseAction *PoliciesHTTPActionSendResponse `validate:"omitempty"`
+		SendResponseAction *PoliciesHTTPActionSendResponse `json:"sendResponseAction" validate:"omitempty"`
 	}
 
 	PoliciesHTTPSecurityMatchCriteria struct {
 		// Protocol
-		Protocol PoliciesHTTPProtocol `validate:"omitempty,oneof=HTTP HTTPS"`
+		Protocol PoliciesHTTPProtocol `json:"protocol" validate:"omitempty,oneof=HTTP HTTPS"`
 		// Client IP addresses
-		ClientIPMatch *PoliciesHTTPClientIPMatch `validate:"omitempty"`
+		ClientIPMatch *PoliciesHTTPClientIPMatch `json:"clientIPMatch" validate:"omitempty"`
 		// Service Ports
-		ServicePortMatch *PoliciesHTTPServicePortMatch `validate:"omitempty"`
+		ServicePortMatch *PoliciesHTTPServicePortMatch `json:"servicePortMatch" validate:"omitempty"`
 		// HTTP Methods
-		MethodMatch *PoliciesHTTPMethodMatch `validate:"omitempty"`
+		MethodMatch *PoliciesHTTPMethodMatch `json:"methodMatch" validate:"omitempty"`
 		// Path Match
-		PathMatch *PoliciesHTTPPathMatch `validate:"omitempty"`
+		PathMatch *PoliciesHTTPPathMatch `json:"pathMatch" validate:"omitempty"`
 		// HTTP request cookies
-		CookieMatch *PoliciesHTTPCookieMatch `validate:"omitempty"`
+		CookieMatch *PoliciesHTTPCookieMatch `json:"cookieMatch" validate:"omitempty"`
 		// HTTP request headers
-		HeaderMatch PoliciesHTTPHeadersMatch `validate:"omitempty"`
+		HeaderMatch PoliciesHTTPHeadersMatch `json:"headerMatch" validate:"omitempty"`
 		// HTTP request query strings in key=value format
-		QueryMatch []string `validate:"omitempty,dive,str_key_value"`
+		QueryMatch []string `json:"queryMatch" validate:"omitempty,dive,str_key_value"`
 	}
 )
 
@@ -22,50 +22,50 @@ type (
 	PoliciesHTTPActionHeaderRewrite  struct {
 		// Action for the chosen header
 		// var PoliciesHTTPActionHeaderRewriteActions and PoliciesHTTPActionHeaderRewriteActionsString are defined get the list of valid values.
-		Action string `validate:"required,oneof=ADD REMOVE REPLACE"`
+		Action string `json:"action" validate:"required,oneof=ADD REMOVE REPLACE"`
 		// Name of HTTP header
-		Name string `validate:"required"`
+		Name string `json:"name" validate:"required"`
 		// Value of HTTP header
-		Value string `validate:"required_if=Action ADD|REPLACE,excluded_if=Action REMOVE"`
+		Value string `json:"value" validate:"required_if=Action ADD|REPLACE,excluded_if=Action REMOVE"`
 	}
 
 	PoliciesHTTPActionLocationRewrite struct {
 		// Protocol is HTTP or HTTPS
-		Protocol string `validate:"required,oneof=HTTP HTTPS"`
+		Protocol string `json:"protocol" validate:"required,oneof=HTTP HTTPS"`
 		// Host to which redirect the request. Default is the original host
-		Host string `validate:"omitempty"`
+		Host string `json:"host" validate:"omitempty"`
 		// Port to which redirect the request.
-		Port *int `validate:"omitempty,tcp_udp_port"`
+		Port *int `json:"port" validate:"omitempty,tcp_udp_port"`
 		// Path to which redirect the request. Default is the original path
-		Path string `validate:"omitempty"`
+		Path string `json:"path" validate:"omitempty"`
 		// Keep or drop the query of the incoming request URI in the redirected URI
-		KeepQuery bool `validate:"omitempty"`
+		KeepQuery bool `json:"keepQuery" validate:"omitempty"`
 	}
 
 	PoliciesHTTPActionRedirect struct {
 		// Host to which redirect the request. Default is the original host
-		Host string `validate:"omitempty"`
+		Host string `json:"host" validate:"omitempty"`
 		// Keep or drop the query of the incoming request URI in the redirected URI
-		KeepQuery bool
+		KeepQuery bool `json:"keepQuery"`
 		// Path to which redirect the request. Default is the original path
-		Path string `validate:"omitempty"`
+		Path string `json:"path" validate:"omitempty"`
 		// Port to which redirect the request.
-		Port *int `validate:"required,tcp_udp_port"`
+		Port *int `json:"port" validate:"required,tcp_udp_port"`
 		// HTTP or HTTPS protocol
-		Protocol string `validate:"required,oneof=HTTP HTTPS"`
+		Protocol string `json:"protocol" validate:"required,oneof=HTTP HTTPS"`
 		// One of the redirect status codes - 301, 302, 307
-		StatusCode int `validate:"required,oneof=301 302 307"`
+		StatusCode int `json:"statusCode" validate:"required,oneof=301 302 307"`
 	}
 
 	PoliciesHTTPActionURLRewrite struct {
 		// Host header to use for the rewritten URL.
-		HostHeader string `validate:"required"`
+		HostHeader string `json:"hostHeader" validate:"required"`
 		// Path to use for the rewritten URL.
-		Path string `validate:"required"`
+		Path string `json:"path" validate:"required"`
 		// Query string to use or append to the existing query string in the rewritten URL.
-		Query string `validate:"omitempty"`
+		Query string `json:"query" validate:"omitempty"`
 		// Whether or not to keep the existing query string when rewriting the URL. Defaults to true.
-		KeepQuery bool `validate:"omitempty"`
+		KeepQuery bool `json:"keepQuery" validate:"omitempty"`
 	}
 
 	PoliciesHTTPActionRateLimit struct {
@@ -74,35 +74,35 @@ type (
 		// Default is 1000 requests
 		// 1 request is the minimum
 		// 1000000000 requests is the maximum
-		Count int `default:"1000" validate:"min=1,max=1000000000"`
+		Count int `json:"count" default:"1000" validate:"min=1,max=1000000000"`
 		//
 		// Time period in seconds for the rate limit 1 to 1000000000
 		// Default is 60 seconds
 		// 1 second is the minimum period
 		// 1000000000 seconds is the maximum period
-		Period int `default:"60" validate:"min=1,max=1000000000"`
+		Period int `json:"period" default:"60" validate:"min=1,max=1000000000"`
 		//
 		// Action to do an HTTP redirect when the rate limit is exceeded
 		// It can't be configured in combination with other actions below
-		RedirectAction *PoliciesHTTPActionRedirect `validate:"omitempty"`
+		RedirectAction *PoliciesHTTPActionRedirect `json:"redirectAction" validate:"omitempty"`
 		//
 		// Action to close the connection HTTP when the rate limit is exceeded
 		// The network connection is closed (no error http return).
 		// It can't be configured in combination with other actions
-		CloseConnectionAction *bool `validate:"omitempty"`
+		CloseConnectionAction *bool `json:"closeConnectionAction" validate:"omitempty"`
 		//
 		// You can use this action to send a custom response to the client when the rate limit is exceeded.
 		// It can't be configured in combination with other actions
-		LocalResponseAction *PoliciesHTTPActionSendResponse `validate:"omitempty"`
+		LocalResponseAction *PoliciesHTTPActionSendResponse `json:"localResponseAction" validate:"omitempty"`
 	}
 
 	PoliciesHTTPActionSendResponse struct {
 		// HTTP status code to return
-		StatusCode int `validate:"required,oneof=200 204 403 404 429 501"`
+		StatusCode int `json:"statusCode" validate:"required,oneof=200 204 403 404 429 501"`
 		// Content type of the response
-		ContentType string `validate:"required,oneof=application/json text/html text/plain"`
+		ContentType string `json:"contentType" validate:"required,oneof=application/json text/html text/plain"`
 		// Content of the response - base64 encoded string
-		Content string `validate:"required,base64"`
+		Content string `json:"content" validate:"required,base64"`
 	}
 )
 
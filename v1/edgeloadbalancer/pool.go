@@ -103,6 +103,10 @@ func (c *client) CreatePool(ctx context.Context, pool PoolModelRequest) (*PoolMo
 		return nil, err
 	}
 
+	if err := c.resolvePoolMemberGroup(&pool); err != nil {
+		return nil, err
+	}
+
 	poolCreated, err := c.clientGoVCD.CreateNsxtAlbPool(fromModelToGoVCDNsxtALBPool("", pool))
 	if err != nil {
 		return nil, fmt.Errorf("error creating Load Balancer Pool: %w", err)
@@ -138,6 +142,10 @@ func (c *client) UpdatePool(ctx context.Context, poolID string, pool PoolModelRe
 		return nil, fmt.Errorf("error retrieving Load Balancer Pool: %w", err)
 	}
 
+	if err := c.resolvePoolMemberGroup(&pool); err != nil {
+		return nil, err
+	}
+
 	poolUpdated, err := updatePool(poolToUpdate, fromModelToGoVCDNsxtALBPool(poolToUpdate.NsxtAlbPool.ID, pool))
 	if err != nil {
 		return nil, fmt.Errorf("error updating Load Balancer Pool: %w", err)
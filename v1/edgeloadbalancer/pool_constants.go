@@ -84,3 +84,14 @@ const (
 	// PoolPersistenceProfileTypeTLS is the TLS persistence profile.
 	PoolPersistenceProfileTypeTLS PoolPersistenceProfileType = "TLS"
 )
+
+const (
+	// PoolMemberHealthStatusUp is the health status of an operational member.
+	PoolMemberHealthStatusUp = "UP"
+	// PoolMemberHealthStatusDown is the health status of a member marked down by a health monitor.
+	PoolMemberHealthStatusDown = "DOWN"
+	// PoolMemberHealthStatusDisabled is the health status of a disabled member.
+	PoolMemberHealthStatusDisabled = "DISABLED"
+	// PoolMemberHealthStatusUnknown is the health status of a member whose state can't be determined.
+	PoolMemberHealthStatusUnknown = "UNKNOWN"
+)
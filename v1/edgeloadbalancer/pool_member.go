@@ -0,0 +1,353 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgeloadbalancer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/utils"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+)
+
+const (
+	defaultPoolMemberGracefulTimeoutPeriod = 1
+	defaultPoolMemberRatio                 = 1
+	defaultWaitForPoolMemberUpTimeout      = 10 * time.Minute
+
+	poolMemberMinRatio = 1
+	poolMemberMaxRatio = 20
+)
+
+// poolMemberDrainUnit is the unit of the GracefulTimeoutPeriod of a pool.
+var poolMemberDrainUnit = time.Minute
+
+// AddPoolMember adds a member to a pool. The other members are left untouched.
+// If the member is already present, errors.ErrAlreadyExists is returned.
+// The detection of concurrent modifications is best-effort, errors.ErrLoadBalancerPoolConflict is returned when one is detected.
+func (c *client) AddPoolMember(ctx context.Context, poolID string, member PoolModelMember) (*PoolModel, error) {
+	if err := poolMemberValidator(member.IPAddress, member.Port); err != nil {
+		return nil, err
+	}
+
+	if member.Ratio != nil {
+		if err := poolMemberRatioValidator(*member.Ratio); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.modifyPoolMembers(ctx, poolID, func(members []govcdtypes.NsxtAlbPoolMember) ([]govcdtypes.NsxtAlbPoolMember, error) {
+		if findPoolMember(members, member.IPAddress, member.Port) != -1 {
+			return nil, fmt.Errorf("pool member %s in pool %s %w", poolMemberKey(member.IPAddress, member.Port), poolID, errors.ErrAlreadyExists)
+		}
+
+		return append(members, govcdtypes.NsxtAlbPoolMember{
+			Enabled:   member.Enabled,
+			IpAddress: member.IPAddress,
+			Port:      member.Port,
+			Ratio:     member.Ratio,
+		}), nil
+	})
+}
+
+// RemovePoolMember removes the member identified by its IP address and port from a pool.
+// The detection of concurrent modifications is best-effort, errors.ErrLoadBalancerPoolConflict is returned when one is detected.
+func (c *client) RemovePoolMember(ctx context.Context, poolID, ipAddress string, port int) (*PoolModel, error) {
+	if err := poolMemberValidator(ipAddress, port); err != nil {
+		return nil, err
+	}
+
+	return c.modifyPoolMembers(ctx, poolID, func(members []govcdtypes.NsxtAlbPoolMember) ([]govcdtypes.NsxtAlbPoolMember, error) {
+		index := findPoolMember(members, ipAddress, port)
+		if index == -1 {
+			return nil, fmt.Errorf("pool member %s %w in pool %s", poolMemberKey(ipAddress, port), errors.ErrNotFound, poolID)
+		}
+
+		return slices.Delete(members, index, index+1), nil
+	})
+}
+
+// SetPoolMemberEnabled enables or disables the member identified by its IP address and port.
+// A disabled member is drained during the GracefulTimeoutPeriod of the pool.
+// The detection of concurrent modifications is best-effort, errors.ErrLoadBalancerPoolConflict is returned when one is detected.
+func (c *client) SetPoolMemberEnabled(ctx context.Context, poolID, ipAddress string, port int, enabled bool) (*PoolModel, error) {
+	if err := poolMemberValidator(ipAddress, port); err != nil {
+		return nil, err
+	}
+
+	return c.modifyPoolMembers(ctx, poolID, func(members []govcdtypes.NsxtAlbPoolMember) ([]govcdtypes.NsxtAlbPoolMember, error) {
+		index := findPoolMember(members, ipAddress, port)
+		if index == -1 {
+			return nil, fmt.Errorf("pool member %s %w in pool %s", poolMemberKey(ipAddress, port), errors.ErrNotFound, poolID)
+		}
+
+		members[index].Enabled = enabled
+		return members, nil
+	})
+}
+
+// SetPoolMemberRatio sets the ratio of the member identified by its IP address and port.
+// The detection of concurrent modifications is best-effort, errors.ErrLoadBalancerPoolConflict is returned when one is detected.
+func (c *client) SetPoolMemberRatio(ctx context.Context, poolID, ipAddress string, port, ratio int) (*PoolModel, error) {
+	if err := poolMemberValidator(ipAddress, port); err != nil {
+		return nil, err
+	}
+
+	if err := poolMemberRatioValidator(ratio); err != nil {
+		return nil, err
+	}
+
+	return c.modifyPoolMembers(ctx, poolID, func(members []govcdtypes.NsxtAlbPoolMember) ([]govcdtypes.NsxtAlbPoolMember, error) {
+		index := findPoolMember(members, ipAddress, port)
+		if index == -1 {
+			return nil, fmt.Errorf("pool member %s %w in pool %s", poolMemberKey(ipAddress, port), errors.ErrNotFound, poolID)
+		}
+
+		members[index].Ratio = utils.ToPTR(ratio)
+		return members, nil
+	})
+}
+
+// RollingMaintenance runs fn on each enabled member of a pool, one member at a time.
+// For each member, the member is disabled, drained during the GracefulTimeoutPeriod of the pool,
+// fn is called, then the member is enabled again and RollingMaintenance waits for the member
+// to report UP before moving to the next one.
+// If fn returns an error, the member is left disabled and the maintenance is stopped.
+// The health status is polled with an exponential backoff until the context is done,
+// or until 10 minutes per member if the context has no deadline.
+func (c *client) RollingMaintenance(ctx context.Context, poolID string, fn PoolMemberMaintenanceFunc) error {
+	if fn == nil {
+		return fmt.Errorf("fn is %w. Please provide a maintenance function", errors.ErrEmpty)
+	}
+
+	if err := poolIDValidator(poolID); err != nil {
+		return err
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return err
+	}
+
+	pool, err := c.getpool(ctx, "", poolID)
+	if err != nil {
+		return fmt.Errorf("error retrieving Load Balancer Pool: %w", err)
+	}
+
+	gracefulTimeoutPeriod := defaultPoolMemberGracefulTimeoutPeriod
+	if pool.NsxtAlbPool.GracefulTimeoutPeriod != nil {
+		gracefulTimeoutPeriod = *pool.NsxtAlbPool.GracefulTimeoutPeriod
+	}
+
+	if gracefulTimeoutPeriod < 0 {
+		return fmt.Errorf("rolling maintenance is not possible with an infinite GracefulTimeoutPeriod on pool %s", poolID)
+	}
+
+	members := fromVCDNsxtALBPoolToModel(pool.NsxtAlbPool).Members
+
+	for _, member := range members {
+		if !member.Enabled {
+			continue
+		}
+
+		if _, err := c.SetPoolMemberEnabled(ctx, poolID, member.IPAddress, member.Port, false); err != nil {
+			return fmt.Errorf("error disabling pool member %s: %w", poolMemberKey(member.IPAddress, member.Port), err)
+		}
+
+		// * Drain the member
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("context done while draining pool member %s: %w", poolMemberKey(member.IPAddress, member.Port), ctx.Err())
+		case <-time.After(time.Duration(gracefulTimeoutPeriod) * poolMemberDrainUnit):
+		}
+
+		if err := fn(ctx, member); err != nil {
+			return fmt.Errorf("error during maintenance of pool member %s, the member is left disabled: %w", poolMemberKey(member.IPAddress, member.Port), err)
+		}
+
+		if _, err := c.SetPoolMemberEnabled(ctx, poolID, member.IPAddress, member.Port, true); err != nil {
+			return fmt.Errorf("error enabling pool member %s: %w", poolMemberKey(member.IPAddress, member.Port), err)
+		}
+
+		if err := c.waitForPoolMemberUp(ctx, poolID, member.IPAddress, member.Port); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// * Local functions
+
+// modifyPoolMembers applies a read-modify-write cycle on the members of a pool.
+// VCD has no version or If-Match precondition on the pools, so the conflict detection is best-effort:
+//   - the pool is read again before being written, and errors.ErrLoadBalancerPoolConflict is returned
+//     without writing anything if its members have been modified in the meantime;
+//   - the members read back after the write are compared with the written ones, and
+//     errors.ErrLoadBalancerPoolConflict is returned if they differ. In that case the write has been
+//     applied and overwritten, or merged, by a concurrent one.
+//
+// A concurrent write landing between the second read and the write is still lost silently if it is
+// overwritten before the read back.
+func (c *client) modifyPoolMembers(ctx context.Context, poolID string, modify func(members []govcdtypes.NsxtAlbPoolMember) ([]govcdtypes.NsxtAlbPoolMember, error)) (*PoolModel, error) {
+	if err := poolIDValidator(poolID); err != nil {
+		return nil, err
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return nil, err
+	}
+
+	// edgegatewayID is not needed for retreiving the pool by ID
+	pool, err := c.getpool(ctx, "", poolID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving Load Balancer Pool: %w", err)
+	}
+
+	if pool.NsxtAlbPool.MemberGroupRef != nil {
+		return nil, fmt.Errorf("the members of pool %s are managed by the group %s: %w", poolID, pool.NsxtAlbPool.MemberGroupRef.Name, errors.ErrLoadBalancerPoolMembersManagedByGroup)
+	}
+
+	signature := poolMembersSignature(pool.NsxtAlbPool.Members)
+
+	members, err := modify(slices.Clone(pool.NsxtAlbPool.Members))
+	if err != nil {
+		return nil, err
+	}
+
+	// Detect a concurrent modification before writing
+	current, err := c.getpool(ctx, "", poolID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving Load Balancer Pool: %w", err)
+	}
+
+	if poolMembersSignature(current.NsxtAlbPool.Members) != signature {
+		return nil, errors.ErrLoadBalancerPoolConflict
+	}
+
+	poolToUpdate := *current.NsxtAlbPool
+	poolToUpdate.Members = members
+
+	poolUpdated, err := updatePool(current, &poolToUpdate)
+	if err != nil {
+		return nil, fmt.Errorf("error updating Load Balancer Pool: %w", err)
+	}
+
+	// The updated pool is read back by govcd once the update task is done
+	if poolMembersSignature(poolUpdated.NsxtAlbPool.Members) != poolMembersSignature(members) {
+		return nil, fmt.Errorf("members of pool %s differ from the written ones: %w", poolID, errors.ErrLoadBalancerPoolConflict)
+	}
+
+	return fromVCDNsxtALBPoolToModel(poolUpdated.NsxtAlbPool), nil
+}
+
+// waitForPoolMemberUp waits for the member of a pool to report the UP health status.
+func (c *client) waitForPoolMemberUp(ctx context.Context, poolID, ipAddress string, port int) error {
+	if _, deadlineSet := ctx.Deadline(); !deadlineSet {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultWaitForPoolMemberUpTimeout)
+		defer cancel()
+	}
+
+	healthStatus := ""
+
+	err := utils.Poll(ctx, func() (bool, error) {
+		if err := c.clientCloudavenue.Refresh(); err != nil {
+			return false, err
+		}
+
+		pool, err := c.getpool(ctx, "", poolID)
+		if err != nil {
+			return false, fmt.Errorf("error retrieving Load Balancer Pool: %w", err)
+		}
+
+		index := findPoolMember(pool.NsxtAlbPool.Members, ipAddress, port)
+		if index == -1 {
+			return false, fmt.Errorf("pool member %s %w in pool %s", poolMemberKey(ipAddress, port), errors.ErrNotFound, poolID)
+		}
+
+		healthStatus = pool.NsxtAlbPool.Members[index].HealthStatus
+		return healthStatus == PoolMemberHealthStatusUp, nil
+	})
+	if err != nil && ctx.Err() != nil {
+		return fmt.Errorf("timeout reached waiting for pool member %s to be UP, current status is %s (%w)", poolMemberKey(ipAddress, port), healthStatus, ctx.Err())
+	}
+
+	return err
+}
+
+// findPoolMember returns the index of the member matching the IP address and the port, or -1.
+func findPoolMember(members []govcdtypes.NsxtAlbPoolMember, ipAddress string, port int) int {
+	return slices.IndexFunc(members, func(m govcdtypes.NsxtAlbPoolMember) bool {
+		return m.IpAddress == ipAddress && m.Port == port
+	})
+}
+
+// poolMembersSignature returns a string identifying the members and their settings, regardless of their order.
+// A member without ratio has the default ratio of VCD.
+func poolMembersSignature(members []govcdtypes.NsxtAlbPoolMember) string {
+	entries := make([]string, 0, len(members))
+	for _, m := range members {
+		ratio := defaultPoolMemberRatio
+		if m.Ratio != nil {
+			ratio = *m.Ratio
+		}
+		entries = append(entries, fmt.Sprintf("%s:%t:%d", poolMemberKey(m.IpAddress, m.Port), m.Enabled, ratio))
+	}
+	slices.Sort(entries)
+	return strings.Join(entries, ";")
+}
+
+// poolMemberKey returns the IP address and the port of a member (e.g. 192.168.0.1:80).
+func poolMemberKey(ipAddress string, port int) string {
+	return net.JoinHostPort(ipAddress, fmt.Sprint(port))
+}
+
+func poolIDValidator(poolID string) error {
+	if poolID == "" {
+		return fmt.Errorf("poolID is %w. Please provide a valid poolID", errors.ErrEmpty)
+	}
+
+	if !urn.IsLoadBalancerPool(poolID) {
+		return fmt.Errorf("poolID has %w. Please provide a valid poolID", errors.ErrInvalidFormat)
+	}
+
+	return nil
+}
+
+func poolMemberValidator(ipAddress string, port int) error {
+	if ipAddress == "" {
+		return fmt.Errorf("ipAddress is %w. Please provide a valid ipAddress", errors.ErrEmpty)
+	}
+
+	if net.ParseIP(ipAddress) == nil {
+		return fmt.Errorf("ipAddress has %w. Please provide a valid ipAddress", errors.ErrInvalidFormat)
+	}
+
+	if port < 0 || port > 65535 {
+		return fmt.Errorf("port has %w. Please provide a port between 0 and 65535", errors.ErrInvalidFormat)
+	}
+
+	return nil
+}
+
+func poolMemberRatioValidator(ratio int) error {
+	if ratio < poolMemberMinRatio || ratio > poolMemberMaxRatio {
+		return fmt.Errorf("ratio has %w. Please provide a ratio between %d and %d", errors.ErrInvalidFormat, poolMemberMinRatio, poolMemberMaxRatio)
+	}
+
+	return nil
+}
@@ -0,0 +1,480 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgeloadbalancer
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+)
+
+const (
+	// poolEffectiveMembersMax is the maximum number of members returned when an IP Set is expanded.
+	poolEffectiveMembersMax = 4096
+
+	// poolVMsQueryBatchSize is the number of VMs retrieved by a query when the members of a Security Group are resolved.
+	poolVMsQueryBatchSize = 25
+)
+
+var (
+	listFirewallGroups = func(edgeClient fakeFirewallGroupEdgeGatewayClient, firewallGroupType string) ([]*govcd.NsxtFirewallGroup, error) {
+		return edgeClient.GetAllNsxtFirewallGroups(url.Values{}, firewallGroupType)
+	}
+
+	getFirewallGroup = func(edgeClient fakeFirewallGroupEdgeGatewayClient, id string) (*govcd.NsxtFirewallGroup, error) {
+		return edgeClient.GetNsxtFirewallGroupById(id)
+	}
+
+	createFirewallGroup = func(edgeClient fakeFirewallGroupEdgeGatewayClient, group *govcdtypes.NsxtFirewallGroup) (*govcd.NsxtFirewallGroup, error) {
+		return edgeClient.CreateNsxtFirewallGroup(group)
+	}
+
+	updateFirewallGroup = func(groupClient fakeFirewallGroupClient, group *govcdtypes.NsxtFirewallGroup) (*govcd.NsxtFirewallGroup, error) {
+		return groupClient.Update(group)
+	}
+
+	deleteFirewallGroup = func(groupClient fakeFirewallGroupClient) error {
+		return groupClient.Delete()
+	}
+
+	getFirewallGroupAssociatedVms = func(groupClient fakeFirewallGroupClient) ([]*govcdtypes.NsxtFirewallGroupMemberVms, error) {
+		return groupClient.GetAssociatedVms()
+	}
+)
+
+// GetEffectiveMembers returns the members actually behind a pool.
+// For a pool with static members, the members are returned as is. For a pool pointing to an IP Set,
+// the IP addresses, ranges and CIDRs of the IP Set are expanded. For a pool pointing to a Security
+// Group, the IP address of the primary network of each associated VM is returned (VMs without IP
+// address are ignored).
+// The members of a group use the DefaultPort of the pool.
+// Cloud Director only exposes the health of static members. For a pool pointing to a group, the
+// health of each member is not available and HealthStatus is always UNKNOWN, use the UpMemberCount
+// and HealthMessage of the pool to get its overall health.
+func (c *client) GetEffectiveMembers(ctx context.Context, poolID string) ([]PoolModelMember, error) {
+	if err := poolIDValidator(poolID); err != nil {
+		return nil, err
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return nil, err
+	}
+
+	// edgegatewayID is not needed for retreiving the pool by ID
+	pool, err := c.getpool(ctx, "", poolID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving Load Balancer Pool: %w", err)
+	}
+
+	model := fromVCDNsxtALBPoolToModel(pool.NsxtAlbPool)
+	if model.MemberGroupRef == nil {
+		return model.Members, nil
+	}
+
+	egw, err := c.clientGoVCDOrg.GetNsxtEdgeGatewayById(model.GatewayRef.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving edge gateway: %w", err)
+	}
+
+	group, err := getFirewallGroup(egw, model.MemberGroupRef.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving firewall group %s: %w", model.MemberGroupRef.Name, err)
+	}
+
+	var ipAddresses []string
+	if firewallGroupType(group.NsxtFirewallGroup) == govcdtypes.FirewallGroupTypeIpSet {
+		ipAddresses, err = expandIPSetAddresses(group.NsxtFirewallGroup.IpAddresses)
+	} else {
+		ipAddresses, err = c.getFirewallGroupVMsIPAddresses(group)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error resolving the members of firewall group %s: %w", group.NsxtFirewallGroup.Name, err)
+	}
+
+	port := 0
+	if model.DefaultPort != nil {
+		port = *model.DefaultPort
+	}
+
+	members := make([]PoolModelMember, 0, len(ipAddresses))
+	for _, ip := range ipAddresses {
+		members = append(members, PoolModelMember{
+			Enabled:      true,
+			IPAddress:    ip,
+			Port:         port,
+			HealthStatus: PoolMemberHealthStatusUnknown,
+		})
+	}
+
+	return members, nil
+}
+
+// MigratePoolMembersToIPSet converts the static members of a pool into an IP Set of the Edge Gateway
+// and repoints the pool to it. The IP Set is created if it does not exist. An existing IP Set may be
+// referenced by other rules, so errors.ErrConflict is returned unless ReuseExistingIPSet is set in the
+// options, in which case its IP addresses are replaced by those of the members.
+// If the pool update fails, the IP Set created by the call is deleted.
+// If the members of the pool are already managed by a group, errors.ErrLoadBalancerPoolMembersManagedByGroup is returned.
+// The members must use the DefaultPort of the pool (or no port). An IP Set can't hold the state or the
+// ratio of a member, so errors.ErrInvalidFormat is returned if a member is disabled or has a ratio other
+// than 1, unless AllowMemberSettingsLoss is set in the options. In that case the disabled members are
+// not added to the IP Set and the ratios are lost.
+// VCD has no version or If-Match precondition on the pools, so the conflict detection is done by the SDK:
+//   - if IfMatch is set in the options, errors.ErrLoadBalancerPoolConflict is returned without writing anything
+//     if the MembersVersion of the pool differs from it, i.e. the members have been modified since the caller read them;
+//   - the pool read back after the write must point to the IP Set without static members, otherwise
+//     errors.ErrLoadBalancerPoolConflict is returned. In that case the write has been applied and overwritten
+//     by a concurrent one, and the IP Set is kept.
+//
+// Without IfMatch, a modification of the members made since the caller read them is lost.
+// A concurrent write landing between the read and the write of this call is not detected.
+func (c *client) MigratePoolMembersToIPSet(ctx context.Context, poolID, ipSetName string, opts ...PoolMigrateToIPSetOptions) (*PoolModel, error) {
+	if err := poolIDValidator(poolID); err != nil {
+		return nil, err
+	}
+
+	if ipSetName == "" {
+		return nil, fmt.Errorf("ipSetName is %w. Please provide a valid ipSetName", errors.ErrEmpty)
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return nil, err
+	}
+
+	// edgegatewayID is not needed for retreiving the pool by ID
+	pool, err := c.getpool(ctx, "", poolID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving Load Balancer Pool: %w", err)
+	}
+
+	if pool.NsxtAlbPool.MemberGroupRef != nil {
+		return nil, fmt.Errorf("the members of pool %s are already managed by the group %s: %w", poolID, pool.NsxtAlbPool.MemberGroupRef.Name, errors.ErrLoadBalancerPoolMembersManagedByGroup)
+	}
+
+	var (
+		reuseExistingIPSet, allowMemberSettingsLoss bool
+		ifMatch                                     string
+	)
+	for _, opt := range opts {
+		reuseExistingIPSet = reuseExistingIPSet || opt.ReuseExistingIPSet
+		allowMemberSettingsLoss = allowMemberSettingsLoss || opt.AllowMemberSettingsLoss
+		if opt.IfMatch != "" {
+			ifMatch = opt.IfMatch
+		}
+	}
+
+	if ifMatch != "" && poolMembersSignature(pool.NsxtAlbPool.Members) != ifMatch {
+		return nil, errors.ErrLoadBalancerPoolConflict
+	}
+
+	defaultPort := 0
+	if pool.NsxtAlbPool.DefaultPort != nil {
+		defaultPort = *pool.NsxtAlbPool.DefaultPort
+	}
+
+	ipAddresses := make([]string, 0, len(pool.NsxtAlbPool.Members))
+	for _, m := range pool.NsxtAlbPool.Members {
+		if m.Port != 0 && m.Port != defaultPort {
+			return nil, fmt.Errorf("pool member %s has %w. An IP Set member uses the default port %d of the pool", poolMemberKey(m.IpAddress, m.Port), errors.ErrInvalidFormat, defaultPort)
+		}
+
+		if !allowMemberSettingsLoss {
+			if !m.Enabled {
+				return nil, fmt.Errorf("pool member %s is disabled: %w. An IP Set can't hold disabled members, set AllowMemberSettingsLoss to drop them", poolMemberKey(m.IpAddress, m.Port), errors.ErrInvalidFormat)
+			}
+			if m.Ratio != nil && *m.Ratio != 1 {
+				return nil, fmt.Errorf("pool member %s has a ratio of %d: %w. An IP Set can't hold ratios, set AllowMemberSettingsLoss to drop them", poolMemberKey(m.IpAddress, m.Port), *m.Ratio, errors.ErrInvalidFormat)
+			}
+		}
+
+		if m.Enabled {
+			ipAddresses = append(ipAddresses, m.IpAddress)
+		}
+	}
+
+	if len(ipAddresses) == 0 {
+		return nil, fmt.Errorf("enabled members of pool %s are %w. Nothing to migrate", poolID, errors.ErrEmpty)
+	}
+
+	egw, err := c.clientGoVCDOrg.GetNsxtEdgeGatewayById(pool.NsxtAlbPool.GatewayRef.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving edge gateway: %w", err)
+	}
+
+	ipSet, created, err := c.createOrUpdateIPSet(egw, pool.NsxtAlbPool.GatewayRef.ID, ipSetName, ipAddresses, reuseExistingIPSet)
+	if err != nil {
+		return nil, err
+	}
+
+	poolToUpdate := *pool.NsxtAlbPool
+	poolToUpdate.Members = nil
+	poolToUpdate.MemberGroupRef = &govcdtypes.OpenApiReference{
+		ID:   ipSet.NsxtFirewallGroup.ID,
+		Name: ipSet.NsxtFirewallGroup.Name,
+	}
+
+	poolUpdated, err := updatePool(pool, &poolToUpdate)
+	if err != nil {
+		if created {
+			if errDelete := deleteFirewallGroup(ipSet); errDelete != nil {
+				return nil, fmt.Errorf("error updating Load Balancer Pool: %w (deletion of the IP Set %s failed: %w)", err, ipSetName, errDelete)
+			}
+		}
+		return nil, fmt.Errorf("error updating Load Balancer Pool: %w", err)
+	}
+
+	// The updated pool is read back by govcd once the update task is done
+	if poolUpdated.NsxtAlbPool.MemberGroupRef == nil || poolUpdated.NsxtAlbPool.MemberGroupRef.ID != ipSet.NsxtFirewallGroup.ID || len(poolUpdated.NsxtAlbPool.Members) != 0 {
+		return nil, fmt.Errorf("members of pool %s differ from the IP Set %s: %w", poolID, ipSetName, errors.ErrLoadBalancerPoolConflict)
+	}
+
+	return fromVCDNsxtALBPoolToModel(poolUpdated.NsxtAlbPool), nil
+}
+
+// MembersVersion returns the version of the static members of the pool.
+// It can be passed as IfMatch in PoolMigrateToIPSetOptions to detect a modification made since the pool was read.
+func (p *PoolModel) MembersVersion() string {
+	members := make([]govcdtypes.NsxtAlbPoolMember, len(p.Members))
+	for i, m := range p.Members {
+		members[i] = govcdtypes.NsxtAlbPoolMember{
+			Enabled:   m.Enabled,
+			IpAddress: m.IPAddress,
+			Port:      m.Port,
+			Ratio:     m.Ratio,
+		}
+	}
+	return poolMembersSignature(members)
+}
+
+// * Local functions
+
+// resolvePoolMemberGroup sets the MemberGroupRef of the pool from its MemberGroupName.
+// Only IP Sets and Security Groups can be used as members of a pool.
+func (c *client) resolvePoolMemberGroup(pool *PoolModelRequest) error {
+	if pool.MemberGroupName == "" {
+		return nil
+	}
+
+	egw, err := c.clientGoVCDOrg.GetNsxtEdgeGatewayById(pool.GatewayRef.ID)
+	if err != nil {
+		return fmt.Errorf("error retrieving edge gateway: %w", err)
+	}
+
+	groups, err := listFirewallGroups(egw, "")
+	if err != nil {
+		return fmt.Errorf("error retrieving firewall groups: %w", err)
+	}
+
+	var found *govcdtypes.NsxtFirewallGroup
+	for _, group := range groups {
+		if group.NsxtFirewallGroup.Name != pool.MemberGroupName {
+			continue
+		}
+
+		switch firewallGroupType(group.NsxtFirewallGroup) {
+		case govcdtypes.FirewallGroupTypeIpSet, govcdtypes.FirewallGroupTypeSecurityGroup:
+		default:
+			continue
+		}
+
+		if found != nil {
+			return fmt.Errorf("firewall group %s is ambiguous, an IP Set and a Security Group have this name: %w", pool.MemberGroupName, errors.ErrConflict)
+		}
+		found = group.NsxtFirewallGroup
+	}
+
+	if found == nil {
+		return fmt.Errorf("IP Set or Security Group %s %w on edge gateway %s", pool.MemberGroupName, errors.ErrNotFound, pool.GatewayRef.ID)
+	}
+
+	pool.MemberGroupRef = &govcdtypes.OpenApiReference{
+		ID:   found.ID,
+		Name: found.Name,
+	}
+	pool.MemberGroupName = ""
+
+	return nil
+}
+
+// createOrUpdateIPSet sets the IP addresses of the IP Set, the IP Set is created if it does not exist.
+// An existing IP Set is only updated if reuseExisting is true, otherwise errors.ErrConflict is returned.
+// created reports whether the IP Set has been created by the call.
+func (c *client) createOrUpdateIPSet(egw fakeFirewallGroupEdgeGatewayClient, edgeGatewayID, name string, ipAddresses []string, reuseExisting bool) (ipSet *govcd.NsxtFirewallGroup, created bool, err error) {
+	ipSets, err := listFirewallGroups(egw, govcdtypes.FirewallGroupTypeIpSet)
+	if err != nil {
+		return nil, false, fmt.Errorf("error retrieving IP Sets: %w", err)
+	}
+
+	for _, existing := range ipSets {
+		if existing.NsxtFirewallGroup.Name != name {
+			continue
+		}
+
+		if !reuseExisting {
+			return nil, false, fmt.Errorf("IP Set %s already exists and may be referenced by other rules: %w. Set ReuseExistingIPSet to replace its IP addresses", name, errors.ErrConflict)
+		}
+
+		groupToUpdate := *existing.NsxtFirewallGroup
+		groupToUpdate.IpAddresses = ipAddresses
+
+		ipSetUpdated, err := updateFirewallGroup(existing, &groupToUpdate)
+		if err != nil {
+			return nil, false, fmt.Errorf("error updating IP Set %s: %w", name, err)
+		}
+		return ipSetUpdated, false, nil
+	}
+
+	ipSetCreated, err := createFirewallGroup(egw, &govcdtypes.NsxtFirewallGroup{
+		Name:        name,
+		Description: "Members of the load balancer pool",
+		IpAddresses: ipAddresses,
+		OwnerRef:    &govcdtypes.OpenApiReference{ID: edgeGatewayID},
+		TypeValue:   govcdtypes.FirewallGroupTypeIpSet,
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("error creating IP Set %s: %w", name, err)
+	}
+
+	return ipSetCreated, true, nil
+}
+
+// getFirewallGroupVMsIPAddresses returns the IP address of the primary network of the VMs associated to the group.
+func (c *client) getFirewallGroupVMsIPAddresses(group fakeFirewallGroupClient) ([]string, error) {
+	vms, err := getFirewallGroupAssociatedVms(group)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving associated VMs: %w", err)
+	}
+
+	ids := make([]string, 0, len(vms))
+	for _, vm := range vms {
+		if vm.VmRef != nil {
+			ids = append(ids, urn.ExtractUUID(vm.VmRef.ID))
+		}
+	}
+
+	// Only the VMs of the group are retrieved, by batches fitting in a single page
+	ipByVM := make(map[string]string, len(ids))
+	for batch := range slices.Chunk(ids, poolVMsQueryBatchSize) {
+		filters := make([]string, len(batch))
+		for i, id := range batch {
+			filters[i] = "id==" + url.QueryEscape(id)
+		}
+
+		results, err := c.clientGoVCD.QueryWithNotEncodedParams(nil, map[string]string{
+			"type":          govcdtypes.QtVm,
+			"filter":        "(" + strings.Join(filters, ",") + ");" + govcdtypes.VmQueryFilterOnlyDeployed.String(),
+			"filterEncoded": "true",
+			"pageSize":      strconv.Itoa(poolVMsQueryBatchSize),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving VMs: %w", err)
+		}
+
+		if results.Results == nil {
+			continue
+		}
+
+		for _, record := range results.Results.VMRecord {
+			ipByVM[urn.ExtractUUID(record.HREF)] = record.IpAddress
+		}
+	}
+
+	ipAddresses := make([]string, 0, len(vms))
+	for _, vm := range vms {
+		if vm.VmRef == nil {
+			continue
+		}
+
+		if ip := ipByVM[urn.ExtractUUID(vm.VmRef.ID)]; ip != "" {
+			ipAddresses = append(ipAddresses, ip)
+		}
+	}
+
+	return ipAddresses, nil
+}
+
+// expandIPSetAddresses expands the IP addresses, ranges (e.g. 192.168.0.1-192.168.0.10)
+// and CIDRs (e.g. 192.168.0.0/24) of an IP Set into single IP addresses.
+func expandIPSetAddresses(entries []string) ([]string, error) {
+	seen := map[netip.Addr]bool{}
+	ipAddresses := []string{}
+
+	add := func(addr netip.Addr) error {
+		if seen[addr] {
+			return nil
+		}
+		if len(ipAddresses) >= poolEffectiveMembersMax {
+			return fmt.Errorf("the IP Set contains more than %d IP addresses", poolEffectiveMembersMax)
+		}
+		seen[addr] = true
+		ipAddresses = append(ipAddresses, addr.String())
+		return nil
+	}
+
+	for _, entry := range entries {
+		var (
+			start   netip.Addr
+			inRange func(netip.Addr) bool
+		)
+
+		switch {
+		case strings.Contains(entry, "/"):
+			prefix, err := netip.ParsePrefix(entry)
+			if err != nil {
+				return nil, fmt.Errorf("IP Set entry %s has %w", entry, errors.ErrInvalidFormat)
+			}
+			prefix = prefix.Masked()
+			start = prefix.Addr()
+			inRange = prefix.Contains
+		case strings.Contains(entry, "-"):
+			bounds := strings.SplitN(entry, "-", 2)
+			var errStart error
+			start, errStart = netip.ParseAddr(strings.TrimSpace(bounds[0]))
+			end, errEnd := netip.ParseAddr(strings.TrimSpace(bounds[1]))
+			if errStart != nil || errEnd != nil || start.BitLen() != end.BitLen() || end.Less(start) {
+				return nil, fmt.Errorf("IP Set entry %s has %w", entry, errors.ErrInvalidFormat)
+			}
+			inRange = func(addr netip.Addr) bool { return !end.Less(addr) }
+		default:
+			addr, err := netip.ParseAddr(entry)
+			if err != nil {
+				return nil, fmt.Errorf("IP Set entry %s has %w", entry, errors.ErrInvalidFormat)
+			}
+			start = addr
+			inRange = func(a netip.Addr) bool { return a == addr }
+		}
+
+		for addr := start; addr.IsValid() && inRange(addr); addr = addr.Next() {
+			if err := add(addr); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return ipAddresses, nil
+}
+
+// firewallGroupType returns the type of the firewall group, TypeValue is preferred over the deprecated Type.
+func firewallGroupType(group *govcdtypes.NsxtFirewallGroup) string {
+	if group.TypeValue != "" {
+		return group.TypeValue
+	}
+	return group.Type
+}
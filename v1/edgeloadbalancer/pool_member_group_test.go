@@ -0,0 +1,611 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgeloadbalancer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/utils"
+	sdkerrors "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+)
+
+func TestExpandIPSetAddresses(t *testing.T) {
+	tests := []struct {
+		name          string
+		entries       []string
+		expectedValue []string
+		expectedErr   bool
+		err           error
+	}{
+		{
+			name:          testSuccess,
+			entries:       []string{"192.168.0.1", "192.168.0.10-192.168.0.12", "10.0.0.0/30", "192.168.0.11"},
+			expectedValue: []string{"192.168.0.1", "192.168.0.10", "192.168.0.11", "192.168.0.12", "10.0.0.0", "10.0.0.1", "10.0.0.2", "10.0.0.3"},
+		},
+		{
+			name:          "success-ipv6",
+			entries:       []string{"2001:db8::1-2001:db8::2"},
+			expectedValue: []string{"2001:db8::1", "2001:db8::2"},
+		},
+		{
+			name:        "error-invalid-range",
+			entries:     []string{"192.168.0.12-192.168.0.10"},
+			expectedErr: true,
+			err:         sdkerrors.ErrInvalidFormat,
+		},
+		{
+			name:        "error-invalid-address",
+			entries:     []string{"not-an-ip"},
+			expectedErr: true,
+			err:         sdkerrors.ErrInvalidFormat,
+		},
+		{
+			name:        "error-too-many-addresses",
+			entries:     []string{"10.0.0.0/8"},
+			expectedErr: true,
+			err:         errors.New("the IP Set contains more than"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ipAddresses, err := expandIPSetAddresses(tc.entries)
+			if !tc.expectedErr {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedValue, ipAddresses)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Nil(t, ipAddresses)
+			if errors.Is(err, tc.err) {
+				return
+			}
+			assert.Contains(t, err.Error(), tc.err.Error())
+		})
+	}
+}
+
+func TestClient_resolvePoolMemberGroup(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientFake(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	ipSetID := urn.SecurityGroup.String() + uuid.New().String()
+
+	groups := []*govcd.NsxtFirewallGroup{
+		{NsxtFirewallGroup: &govcdtypes.NsxtFirewallGroup{ID: ipSetID, Name: "web", TypeValue: govcdtypes.FirewallGroupTypeIpSet}},
+		{NsxtFirewallGroup: &govcdtypes.NsxtFirewallGroup{ID: uuid.New().String(), Name: "dynamic", TypeValue: govcdtypes.FirewallGroupTypeVmCriteria}},
+		{NsxtFirewallGroup: &govcdtypes.NsxtFirewallGroup{ID: uuid.New().String(), Name: "twice", TypeValue: govcdtypes.FirewallGroupTypeIpSet}},
+		{NsxtFirewallGroup: &govcdtypes.NsxtFirewallGroup{ID: uuid.New().String(), Name: "twice", Type: govcdtypes.FirewallGroupTypeSecurityGroup}},
+	}
+
+	defer func(f func(fakeFirewallGroupEdgeGatewayClient, string) ([]*govcd.NsxtFirewallGroup, error)) {
+		listFirewallGroups = f
+	}(listFirewallGroups)
+
+	listFirewallGroups = func(_ fakeFirewallGroupEdgeGatewayClient, _ string) ([]*govcd.NsxtFirewallGroup, error) {
+		return groups, nil
+	}
+
+	tests := []struct {
+		name          string
+		groupName     string
+		expectedValue *govcdtypes.OpenApiReference
+		expectedErr   bool
+		err           error
+	}{
+		{
+			name:          testSuccess,
+			groupName:     "web",
+			expectedValue: &govcdtypes.OpenApiReference{ID: ipSetID, Name: "web"},
+		},
+		{
+			name:        "error-dynamic-group",
+			groupName:   "dynamic",
+			expectedErr: true,
+			err:         sdkerrors.ErrNotFound,
+		},
+		{
+			name:        "error-ambiguous",
+			groupName:   "twice",
+			expectedErr: true,
+			err:         sdkerrors.ErrConflict,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(&govcd.NsxtEdgeGateway{}, nil)
+
+			pool := PoolModelRequest{
+				GatewayRef:      govcdtypes.OpenApiReference{ID: edgeGatewayID},
+				MemberGroupName: tc.groupName,
+			}
+
+			err := c.(*client).resolvePoolMemberGroup(&pool)
+			if !tc.expectedErr {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedValue, pool.MemberGroupRef)
+				assert.Empty(t, pool.MemberGroupName)
+				return
+			}
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.Nil(t, pool.MemberGroupRef)
+		})
+	}
+}
+
+func TestClient_GetEffectiveMembers(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientFake(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	poolID := urn.LoadBalancerPool.String() + uuid.New().String()
+	groupID := urn.SecurityGroup.String() + uuid.New().String()
+	vmUUID := uuid.New().String()
+
+	pool := func(members []govcdtypes.NsxtAlbPoolMember, group *govcdtypes.OpenApiReference) *govcd.NsxtAlbPool {
+		return &govcd.NsxtAlbPool{
+			NsxtAlbPool: &govcdtypes.NsxtAlbPool{
+				ID:             poolID,
+				GatewayRef:     govcdtypes.OpenApiReference{ID: edgeGatewayID},
+				DefaultPort:    utils.ToPTR(8080),
+				Members:        members,
+				MemberGroupRef: group,
+			},
+		}
+	}
+
+	defer func(f func(fakeFirewallGroupEdgeGatewayClient, string) (*govcd.NsxtFirewallGroup, error)) {
+		getFirewallGroup = f
+	}(getFirewallGroup)
+	defer func(f func(fakeFirewallGroupClient) ([]*govcdtypes.NsxtFirewallGroupMemberVms, error)) {
+		getFirewallGroupAssociatedVms = f
+	}(getFirewallGroupAssociatedVms)
+
+	tests := []struct {
+		name          string
+		mockFunc      func()
+		expectedValue []PoolModelMember
+		expectedErr   bool
+		err           error
+	}{
+		{
+			name: "success-static-members",
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetAlbPoolById(poolID).Return(pool([]govcdtypes.NsxtAlbPoolMember{
+					{Enabled: true, IpAddress: testIPAddress, Port: 80, HealthStatus: PoolMemberHealthStatusUp},
+				}, nil), nil)
+			},
+			expectedValue: []PoolModelMember{
+				{Enabled: true, IPAddress: testIPAddress, Port: 80, HealthStatus: PoolMemberHealthStatusUp},
+			},
+		},
+		{
+			name: "success-ip-set",
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetAlbPoolById(poolID).Return(pool([]govcdtypes.NsxtAlbPoolMember{
+					{Enabled: true, IpAddress: "192.168.0.2", Port: 8080, HealthStatus: PoolMemberHealthStatusDown, MarkedDownBy: []string{"System-HTTP"}},
+				}, &govcdtypes.OpenApiReference{ID: groupID, Name: "web"}), nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(&govcd.NsxtEdgeGateway{}, nil)
+				getFirewallGroup = func(_ fakeFirewallGroupEdgeGatewayClient, _ string) (*govcd.NsxtFirewallGroup, error) {
+					return &govcd.NsxtFirewallGroup{NsxtFirewallGroup: &govcdtypes.NsxtFirewallGroup{
+						ID:          groupID,
+						Name:        "web",
+						TypeValue:   govcdtypes.FirewallGroupTypeIpSet,
+						IpAddresses: []string{"192.168.0.1-192.168.0.2"},
+					}}, nil
+				}
+			},
+			expectedValue: []PoolModelMember{
+				{Enabled: true, IPAddress: "192.168.0.1", Port: 8080, HealthStatus: PoolMemberHealthStatusUnknown},
+				{Enabled: true, IPAddress: "192.168.0.2", Port: 8080, HealthStatus: PoolMemberHealthStatusUnknown},
+			},
+		},
+		{
+			name: "success-security-group",
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetAlbPoolById(poolID).Return(pool(nil, &govcdtypes.OpenApiReference{ID: groupID, Name: "vms"}), nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(&govcd.NsxtEdgeGateway{}, nil)
+				getFirewallGroup = func(_ fakeFirewallGroupEdgeGatewayClient, _ string) (*govcd.NsxtFirewallGroup, error) {
+					return &govcd.NsxtFirewallGroup{NsxtFirewallGroup: &govcdtypes.NsxtFirewallGroup{
+						ID:        groupID,
+						Name:      "vms",
+						TypeValue: govcdtypes.FirewallGroupTypeSecurityGroup,
+					}}, nil
+				}
+				getFirewallGroupAssociatedVms = func(_ fakeFirewallGroupClient) ([]*govcdtypes.NsxtFirewallGroupMemberVms, error) {
+					return []*govcdtypes.NsxtFirewallGroupMemberVms{
+						{VmRef: &govcdtypes.OpenApiReference{ID: urn.VM.String() + vmUUID}},
+						{VmRef: &govcdtypes.OpenApiReference{ID: urn.VM.String() + uuid.New().String()}},
+					}, nil
+				}
+				clientCAV.EXPECT().QueryWithNotEncodedParams(gomock.Nil(), gomock.Any()).DoAndReturn(func(_, params map[string]string) (govcd.Results, error) {
+					// Only the VMs of the group are queried
+					assert.Equal(t, govcdtypes.QtVm, params["type"])
+					assert.Contains(t, params["filter"], "id=="+vmUUID)
+					return govcd.Results{Results: &govcdtypes.QueryResultRecordsType{VMRecord: []*govcdtypes.QueryResultVMRecordType{
+						{HREF: "https://vcd/api/vApp/vm-" + vmUUID, IpAddress: testIPAddress},
+					}}}, nil
+				})
+			},
+			expectedValue: []PoolModelMember{
+				{Enabled: true, IPAddress: testIPAddress, Port: 8080, HealthStatus: PoolMemberHealthStatusUnknown},
+			},
+		},
+		{
+			name: "error-get-firewall-group",
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetAlbPoolById(poolID).Return(pool(nil, &govcdtypes.OpenApiReference{ID: groupID, Name: "web"}), nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(&govcd.NsxtEdgeGateway{}, nil)
+				getFirewallGroup = func(_ fakeFirewallGroupEdgeGatewayClient, _ string) (*govcd.NsxtFirewallGroup, error) {
+					return nil, errors.New("error")
+				}
+			},
+			expectedErr: true,
+			err:         errors.New("error retrieving firewall group web"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockFunc()
+
+			members, err := c.GetEffectiveMembers(context.Background(), poolID)
+			if !tc.expectedErr {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedValue, members)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Nil(t, members)
+			if errors.Is(err, tc.err) {
+				return
+			}
+			assert.Contains(t, err.Error(), tc.err.Error())
+		})
+	}
+}
+
+func TestClient_MigratePoolMembersToIPSet(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientFake(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+	poolID := urn.LoadBalancerPool.String() + uuid.New().String()
+	ipSetID := urn.SecurityGroup.String() + uuid.New().String()
+
+	pool := func(members ...govcdtypes.NsxtAlbPoolMember) *govcd.NsxtAlbPool {
+		return &govcd.NsxtAlbPool{
+			NsxtAlbPool: &govcdtypes.NsxtAlbPool{
+				ID:          poolID,
+				GatewayRef:  govcdtypes.OpenApiReference{ID: edgeGatewayID},
+				DefaultPort: utils.ToPTR(80),
+				Members:     members,
+			},
+		}
+	}
+
+	defer func(f func(fakeFirewallGroupEdgeGatewayClient, string) ([]*govcd.NsxtFirewallGroup, error)) {
+		listFirewallGroups = f
+	}(listFirewallGroups)
+	defer func(f func(fakeFirewallGroupEdgeGatewayClient, *govcdtypes.NsxtFirewallGroup) (*govcd.NsxtFirewallGroup, error)) {
+		createFirewallGroup = f
+	}(createFirewallGroup)
+	defer func(f func(fakeFirewallGroupClient, *govcdtypes.NsxtFirewallGroup) (*govcd.NsxtFirewallGroup, error)) {
+		updateFirewallGroup = f
+	}(updateFirewallGroup)
+	defer func(f func(fakeFirewallGroupClient) error) {
+		deleteFirewallGroup = f
+	}(deleteFirewallGroup)
+	defer func(f func(fakePoolClient, *govcdtypes.NsxtAlbPool) (*govcd.NsxtAlbPool, error)) {
+		updatePool = f
+	}(updatePool)
+
+	var (
+		createdIPSet   *govcdtypes.NsxtFirewallGroup
+		updatedIPSet   *govcdtypes.NsxtFirewallGroup
+		deletedIPSetID string
+		updatedPool    *govcdtypes.NsxtAlbPool
+		updatePoolErr  error
+		overwritten    bool
+	)
+
+	createFirewallGroup = func(_ fakeFirewallGroupEdgeGatewayClient, group *govcdtypes.NsxtFirewallGroup) (*govcd.NsxtFirewallGroup, error) {
+		createdIPSet = group
+		created := *group
+		created.ID = ipSetID
+		return &govcd.NsxtFirewallGroup{NsxtFirewallGroup: &created}, nil
+	}
+	updateFirewallGroup = func(_ fakeFirewallGroupClient, group *govcdtypes.NsxtFirewallGroup) (*govcd.NsxtFirewallGroup, error) {
+		updatedIPSet = group
+		return &govcd.NsxtFirewallGroup{NsxtFirewallGroup: group}, nil
+	}
+	deleteFirewallGroup = func(groupClient fakeFirewallGroupClient) error {
+		deletedIPSetID = groupClient.(*govcd.NsxtFirewallGroup).NsxtFirewallGroup.ID
+		return nil
+	}
+	updatePool = func(_ fakePoolClient, p *govcdtypes.NsxtAlbPool) (*govcd.NsxtAlbPool, error) {
+		if updatePoolErr != nil {
+			return nil, updatePoolErr
+		}
+		updatedPool = p
+		if overwritten {
+			// A concurrent write restores the static members
+			return pool(govcdtypes.NsxtAlbPoolMember{Enabled: true, IpAddress: "192.168.0.1", Port: 80}), nil
+		}
+		return &govcd.NsxtAlbPool{NsxtAlbPool: p}, nil
+	}
+
+	existingIPSets := func(_ fakeFirewallGroupEdgeGatewayClient, _ string) ([]*govcd.NsxtFirewallGroup, error) {
+		return []*govcd.NsxtFirewallGroup{
+			{NsxtFirewallGroup: &govcdtypes.NsxtFirewallGroup{ID: ipSetID, Name: "web", TypeValue: govcdtypes.FirewallGroupTypeIpSet, IpAddresses: []string{"10.0.0.1"}}},
+		}, nil
+	}
+
+	members := []govcdtypes.NsxtAlbPoolMember{
+		{Enabled: true, IpAddress: "192.168.0.1", Port: 80},
+		{Enabled: false, IpAddress: "192.168.0.2", Port: 80},
+		{Enabled: true, IpAddress: "192.168.0.3"},
+	}
+
+	tests := []struct {
+		name          string
+		mockFunc      func()
+		ipSetName     string
+		opts          []PoolMigrateToIPSetOptions
+		expectedIPSet func(t *testing.T)
+		poolWritten   bool
+		expectedErr   bool
+		err           error
+	}{
+		{
+			name:      "success-create-ip-set",
+			ipSetName: "web",
+			opts:      []PoolMigrateToIPSetOptions{{AllowMemberSettingsLoss: true}},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetAlbPoolById(poolID).Return(pool(members...), nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(&govcd.NsxtEdgeGateway{}, nil)
+				listFirewallGroups = func(_ fakeFirewallGroupEdgeGatewayClient, _ string) ([]*govcd.NsxtFirewallGroup, error) {
+					return []*govcd.NsxtFirewallGroup{}, nil
+				}
+			},
+			expectedIPSet: func(t *testing.T) {
+				t.Helper()
+				assert.Nil(t, updatedIPSet)
+				assert.Equal(t, []string{"192.168.0.1", "192.168.0.3"}, createdIPSet.IpAddresses)
+				assert.Equal(t, govcdtypes.FirewallGroupTypeIpSet, createdIPSet.TypeValue)
+				assert.Equal(t, edgeGatewayID, createdIPSet.OwnerRef.ID)
+				assert.Empty(t, deletedIPSetID)
+			},
+		},
+		{
+			name:      "success-if-match",
+			ipSetName: "web",
+			opts: []PoolMigrateToIPSetOptions{{AllowMemberSettingsLoss: true, IfMatch: (&PoolModel{Members: []PoolModelMember{
+				{Enabled: true, IPAddress: "192.168.0.1", Port: 80},
+				{Enabled: false, IPAddress: "192.168.0.2", Port: 80},
+				{Enabled: true, IPAddress: "192.168.0.3"},
+			}}).MembersVersion()}},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetAlbPoolById(poolID).Return(pool(members...), nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(&govcd.NsxtEdgeGateway{}, nil)
+				listFirewallGroups = func(_ fakeFirewallGroupEdgeGatewayClient, _ string) ([]*govcd.NsxtFirewallGroup, error) {
+					return []*govcd.NsxtFirewallGroup{}, nil
+				}
+			},
+			expectedIPSet: func(t *testing.T) {
+				t.Helper()
+				assert.Equal(t, []string{"192.168.0.1", "192.168.0.3"}, createdIPSet.IpAddresses)
+			},
+		},
+		{
+			name:      "success-update-ip-set",
+			ipSetName: "web",
+			opts:      []PoolMigrateToIPSetOptions{{ReuseExistingIPSet: true, AllowMemberSettingsLoss: true}},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetAlbPoolById(poolID).Return(pool(members...), nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(&govcd.NsxtEdgeGateway{}, nil)
+				listFirewallGroups = existingIPSets
+			},
+			expectedIPSet: func(t *testing.T) {
+				t.Helper()
+				assert.Nil(t, createdIPSet)
+				assert.Equal(t, []string{"192.168.0.1", "192.168.0.3"}, updatedIPSet.IpAddresses)
+			},
+		},
+		{
+			name:      "error-existing-ip-set",
+			ipSetName: "web",
+			opts:      []PoolMigrateToIPSetOptions{{AllowMemberSettingsLoss: true}},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetAlbPoolById(poolID).Return(pool(members...), nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(&govcd.NsxtEdgeGateway{}, nil)
+				listFirewallGroups = existingIPSets
+			},
+			expectedErr: true,
+			err:         sdkerrors.ErrConflict,
+		},
+		{
+			name:      "error-update-pool-ip-set-deleted",
+			ipSetName: "web",
+			opts:      []PoolMigrateToIPSetOptions{{AllowMemberSettingsLoss: true}},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetAlbPoolById(poolID).Return(pool(members...), nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(&govcd.NsxtEdgeGateway{}, nil)
+				listFirewallGroups = func(_ fakeFirewallGroupEdgeGatewayClient, _ string) ([]*govcd.NsxtFirewallGroup, error) {
+					return []*govcd.NsxtFirewallGroup{}, nil
+				}
+				updatePoolErr = errors.New("error updating pool")
+			},
+			expectedIPSet: func(t *testing.T) {
+				t.Helper()
+				assert.NotNil(t, createdIPSet)
+				assert.Equal(t, ipSetID, deletedIPSetID)
+			},
+			expectedErr: true,
+			err:         errors.New("error updating pool"),
+		},
+		{
+			name:      "error-member-port",
+			ipSetName: "web",
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetAlbPoolById(poolID).Return(pool(govcdtypes.NsxtAlbPoolMember{Enabled: true, IpAddress: testIPAddress, Port: 8080}), nil)
+			},
+			expectedErr: true,
+			err:         sdkerrors.ErrInvalidFormat,
+		},
+		{
+			name:      "error-disabled-member",
+			ipSetName: "web",
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetAlbPoolById(poolID).Return(pool(members...), nil)
+			},
+			expectedErr: true,
+			err:         errors.New("pool member 192.168.0.2:80 is disabled"),
+		},
+		{
+			name:      "error-member-ratio",
+			ipSetName: "web",
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetAlbPoolById(poolID).Return(pool(govcdtypes.NsxtAlbPoolMember{Enabled: true, IpAddress: testIPAddress, Port: 80, Ratio: utils.ToPTR(2)}), nil)
+			},
+			expectedErr: true,
+			err:         sdkerrors.ErrInvalidFormat,
+		},
+		{
+			name:      "error-conflict",
+			ipSetName: "web",
+			// The caller read the pool with a single member
+			opts: []PoolMigrateToIPSetOptions{{AllowMemberSettingsLoss: true, IfMatch: (&PoolModel{Members: []PoolModelMember{{Enabled: true, IPAddress: "192.168.0.1", Port: 80}}}).MembersVersion()}},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetAlbPoolById(poolID).Return(pool(members...), nil)
+			},
+			expectedErr: true,
+			err:         sdkerrors.ErrLoadBalancerPoolConflict,
+		},
+		{
+			name:      "error-concurrent-write",
+			ipSetName: "web",
+			opts:      []PoolMigrateToIPSetOptions{{AllowMemberSettingsLoss: true}},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetAlbPoolById(poolID).Return(pool(members...), nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(&govcd.NsxtEdgeGateway{}, nil)
+				listFirewallGroups = func(_ fakeFirewallGroupEdgeGatewayClient, _ string) ([]*govcd.NsxtFirewallGroup, error) {
+					return []*govcd.NsxtFirewallGroup{}, nil
+				}
+				overwritten = true
+			},
+			expectedIPSet: func(t *testing.T) {
+				t.Helper()
+				// The write has been applied, the IP Set is kept
+				assert.NotNil(t, createdIPSet)
+				assert.Empty(t, deletedIPSetID)
+			},
+			poolWritten: true,
+			expectedErr: true,
+			err:         sdkerrors.ErrLoadBalancerPoolConflict,
+		},
+		{
+			name:      "error-managed-by-group",
+			ipSetName: "web",
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				p := pool()
+				p.NsxtAlbPool.MemberGroupRef = &govcdtypes.OpenApiReference{ID: ipSetID, Name: "web"}
+				clientCAV.EXPECT().GetAlbPoolById(poolID).Return(p, nil)
+			},
+			expectedErr: true,
+			err:         sdkerrors.ErrLoadBalancerPoolMembersManagedByGroup,
+		},
+		{
+			name:        "error-empty-ip-set-name",
+			mockFunc:    func() {},
+			expectedErr: true,
+			err:         sdkerrors.ErrEmpty,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			createdIPSet, updatedIPSet, deletedIPSetID, updatedPool, updatePoolErr, overwritten = nil, nil, "", nil, nil, false
+
+			tc.mockFunc()
+
+			p, err := c.MigratePoolMembersToIPSet(context.Background(), poolID, tc.ipSetName, tc.opts...)
+			if !tc.expectedErr {
+				assert.NoError(t, err)
+				tc.expectedIPSet(t)
+				assert.Equal(t, &govcdtypes.OpenApiReference{ID: ipSetID, Name: "web"}, p.MemberGroupRef)
+				assert.Nil(t, updatedPool.Members)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Nil(t, p)
+			assert.Equal(t, tc.poolWritten, updatedPool != nil)
+			assert.Nil(t, updatedIPSet)
+			if tc.expectedIPSet != nil {
+				tc.expectedIPSet(t)
+			} else {
+				assert.Nil(t, createdIPSet)
+			}
+			if errors.Is(err, tc.err) {
+				return
+			}
+			assert.Contains(t, err.Error(), tc.err.Error())
+		})
+	}
+}
@@ -0,0 +1,441 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgeloadbalancer
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/utils"
+	sdkerrors "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+)
+
+const testIPAddress2 = "192.168.0.2"
+
+// testVCDPool returns a pool with the given members.
+func testVCDPool(poolID string, members ...govcdtypes.NsxtAlbPoolMember) *govcd.NsxtAlbPool {
+	return &govcd.NsxtAlbPool{
+		NsxtAlbPool: &govcdtypes.NsxtAlbPool{
+			ID:                    poolID,
+			Name:                  testPoolName1,
+			Enabled:               utils.ToPTR(true),
+			GracefulTimeoutPeriod: utils.ToPTR(1),
+			Members:               members,
+		},
+	}
+}
+
+func TestClient_AddPoolMember(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientFake(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	urnPool := urn.LoadBalancerPool.String() + uuid.New().String()
+	existing := govcdtypes.NsxtAlbPoolMember{Enabled: true, IpAddress: testIPAddress, Port: 80}
+
+	var updated *govcdtypes.NsxtAlbPool
+	updatePool = func(_ fakePoolClient, pool *govcdtypes.NsxtAlbPool) (*govcd.NsxtAlbPool, error) {
+		updated = pool
+		return &govcd.NsxtAlbPool{NsxtAlbPool: pool}, nil
+	}
+
+	tests := []struct {
+		name          string
+		poolID        string
+		member        PoolModelMember
+		mockFunc      func()
+		expectedValue []govcdtypes.NsxtAlbPoolMember
+		expectedErr   bool
+		err           error
+	}{
+		{
+			name:   testSuccess,
+			poolID: urnPool,
+			member: PoolModelMember{Enabled: true, IPAddress: testIPAddress2, Port: 80, Ratio: utils.ToPTR(2)},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetAlbPoolById(urnPool).Return(testVCDPool(urnPool, existing), nil).Times(2)
+			},
+			expectedValue: []govcdtypes.NsxtAlbPoolMember{
+				existing,
+				{Enabled: true, IpAddress: testIPAddress2, Port: 80, Ratio: utils.ToPTR(2)},
+			},
+		},
+		{
+			name:   "error-already-present",
+			poolID: urnPool,
+			member: PoolModelMember{Enabled: true, IPAddress: testIPAddress, Port: 80},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetAlbPoolById(urnPool).Return(testVCDPool(urnPool, existing), nil)
+			},
+			expectedErr: true,
+			err:         sdkerrors.ErrAlreadyExists,
+		},
+		{
+			name:   "error-concurrent-modification",
+			poolID: urnPool,
+			member: PoolModelMember{Enabled: true, IPAddress: testIPAddress2, Port: 80},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				gomock.InOrder(
+					clientCAV.EXPECT().GetAlbPoolById(urnPool).Return(testVCDPool(urnPool, existing), nil),
+					clientCAV.EXPECT().GetAlbPoolById(urnPool).Return(testVCDPool(urnPool), nil),
+				)
+			},
+			expectedErr: true,
+			err:         sdkerrors.ErrLoadBalancerPoolConflict,
+		},
+		{
+			name:   "error-member-group",
+			poolID: urnPool,
+			member: PoolModelMember{Enabled: true, IPAddress: testIPAddress2},
+			mockFunc: func() {
+				pool := testVCDPool(urnPool)
+				pool.NsxtAlbPool.MemberGroupRef = &govcdtypes.OpenApiReference{Name: testName}
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetAlbPoolById(urnPool).Return(pool, nil)
+			},
+			expectedErr: true,
+			err:         errors.New("are managed by the group"),
+		},
+		{
+			name:        "error-invalid-ip",
+			poolID:      urnPool,
+			member:      PoolModelMember{IPAddress: "invalid"},
+			mockFunc:    func() {},
+			expectedErr: true,
+			err:         sdkerrors.ErrInvalidFormat,
+		},
+		{
+			name:        "error-invalid-ratio",
+			poolID:      urnPool,
+			member:      PoolModelMember{IPAddress: testIPAddress2, Ratio: utils.ToPTR(21)},
+			mockFunc:    func() {},
+			expectedErr: true,
+			err:         sdkerrors.ErrInvalidFormat,
+		},
+		{
+			name:        "error-empty-pool-id",
+			poolID:      "",
+			member:      PoolModelMember{IPAddress: testIPAddress2},
+			mockFunc:    func() {},
+			expectedErr: true,
+			err:         sdkerrors.ErrEmpty,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			updated = nil
+			tc.mockFunc()
+
+			pool, err := c.AddPoolMember(context.Background(), tc.poolID, tc.member)
+			if !tc.expectedErr {
+				assert.NoError(t, err)
+				assert.NotNil(t, pool)
+				assert.Equal(t, tc.expectedValue, updated.Members)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Nil(t, pool)
+			assert.Nil(t, updated)
+			// A duplicate member is a permanent failure, it must not be retried as a conflict
+			assert.Equal(t, errors.Is(tc.err, sdkerrors.ErrConflict), sdkerrors.IsConflict(err))
+			if errors.Is(err, tc.err) {
+				return
+			}
+			assert.Contains(t, err.Error(), tc.err.Error())
+		})
+	}
+
+	t.Run("error-concurrent-write", func(t *testing.T) {
+		// A concurrent write replaced the members between the write and the read back
+		updatePool = func(_ fakePoolClient, pool *govcdtypes.NsxtAlbPool) (*govcd.NsxtAlbPool, error) {
+			updated = pool
+			overwritten := *pool
+			overwritten.Members = []govcdtypes.NsxtAlbPoolMember{existing}
+			return &govcd.NsxtAlbPool{NsxtAlbPool: &overwritten}, nil
+		}
+
+		clientCAV.EXPECT().Refresh().Return(nil)
+		clientCAV.EXPECT().GetAlbPoolById(urnPool).Return(testVCDPool(urnPool, existing), nil).Times(2)
+
+		pool, err := c.AddPoolMember(context.Background(), urnPool, PoolModelMember{Enabled: true, IPAddress: testIPAddress2, Port: 80})
+		assert.Nil(t, pool)
+		assert.ErrorIs(t, err, sdkerrors.ErrLoadBalancerPoolConflict)
+		assert.Len(t, updated.Members, 2)
+	})
+
+	t.Run("success-default-ratio-read-back", func(t *testing.T) {
+		// VCD sets the default ratio of a member added without ratio
+		updatePool = func(_ fakePoolClient, pool *govcdtypes.NsxtAlbPool) (*govcd.NsxtAlbPool, error) {
+			readBack := *pool
+			readBack.Members = slices.Clone(pool.Members)
+			for i := range readBack.Members {
+				readBack.Members[i].Ratio = utils.ToPTR(defaultPoolMemberRatio)
+			}
+			return &govcd.NsxtAlbPool{NsxtAlbPool: &readBack}, nil
+		}
+
+		clientCAV.EXPECT().Refresh().Return(nil)
+		clientCAV.EXPECT().GetAlbPoolById(urnPool).Return(testVCDPool(urnPool, existing), nil).Times(2)
+
+		pool, err := c.AddPoolMember(context.Background(), urnPool, PoolModelMember{Enabled: true, IPAddress: testIPAddress2, Port: 80})
+		assert.NoError(t, err)
+		assert.Len(t, pool.Members, 2)
+	})
+}
+
+func TestClient_RemovePoolMember(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientFake(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	urnPool := urn.LoadBalancerPool.String() + uuid.New().String()
+	member1 := govcdtypes.NsxtAlbPoolMember{Enabled: true, IpAddress: testIPAddress, Port: 80}
+	member2 := govcdtypes.NsxtAlbPoolMember{Enabled: true, IpAddress: testIPAddress, Port: 8080}
+
+	var updated *govcdtypes.NsxtAlbPool
+	updatePool = func(_ fakePoolClient, pool *govcdtypes.NsxtAlbPool) (*govcd.NsxtAlbPool, error) {
+		updated = pool
+		return &govcd.NsxtAlbPool{NsxtAlbPool: pool}, nil
+	}
+
+	t.Run(testSuccess, func(t *testing.T) {
+		updated = nil
+		clientCAV.EXPECT().Refresh().Return(nil)
+		clientCAV.EXPECT().GetAlbPoolById(urnPool).Return(testVCDPool(urnPool, member1, member2), nil).Times(2)
+
+		_, err := c.RemovePoolMember(context.Background(), urnPool, testIPAddress, 8080)
+		assert.NoError(t, err)
+		assert.Equal(t, []govcdtypes.NsxtAlbPoolMember{member1}, updated.Members)
+	})
+
+	t.Run("error-not-found", func(t *testing.T) {
+		updated = nil
+		clientCAV.EXPECT().Refresh().Return(nil)
+		clientCAV.EXPECT().GetAlbPoolById(urnPool).Return(testVCDPool(urnPool, member1), nil)
+
+		_, err := c.RemovePoolMember(context.Background(), urnPool, testIPAddress, 8080)
+		assert.ErrorIs(t, err, sdkerrors.ErrNotFound)
+		assert.Nil(t, updated)
+	})
+}
+
+func TestClient_SetPoolMemberEnabled(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientFake(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	urnPool := urn.LoadBalancerPool.String() + uuid.New().String()
+	member1 := govcdtypes.NsxtAlbPoolMember{Enabled: true, IpAddress: testIPAddress, Port: 80}
+	member2 := govcdtypes.NsxtAlbPoolMember{Enabled: true, IpAddress: testIPAddress2, Port: 80}
+
+	var updated *govcdtypes.NsxtAlbPool
+	updatePool = func(_ fakePoolClient, pool *govcdtypes.NsxtAlbPool) (*govcd.NsxtAlbPool, error) {
+		updated = pool
+		return &govcd.NsxtAlbPool{NsxtAlbPool: pool}, nil
+	}
+
+	clientCAV.EXPECT().Refresh().Return(nil)
+	clientCAV.EXPECT().GetAlbPoolById(urnPool).Return(testVCDPool(urnPool, member1, member2), nil).Times(2)
+
+	_, err := c.SetPoolMemberEnabled(context.Background(), urnPool, testIPAddress2, 80, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []govcdtypes.NsxtAlbPoolMember{
+		member1,
+		{Enabled: false, IpAddress: testIPAddress2, Port: 80},
+	}, updated.Members)
+}
+
+func TestClient_SetPoolMemberRatio(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientFake(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	urnPool := urn.LoadBalancerPool.String() + uuid.New().String()
+	member := govcdtypes.NsxtAlbPoolMember{Enabled: true, IpAddress: testIPAddress, Port: 80, Ratio: utils.ToPTR(1)}
+
+	var updated *govcdtypes.NsxtAlbPool
+	updatePool = func(_ fakePoolClient, pool *govcdtypes.NsxtAlbPool) (*govcd.NsxtAlbPool, error) {
+		updated = pool
+		return &govcd.NsxtAlbPool{NsxtAlbPool: pool}, nil
+	}
+
+	t.Run(testSuccess, func(t *testing.T) {
+		clientCAV.EXPECT().Refresh().Return(nil)
+		clientCAV.EXPECT().GetAlbPoolById(urnPool).Return(testVCDPool(urnPool, member), nil).Times(2)
+
+		_, err := c.SetPoolMemberRatio(context.Background(), urnPool, testIPAddress, 80, 5)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, *updated.Members[0].Ratio)
+	})
+
+	t.Run("error-invalid-ratio", func(t *testing.T) {
+		_, err := c.SetPoolMemberRatio(context.Background(), urnPool, testIPAddress, 80, 0)
+		assert.ErrorIs(t, err, sdkerrors.ErrInvalidFormat)
+	})
+}
+
+func TestClient_RollingMaintenance(t *testing.T) {
+	// Speed up the draining and the polling
+	defer func(drainUnit, minInterval, maxInterval time.Duration) {
+		poolMemberDrainUnit, utils.PollMinInterval, utils.PollMaxInterval = drainUnit, minInterval, maxInterval
+	}(poolMemberDrainUnit, utils.PollMinInterval, utils.PollMaxInterval)
+	poolMemberDrainUnit, utils.PollMinInterval, utils.PollMaxInterval = time.Millisecond, time.Millisecond, 2*time.Millisecond
+
+	urnPool := urn.LoadBalancerPool.String() + uuid.New().String()
+
+	// newPoolState returns a fake pool where the members report UP after the second poll once enabled.
+	newPoolState := func(t *testing.T, clientCAV *MockclientFake) *govcdtypes.NsxtAlbPool {
+		t.Helper()
+
+		state := testVCDPool(urnPool,
+			govcdtypes.NsxtAlbPoolMember{Enabled: true, IpAddress: testIPAddress, Port: 80, HealthStatus: PoolMemberHealthStatusUp},
+			govcdtypes.NsxtAlbPoolMember{Enabled: false, IpAddress: testIPAddress2, Port: 80, HealthStatus: PoolMemberHealthStatusDisabled},
+			govcdtypes.NsxtAlbPoolMember{Enabled: true, IpAddress: testIPAddress2, Port: 8080, HealthStatus: PoolMemberHealthStatusUp},
+		).NsxtAlbPool
+
+		clientCAV.EXPECT().Refresh().Return(nil).AnyTimes()
+		clientCAV.EXPECT().GetAlbPoolById(urnPool).DoAndReturn(func(_ string) (*govcd.NsxtAlbPool, error) {
+			pool := *state
+			pool.Members = make([]govcdtypes.NsxtAlbPoolMember, len(state.Members))
+			copy(pool.Members, state.Members)
+
+			// Members coming back from maintenance are UP on the next poll
+			for i := range state.Members {
+				if state.Members[i].Enabled && state.Members[i].HealthStatus != PoolMemberHealthStatusUp {
+					state.Members[i].HealthStatus = PoolMemberHealthStatusUp
+				}
+			}
+			return &govcd.NsxtAlbPool{NsxtAlbPool: &pool}, nil
+		}).AnyTimes()
+
+		updatePool = func(_ fakePoolClient, pool *govcdtypes.NsxtAlbPool) (*govcd.NsxtAlbPool, error) {
+			for i := range pool.Members {
+				if pool.Members[i].Enabled {
+					pool.Members[i].HealthStatus = PoolMemberHealthStatusDown
+				} else {
+					pool.Members[i].HealthStatus = PoolMemberHealthStatusDisabled
+				}
+			}
+			*state = *pool
+			return &govcd.NsxtAlbPool{NsxtAlbPool: pool}, nil
+		}
+
+		return state
+	}
+
+	t.Run(testSuccess, func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientCAV := NewMockclientFake(ctrl)
+		c, _ := NewFakeClient(clientCAV)
+
+		state := newPoolState(t, clientCAV)
+
+		var maintained []string
+		err := c.RollingMaintenance(context.Background(), urnPool, func(_ context.Context, member PoolModelMember) error {
+			// Only the member under maintenance is disabled
+			for _, m := range state.Members {
+				if m.IpAddress == member.IPAddress && m.Port == member.Port {
+					assert.False(t, m.Enabled)
+				}
+			}
+			maintained = append(maintained, poolMemberKey(member.IPAddress, member.Port))
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"192.168.0.1:80", "192.168.0.2:8080"}, maintained)
+
+		// The members are restored in their initial state
+		assert.True(t, state.Members[0].Enabled)
+		assert.False(t, state.Members[1].Enabled)
+		assert.True(t, state.Members[2].Enabled)
+	})
+
+	t.Run("error-callback", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientCAV := NewMockclientFake(ctrl)
+		c, _ := NewFakeClient(clientCAV)
+
+		state := newPoolState(t, clientCAV)
+
+		err := c.RollingMaintenance(context.Background(), urnPool, func(_ context.Context, _ PoolModelMember) error {
+			return errors.New("upgrade failed")
+		})
+		assert.ErrorContains(t, err, "upgrade failed")
+
+		// The member is left disabled and the maintenance is stopped
+		assert.False(t, state.Members[0].Enabled)
+		assert.True(t, state.Members[2].Enabled)
+	})
+
+	t.Run("error-infinite-graceful-timeout", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientCAV := NewMockclientFake(ctrl)
+		c, _ := NewFakeClient(clientCAV)
+
+		pool := testVCDPool(urnPool)
+		pool.NsxtAlbPool.GracefulTimeoutPeriod = utils.ToPTR(-1)
+		clientCAV.EXPECT().Refresh().Return(nil)
+		clientCAV.EXPECT().GetAlbPoolById(urnPool).Return(pool, nil)
+
+		err := c.RollingMaintenance(context.Background(), urnPool, func(_ context.Context, _ PoolModelMember) error {
+			return nil
+		})
+		assert.ErrorContains(t, err, "infinite GracefulTimeoutPeriod")
+	})
+
+	t.Run("error-empty-fn", func(t *testing.T) {
+		c, _ := NewFakeClient(nil)
+
+		err := c.RollingMaintenance(context.Background(), urnPool, nil)
+		assert.ErrorIs(t, err, sdkerrors.ErrEmpty)
+	})
+}
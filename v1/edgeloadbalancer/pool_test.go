@@ -1099,6 +1099,45 @@ func TestClient_CreatePool(t *testing.T) {
 			expectedErr:   true,
 			err:           errors.New("Error:Field validation for 'Members' failed on the 'excluded_with'"),
 		},
+		{
+			name: "error-validation-health-monitor-type",
+			pool: PoolModelRequest{
+				Name:       testPoolName1,
+				GatewayRef: govcdtypes.OpenApiReference{ID: urnEdgeGateway},
+				Enabled:    utils.ToPTR(true),
+				Algorithm:  PoolAlgorithmLeastConnections,
+				HealthMonitors: []PoolModelHealthMonitor{
+					{
+						Type: "DNS",
+					},
+				},
+			},
+			mockFunc:      func() {},
+			expectedValue: nil,
+			expectedErr:   true,
+			err:           errors.New("Error:Field validation for 'Type' failed on the 'oneof'"),
+		},
+		{
+			name: "error-validation-health-monitor-duplicate",
+			pool: PoolModelRequest{
+				Name:       testPoolName1,
+				GatewayRef: govcdtypes.OpenApiReference{ID: urnEdgeGateway},
+				Enabled:    utils.ToPTR(true),
+				Algorithm:  PoolAlgorithmLeastConnections,
+				HealthMonitors: []PoolModelHealthMonitor{
+					{
+						Type: PoolHealthMonitorTypeHTTP,
+					},
+					{
+						Type: PoolHealthMonitorTypeHTTP,
+					},
+				},
+			},
+			mockFunc:      func() {},
+			expectedValue: nil,
+			expectedErr:   true,
+			err:           errors.New("Error:Field validation for 'HealthMonitors' failed on the 'unique'"),
+		},
 		{
 			name: "error-create-pool",
 			pool: PoolModelRequest{
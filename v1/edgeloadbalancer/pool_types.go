@@ -10,6 +10,9 @@
 package edgeloadbalancer
 
 import (
+	"context"
+	"net/url"
+
 	"github.com/vmware/go-vcloud-director/v2/govcd"
 	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
 )
@@ -20,6 +23,30 @@ type (
 		Delete() error
 	}
 
+	fakeFirewallGroupEdgeGatewayClient interface {
+		GetAllNsxtFirewallGroups(queryParameters url.Values, firewallGroupType string) ([]*govcd.NsxtFirewallGroup, error)
+		GetNsxtFirewallGroupById(id string) (*govcd.NsxtFirewallGroup, error)
+		CreateNsxtFirewallGroup(firewallGroupConfig *govcdtypes.NsxtFirewallGroup) (*govcd.NsxtFirewallGroup, error)
+	}
+
+	fakeFirewallGroupClient interface {
+		Update(firewallGroupConfig *govcdtypes.NsxtFirewallGroup) (*govcd.NsxtFirewallGroup, error)
+		GetAssociatedVms() ([]*govcdtypes.NsxtFirewallGroupMemberVms, error)
+		Delete() error
+	}
+
+	// PoolMigrateToIPSetOptions represents the options of MigratePoolMembersToIPSet.
+	PoolMigrateToIPSetOptions struct {
+		// ReuseExistingIPSet allows to replace the IP addresses of an existing IP Set with the same name.
+		ReuseExistingIPSet bool
+		// AllowMemberSettingsLoss allows to migrate disabled members and members with a ratio. The disabled
+		// members are not added to the IP Set and the ratios are lost.
+		AllowMemberSettingsLoss bool
+		// IfMatch is the MembersVersion of the pool read by the caller. If set, nothing is written and
+		// errors.ErrLoadBalancerPoolConflict is returned if the members have been modified since the pool was read.
+		IfMatch string
+	}
+
 	// PoolModel represents an ALB Pool to an Edge Gateway.
 	PoolModel struct {
 		ID          string
@@ -112,45 +139,52 @@ type (
 
 	// PoolModelHealthMonitor checks member servers health.
 	// Active monitor generates synthetic traffic and mark a server up or down based on the response.
+	//
+	// Note. Cloud Director only exposes the type of the health monitor. The monitor settings (interval, timeout,
+	// thresholds, HTTP request, expected response, monitor port) are those of the system defined monitor of the
+	// given type and cannot be customized by the tenant.
 	PoolModelHealthMonitor struct {
-		Name string
+		// Name is a read only value computed by Cloud Director (e.g. "System-HTTP").
+		Name string `json:"name"`
+		// SystemDefined is a read only value set to true if the health monitor is a system defined monitor.
+		SystemDefined bool `json:"systemDefined"`
 		// Type
 		// * PoolHealthMonitorTypeHTTP - HTTP request/response is used to validate health.
 		// * PoolHealthMonitorTypeHTTPS - Used against HTTPS encrypted web servers to validate health.
 		// * PoolHealthMonitorTypeTCP - TCP connection is used to validate health.
 		// * PoolHealthMonitorTypeUDP - A UDP datagram is used to validate health.
 		// * PoolHealthMonitorTypePING - An ICMP ping is used to validate health.
-		Type PoolHealthMonitorType
+		Type PoolHealthMonitorType `json:"type" validate:"required,oneof=HTTP HTTPS TCP UDP PING"`
 	}
 
 	// PoolModelMember defines a single destination server which is used by the Load Balancer
 	// Pool to direct load balanced traffic.
 	PoolModelMember struct {
 		// Enabled defines if member is enabled (will receive incoming requests) or not
-		Enabled bool
+		Enabled bool `json:"enabled"`
 		// IPAddress of the Load Balancer Pool member.
-		IPAddress string
+		IPAddress string `json:"ipAddress"`
 
 		// Port number of the Load Balancer Pool member.
 		// If unset, the port that the client used to connect will be used.
-		Port int
+		Port int `json:"port"`
 
 		// Ratio of selecting eligible servers in the pool.
-		Ratio *int
+		Ratio *int `json:"ratio"`
 
 		// MarkedDownBy gives the names of the health monitors that marked the member as down when it is DOWN.
 		// If a monitor cannot be determined, the value will be UNKNOWN.
-		MarkedDownBy []string
+		MarkedDownBy []string `json:"markedDownBy"`
 
 		// HealthStatus of the pool member. Possible values are:
 		// * UP - The member is operational
 		// * DOWN - The member is down
 		// * DISABLED - The member is disabled
 		// * UNKNOWN - The state is unknown
-		HealthStatus string
+		HealthStatus string `json:"healthStatus"`
 
 		// DetailedHealthMessage contains non-localized detailed message on the health of the pool member.
-		DetailedHealthMessage string
+		DetailedHealthMessage string `json:"detailedHealthMessage"`
 	}
 
 	// PoolModelPersistenceProfile olds Persistence Profile of a Load Balancer Pool. Persistence profile will ensure that
@@ -161,7 +195,7 @@ type (
 		// Name field is tricky. It remains empty in some case, but if it is sent it can become computed.
 		// (e.g. setting 'CUSTOM_HTTP_HEADER' results in value being
 		// 'VCD-LoadBalancer-3510eae9-53bb-49f1-b7aa-7aedf5ce3a77-CUSTOM_HTTP_HEADER')
-		Name string
+		Name string `json:"name"`
 
 		// Type of persistence strategy to use. Supported values are:
 		// * PoolPersistenceProfileTypeClientIP - The clients IP is used as the identifier and mapped to the server.
@@ -169,31 +203,34 @@ type (
 		// * PoolPersistenceProfileTypeCustomHTTPHeader - Custom, static mappings of header values to specific servers are used. Header name must be provided as value.
 		// * PoolPersistenceProfileTypeAPPCookie - Load Balancer reads existing server cookies or URI embedded data such as JSessionID. Cookie name must be provided as value.
 		// * PoolPersistenceProfileTypeTLS - Information is embedded in the client's SSL/TLS ticket ID. This will use default system profile System-Persistence-TLS.
-		Type PoolPersistenceProfileType
+		Type PoolPersistenceProfileType `json:"type"`
 
 		// Value of attribute based on selected persistence type.
 		// This is required for PoolPersistenceProfileTypeHTTPCookie, PoolPersistenceProfileTypeCustomHTTPHeader and PoolPersistenceProfileTypeAPPCookie persistence types.
 		//
 		// PoolPersistenceProfileTypeHTTPCookie, PoolPersistenceProfileTypeAPPCookie must have cookie name set as the value and PoolPersistenceProfileTypeCustomHTTPHeader must have header name set as
 		// the value.
-		Value string
+		Value string `json:"value"`
 	}
 
 	PoolAlgorithm              string
 	PoolHealthMonitorType      string
 	PoolPersistenceProfileType string
 
+	// PoolMemberMaintenanceFunc is called by RollingMaintenance on each member once it is drained.
+	PoolMemberMaintenanceFunc func(ctx context.Context, member PoolModelMember) error
+
 	// * ------------------------------------
 	// * PoolModelRequest.
 
 	// PoolModelRequest represents a request to create a Load Balancer Pool.
 	PoolModelRequest struct {
-		Name        string `validate:"required"`
-		Description string `validate:"omitempty"`
-		Enabled     *bool  `validate:"required"`
+		Name        string `json:"name" validate:"required"`
+		Description string `json:"description" validate:"omitempty"`
+		Enabled     *bool  `json:"enabled" validate:"required"`
 
 		// GatewayRef is a reference to the Edge Gateway where the Load Balancer Pool will be edited.
-		GatewayRef govcdtypes.OpenApiReference `validate:"required"`
+		GatewayRef govcdtypes.OpenApiReference `json:"gatewayRef" validate:"required"`
 
 		// The heart of a load balancer is its ability to effectively distribute traffic across healthy servers. If persistence is enabled, only the first connection from a client is load balanced. While the persistence remains in effect, subsequent connections or requests from a client are directed to the same server.
 		// Default value is PoolAlgorithmLeastConnections.
@@ -207,58 +244,67 @@ type (
 		// * PoolAlgorithmRandom
 		// * PoolAlgorithmFewestTasks
 		// * PoolAlgorithmCoreAffinity
-		Algorithm PoolAlgorithm `validate:"required,oneof=LEAST_CONNECTIONS ROUND_ROBIN CONSISTENT_HASH FASTEST_RESPONSE LEAST_LOAD FEWEST_SERVERS RANDOM FEWEST_TASKS CORE_AFFINITY"`
+		Algorithm PoolAlgorithm `json:"algorithm" validate:"required,oneof=LEAST_CONNECTIONS ROUND_ROBIN CONSISTENT_HASH FASTEST_RESPONSE LEAST_LOAD FEWEST_SERVERS RANDOM FEWEST_TASKS CORE_AFFINITY"`
 
 		// DefaultPort defines destination server port used by the traffic sent to the member.
-		DefaultPort *int `validate:"omitempty"`
+		DefaultPort *int `json:"defaultPort" validate:"omitempty"`
 
 		// GracefulTimeoutPeriod sets maximum time (in minutes) to gracefully disable a member. Virtual service waits for the
 		// specified time before terminating the existing connections to the pool members that are disabled.
 		//
 		// Special values: 0 represents Immediate, -1 represents Infinite.
-		GracefulTimeoutPeriod *int `validate:"omitempty"`
+		GracefulTimeoutPeriod *int `json:"gracefulTimeoutPeriod" validate:"omitempty"`
 
 		// PassiveMonitoringEnabled sets if client traffic should be used to check if pool member is up or down.
-		PassiveMonitoringEnabled *bool `validate:"omitempty"`
+		PassiveMonitoringEnabled *bool `json:"passiveMonitoringEnabled" validate:"omitempty"`
 
 		// HealthMonitors check member servers health. It can be monitored by using one or more health monitors. Active
 		// monitors generate synthetic traffic and mark a server up or down based on the response.
-		HealthMonitors []PoolModelHealthMonitor `validate:"omitempty"`
+		//
+		// Each health monitor type can only be used once per pool.
+		HealthMonitors []PoolModelHealthMonitor `json:"healthMonitors" validate:"omitempty,unique=Type,dive"`
 
 		// Members field defines list of destination servers which are used by the Load Balancer Pool to direct load balanced
 		// traffic.
 		//
-		// Note. Only one of Members or MemberGroupRef can be specified
-		Members []PoolModelMember `validate:"omitempty,excluded_with=MemberGroupRef"`
+		// Note. Only one of Members, MemberGroupRef or MemberGroupName can be specified
+		Members []PoolModelMember `json:"members" validate:"omitempty,excluded_with=MemberGroupRef MemberGroupName"`
 
 		// MemberGroupRef contains reference to the Edge Firewall Group Static Group or IP Set
 		// representing destination servers which are used by the Load Balancer Pool to direct load
 		// balanced traffic.
 		//
-		// Note. Only one of Members or MemberGroupRef can be specified
-		MemberGroupRef *govcdtypes.OpenApiReference `validate:"omitempty,excluded_with=Members"`
+		// Note. Only one of Members, MemberGroupRef or MemberGroupName can be specified
+		MemberGroupRef *govcdtypes.OpenApiReference `json:"memberGroupRef" validate:"omitempty,excluded_with=Members MemberGroupName"`
+
+		// MemberGroupName is the name of an IP Set or a Security Group of the Edge Gateway
+		// representing destination servers. It is resolved into MemberGroupRef when the pool is
+		// created or updated.
+		//
+		// Note. Only one of Members, MemberGroupRef or MemberGroupName can be specified
+		MemberGroupName string `json:"memberGroupName" validate:"omitempty,excluded_with=Members MemberGroupRef"`
 
 		// CaCertificateRefs point to root certificates to use when validating certificates presented by the pool members.
-		CaCertificateRefs []govcdtypes.OpenApiReference `validate:"omitempty"`
+		CaCertificateRefs []govcdtypes.OpenApiReference `json:"caCertificateRefs" validate:"omitempty"`
 
 		// CommonNameCheckEnabled specifies whether to check the common name of the certificate presented by the pool member.
 		// This cannot be enabled if no caCertificateRefs are specified.
-		CommonNameCheckEnabled *bool `validate:"omitempty,required_with=CaCertificateRefs"`
+		CommonNameCheckEnabled *bool `json:"commonNameCheckEnabled" validate:"omitempty,required_with=CaCertificateRefs"`
 
 		// DomainNames holds a list of domain names which will be used to verify the common names or subject alternative
 		// names presented by the pool member certificates. It is performed only when common name check
 		// (CommonNameCheckEnabled) is enabled. If common name check is enabled, but domain names are not specified then the
 		// incoming host header will be used to check the certificate.
-		DomainNames []string `validate:"omitempty"`
+		DomainNames []string `json:"domainNames" validate:"omitempty"`
 
 		// SslEnabled is required when CA Certificates are used.
-		SSLEnabled *bool
+		SSLEnabled *bool `json:"sslEnabled"`
 
 		// PersistenceProfile of a Load Balancer Pool. Persistence profile will ensure that the same user sticks to the same
 		// server for a desired duration of time. If the persistence profile is unmanaged by Cloud Director, updates that
 		// leave the values unchanged will continue to use the same unmanaged profile. Any changes made to the persistence
 		// profile will cause Cloud Director to switch the pool to a profile managed by Cloud Director.
-		PersistenceProfile *PoolModelPersistenceProfile `validate:"omitempty"`
+		PersistenceProfile *PoolModelPersistenceProfile `json:"persistenceProfile" validate:"omitempty"`
 	}
 )
 
@@ -307,8 +353,9 @@ func fromVCDNsxtALBPoolToModel(pool *govcdtypes.NsxtAlbPool) *PoolModel {
 			monitors := make([]PoolModelHealthMonitor, len(pool.HealthMonitors))
 			for i, monitor := range pool.HealthMonitors {
 				monitors[i] = PoolModelHealthMonitor{
-					Name: monitor.Name,
-					Type: PoolHealthMonitorType(monitor.Type),
+					Name:          monitor.Name,
+					SystemDefined: monitor.SystemDefined,
+					Type:          PoolHealthMonitorType(monitor.Type),
 				}
 			}
 			return monitors
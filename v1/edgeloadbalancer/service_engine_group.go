@@ -11,10 +11,15 @@ package edgeloadbalancer
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/url"
 
+	"github.com/orange-cloudavenue/common-go/validators"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	sdkerrors "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
 )
 
@@ -27,21 +32,9 @@ func (c *client) ListServiceEngineGroups(ctx context.Context, edgeGatewayID stri
 }
 
 func (c *client) listServiceEngineGroups(_ context.Context, edgeGatewayID string) ([]*ServiceEngineGroupModel, error) {
-	if edgeGatewayID == "" {
-		return nil, errors.New("edgeGatewayID cannot be empty")
-	}
-
-	if !urn.IsEdgeGateway(edgeGatewayID) {
-		return nil, errors.New("edgeGatewayID is not a valid URN")
-	}
-
-	// Find the service engine group by name
-	queryParams := url.Values{}
-	queryParams.Add("filter", fmt.Sprintf("gatewayRef.id==%s", edgeGatewayID)) // Filter
-
-	segs, err := c.clientGoVCD.GetAllAlbServiceEngineGroupAssignments(queryParams)
+	segs, err := c.getServiceEngineGroupAssignments(edgeGatewayID)
 	if err != nil {
-		return nil, fmt.Errorf("error while fetching service engine group: %w", err)
+		return nil, err
 	}
 
 	if len(segs) == 0 {
@@ -51,19 +44,31 @@ func (c *client) listServiceEngineGroups(_ context.Context, edgeGatewayID string
 	// For x make it in []*ServiceEngineGroupsModel
 	x := make([]*ServiceEngineGroupModel, len(segs))
 	for i, seg := range segs {
-		x[i] = &ServiceEngineGroupModel{
-			ID:                         seg.NsxtAlbServiceEngineGroupAssignment.ServiceEngineGroupRef.ID,
-			Name:                       seg.NsxtAlbServiceEngineGroupAssignment.ServiceEngineGroupRef.Name,
-			GatewayRef:                 seg.NsxtAlbServiceEngineGroupAssignment.GatewayRef,
-			MaxVirtualServices:         seg.NsxtAlbServiceEngineGroupAssignment.MaxVirtualServices,
-			MinVirtualServices:         seg.NsxtAlbServiceEngineGroupAssignment.MinVirtualServices,
-			NumDeployedVirtualServices: seg.NsxtAlbServiceEngineGroupAssignment.NumDeployedVirtualServices,
-		}
+		x[i] = (&ServiceEngineGroupModel{}).fromVCD(seg.NsxtAlbServiceEngineGroupAssignment)
 	}
 
 	return x, nil
 }
 
+// getServiceEngineGroupAssignments returns the service engine group assignments of an edge gateway.
+// An empty list is returned if no service engine group is assigned.
+func (c *client) getServiceEngineGroupAssignments(edgeGatewayID string) ([]*govcd.NsxtAlbServiceEngineGroupAssignment, error) {
+	if err := c.edgeGatewayIDValidator(edgeGatewayID); err != nil {
+		return nil, err
+	}
+
+	// Find the service engine group by name
+	queryParams := url.Values{}
+	queryParams.Add("filter", fmt.Sprintf("gatewayRef.id==%s", edgeGatewayID)) // Filter
+
+	segs, err := c.clientGoVCD.GetAllAlbServiceEngineGroupAssignments(queryParams)
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching service engine group: %w", err)
+	}
+
+	return segs, nil
+}
+
 // GetServiceEngineGroup return an Service Engine Group For an Edge Gateway
 // The nameOrID can be either the name or the ID of the service engine group.
 func (c *client) GetServiceEngineGroup(ctx context.Context, edgeGatewayID, nameOrID string) (*ServiceEngineGroupModel, error) {
@@ -119,3 +124,198 @@ func (c *client) GetFirstServiceEngineGroup(ctx context.Context, edgeGatewayID s
 
 	return segs[0], nil
 }
+
+// GetServiceEngineGroupsCapacity returns the reserved, maximum and deployed virtual services
+// of the service engine groups assigned to an edge gateway.
+func (c *client) GetServiceEngineGroupsCapacity(ctx context.Context, edgeGatewayID string) (*ServiceEngineGroupCapacityModel, error) {
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return nil, err
+	}
+
+	segs, err := c.listServiceEngineGroups(ctx, edgeGatewayID)
+	if err != nil {
+		return nil, err
+	}
+
+	capacity := &ServiceEngineGroupCapacityModel{
+		EdgeGatewayID:       edgeGatewayID,
+		ServiceEngineGroups: segs,
+	}
+
+	for _, seg := range segs {
+		if seg.MinVirtualServices != nil {
+			capacity.Reserved += *seg.MinVirtualServices
+		}
+		if seg.MaxVirtualServices != nil {
+			capacity.Max += *seg.MaxVirtualServices
+		}
+		capacity.Deployed += seg.NumDeployedVirtualServices
+	}
+
+	return capacity, nil
+}
+
+// selectServiceEngineGroup returns the service engine group used to deploy a new virtual service.
+// If serviceEngineGroupID is empty, the service engine group with the most available virtual services is selected.
+// errors.ErrSEGCapacityExceeded is returned if the service engine group cannot deploy a new virtual service.
+func (c *client) selectServiceEngineGroup(ctx context.Context, edgeGatewayID, serviceEngineGroupID string) (*ServiceEngineGroupModel, error) {
+	segs, err := c.listServiceEngineGroups(ctx, edgeGatewayID)
+	if err != nil {
+		return nil, err
+	}
+
+	var selected *ServiceEngineGroupModel
+
+	if serviceEngineGroupID != "" {
+		for _, seg := range segs {
+			if seg.ID == serviceEngineGroupID {
+				selected = seg
+				break
+			}
+		}
+
+		if selected == nil {
+			return nil, fmt.Errorf("the service engine group %s %w for edge gateway %s", serviceEngineGroupID, sdkerrors.ErrNotFound, edgeGatewayID)
+		}
+	} else {
+		for _, seg := range segs {
+			if selected == nil || hasMoreAvailableVirtualServices(seg, selected) {
+				selected = seg
+			}
+		}
+	}
+
+	if !selected.HasCapacity() {
+		return nil, fmt.Errorf("the service engine group %s has %d virtual services deployed out of %d: %w", selected.Name, selected.NumDeployedVirtualServices, *selected.MaxVirtualServices, sdkerrors.ErrSEGCapacityExceeded)
+	}
+
+	return selected, nil
+}
+
+// hasMoreAvailableVirtualServices reports whether a can deploy more virtual services than b.
+// A service engine group without maximum is preferred over a group with a maximum.
+func hasMoreAvailableVirtualServices(a, b *ServiceEngineGroupModel) bool {
+	availableA, limitedA := a.AvailableVirtualServices()
+	availableB, limitedB := b.AvailableVirtualServices()
+
+	if limitedA != limitedB {
+		return !limitedA
+	}
+
+	return availableA > availableB
+}
+
+// AssignServiceEngineGroup assigns a service engine group to an edge gateway.
+// If the service engine group is already assigned, the reservation limits
+// (MinVirtualServices/MaxVirtualServices) are updated.
+func (c *client) AssignServiceEngineGroup(ctx context.Context, edgeGatewayID string, assignment ServiceEngineGroupAssignmentModelRequest) (*ServiceEngineGroupModel, error) {
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return nil, err
+	}
+
+	return c.assignServiceEngineGroup(ctx, edgeGatewayID, assignment)
+}
+
+func (c *client) assignServiceEngineGroup(ctx context.Context, edgeGatewayID string, assignment ServiceEngineGroupAssignmentModelRequest) (*ServiceEngineGroupModel, error) {
+	if err := validators.New().StructCtx(ctx, &assignment); err != nil {
+		return nil, err
+	}
+
+	if assignment.MinVirtualServices != nil && assignment.MaxVirtualServices != nil && *assignment.MinVirtualServices > *assignment.MaxVirtualServices {
+		return nil, fmt.Errorf("MinVirtualServices (%d) cannot be greater than MaxVirtualServices (%d)", *assignment.MinVirtualServices, *assignment.MaxVirtualServices)
+	}
+
+	assignments, err := c.getServiceEngineGroupAssignments(edgeGatewayID)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &govcdtypes.NsxtAlbServiceEngineGroupAssignment{
+		GatewayRef:         &govcdtypes.OpenApiReference{ID: edgeGatewayID},
+		MinVirtualServices: assignment.MinVirtualServices,
+		MaxVirtualServices: assignment.MaxVirtualServices,
+	}
+
+	// * Update the reservation limits if the service engine group is already assigned
+	if a := findServiceEngineGroupAssignment(assignments, assignment.NameOrID); a != nil {
+		config.ID = a.NsxtAlbServiceEngineGroupAssignment.ID
+		config.ServiceEngineGroupRef = a.NsxtAlbServiceEngineGroupAssignment.ServiceEngineGroupRef
+
+		updated, err := updateServiceEngineGroupAssignment(a, config)
+		if err != nil {
+			return nil, fmt.Errorf("error updating service engine group assignment: %w", err)
+		}
+
+		return (&ServiceEngineGroupModel{}).fromVCD(updated.NsxtAlbServiceEngineGroupAssignment), nil
+	}
+
+	var seg *govcd.NsxtAlbServiceEngineGroup
+	if urn.IsServiceEngineGroup(assignment.NameOrID) {
+		seg, err = c.clientGoVCD.GetAlbServiceEngineGroupById(assignment.NameOrID)
+	} else {
+		seg, err = c.clientGoVCD.GetAlbServiceEngineGroupByName(edgeGatewayID, assignment.NameOrID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("the service engine group %s %w: %w", assignment.NameOrID, sdkerrors.ErrNotFound, err)
+	}
+
+	config.ServiceEngineGroupRef = &govcdtypes.OpenApiReference{
+		ID:   seg.NsxtAlbServiceEngineGroup.ID,
+		Name: seg.NsxtAlbServiceEngineGroup.Name,
+	}
+
+	created, err := c.clientGoVCD.CreateAlbServiceEngineGroupAssignment(config)
+	if err != nil {
+		return nil, fmt.Errorf("error assigning service engine group %s: %w", assignment.NameOrID, err)
+	}
+
+	return (&ServiceEngineGroupModel{}).fromVCD(created.NsxtAlbServiceEngineGroupAssignment), nil
+}
+
+var updateServiceEngineGroupAssignment = func(assignment fakeServiceEngineGroupAssignmentClient, config *govcdtypes.NsxtAlbServiceEngineGroupAssignment) (*govcd.NsxtAlbServiceEngineGroupAssignment, error) {
+	return assignment.Update(config)
+}
+
+// UnassignServiceEngineGroup removes a service engine group from an edge gateway.
+// The nameOrID can be either the name or the ID of the service engine group.
+func (c *client) UnassignServiceEngineGroup(_ context.Context, edgeGatewayID, nameOrID string) error {
+	if nameOrID == "" {
+		return fmt.Errorf("nameOrID is %w. Please provide a valid service engine group name or ID", sdkerrors.ErrEmpty)
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return err
+	}
+
+	assignments, err := c.getServiceEngineGroupAssignments(edgeGatewayID)
+	if err != nil {
+		return err
+	}
+
+	a := findServiceEngineGroupAssignment(assignments, nameOrID)
+	if a == nil {
+		return fmt.Errorf("the service engine group %s %w for edge gateway %s", nameOrID, sdkerrors.ErrNotFound, edgeGatewayID)
+	}
+
+	if err := deleteServiceEngineGroupAssignment(a); err != nil {
+		return fmt.Errorf("error unassigning service engine group %s: %w", nameOrID, err)
+	}
+
+	return nil
+}
+
+var deleteServiceEngineGroupAssignment = func(assignment fakeServiceEngineGroupAssignmentClient) error {
+	return assignment.Delete()
+}
+
+// findServiceEngineGroupAssignment returns the assignment matching the name or the ID of the service engine group.
+func findServiceEngineGroupAssignment(assignments []*govcd.NsxtAlbServiceEngineGroupAssignment, nameOrID string) *govcd.NsxtAlbServiceEngineGroupAssignment {
+	for _, a := range assignments {
+		ref := a.NsxtAlbServiceEngineGroupAssignment.ServiceEngineGroupRef
+		if ref != nil && (ref.ID == nameOrID || ref.Name == nameOrID) {
+			return a
+		}
+	}
+
+	return nil
+}
@@ -24,6 +24,7 @@ import (
 	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
 
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/utils"
+	sdkerrors "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
 )
 
@@ -135,7 +136,7 @@ func TestClient_ListServiceEngineGroups(t *testing.T) {
 			},
 			expectedCertValue: nil,
 			expectedErr:       true,
-			err:               fmt.Errorf("edgeGatewayID cannot be empty"),
+			err:               sdkerrors.ErrEmpty,
 		},
 		{
 			name:          "error-validation-edgeGateway-ID-not-urn",
@@ -145,7 +146,7 @@ func TestClient_ListServiceEngineGroups(t *testing.T) {
 			},
 			expectedCertValue: nil,
 			expectedErr:       true,
-			err:               fmt.Errorf("edgeGatewayID is not a valid URN"),
+			err:               sdkerrors.ErrInvalidFormat,
 		},
 	}
 
@@ -502,3 +503,328 @@ func TestClient_GetFirstServiceEngineGroup(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_AssignServiceEngineGroup(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientFake(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	urnServiceEngineGroup := urn.ServiceEngineGroup.String() + uuid.New().String()
+	urnEdgeGateway := urn.Gateway.String() + uuid.New().String()
+
+	tests := []struct {
+		name          string
+		request       ServiceEngineGroupAssignmentModelRequest
+		mockFunc      func()
+		expectedValue *ServiceEngineGroupModel
+		expectedErr   bool
+		err           error
+	}{
+		{
+			name:    "success-create",
+			request: ServiceEngineGroupAssignmentModelRequest{NameOrID: urnServiceEngineGroup, MaxVirtualServices: utils.ToPTR(5)},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetAllAlbServiceEngineGroupAssignments(gomock.Any()).Return(nil, nil)
+				clientCAV.EXPECT().GetAlbServiceEngineGroupById(urnServiceEngineGroup).Return(&govcd.NsxtAlbServiceEngineGroup{
+					NsxtAlbServiceEngineGroup: &govcdtypes.NsxtAlbServiceEngineGroup{ID: urnServiceEngineGroup, Name: testName},
+				}, nil)
+				clientCAV.EXPECT().CreateAlbServiceEngineGroupAssignment(gomock.Any()).DoAndReturn(func(config *govcdtypes.NsxtAlbServiceEngineGroupAssignment) (*govcd.NsxtAlbServiceEngineGroupAssignment, error) {
+					return &govcd.NsxtAlbServiceEngineGroupAssignment{NsxtAlbServiceEngineGroupAssignment: config}, nil
+				})
+			},
+			expectedValue: &ServiceEngineGroupModel{
+				ID:                 urnServiceEngineGroup,
+				Name:               testName,
+				GatewayRef:         &govcdtypes.OpenApiReference{ID: urnEdgeGateway},
+				MaxVirtualServices: utils.ToPTR(5),
+			},
+		},
+		{
+			name:    "success-update",
+			request: ServiceEngineGroupAssignmentModelRequest{NameOrID: testName, MinVirtualServices: utils.ToPTR(2), MaxVirtualServices: utils.ToPTR(5)},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetAllAlbServiceEngineGroupAssignments(gomock.Any()).Return([]*govcd.NsxtAlbServiceEngineGroupAssignment{
+					{
+						NsxtAlbServiceEngineGroupAssignment: &govcdtypes.NsxtAlbServiceEngineGroupAssignment{
+							ServiceEngineGroupRef: &govcdtypes.OpenApiReference{ID: urnServiceEngineGroup, Name: testName},
+							GatewayRef:            &govcdtypes.OpenApiReference{ID: urnEdgeGateway},
+						},
+					},
+				}, nil)
+				updateServiceEngineGroupAssignment = func(_ fakeServiceEngineGroupAssignmentClient, config *govcdtypes.NsxtAlbServiceEngineGroupAssignment) (*govcd.NsxtAlbServiceEngineGroupAssignment, error) {
+					return &govcd.NsxtAlbServiceEngineGroupAssignment{NsxtAlbServiceEngineGroupAssignment: config}, nil
+				}
+			},
+			expectedValue: &ServiceEngineGroupModel{
+				ID:                 urnServiceEngineGroup,
+				Name:               testName,
+				GatewayRef:         &govcdtypes.OpenApiReference{ID: urnEdgeGateway},
+				MinVirtualServices: utils.ToPTR(2),
+				MaxVirtualServices: utils.ToPTR(5),
+			},
+		},
+		{
+			name:    "error-not-found",
+			request: ServiceEngineGroupAssignmentModelRequest{NameOrID: testName},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetAllAlbServiceEngineGroupAssignments(gomock.Any()).Return(nil, nil)
+				clientCAV.EXPECT().GetAlbServiceEngineGroupByName(urnEdgeGateway, testName).Return(nil, errors.New("error"))
+			},
+			expectedErr: true,
+			err:         sdkerrors.ErrNotFound,
+		},
+		{
+			name:    "error-validation",
+			request: ServiceEngineGroupAssignmentModelRequest{},
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockFunc()
+
+			seg, err := c.AssignServiceEngineGroup(context.Background(), urnEdgeGateway, tc.request)
+			if !tc.expectedErr {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedValue, seg)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Nil(t, seg)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestClient_UnassignServiceEngineGroup(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientFake(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	urnServiceEngineGroup := urn.ServiceEngineGroup.String() + uuid.New().String()
+	urnEdgeGateway := urn.Gateway.String() + uuid.New().String()
+
+	var deleted bool
+	deleteServiceEngineGroupAssignment = func(_ fakeServiceEngineGroupAssignmentClient) error {
+		deleted = true
+		return nil
+	}
+
+	tests := []struct {
+		name        string
+		nameOrID    string
+		mockFunc    func()
+		expectedErr bool
+		err         error
+	}{
+		{
+			name:     testSuccess,
+			nameOrID: urnServiceEngineGroup,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetAllAlbServiceEngineGroupAssignments(gomock.Any()).Return([]*govcd.NsxtAlbServiceEngineGroupAssignment{
+					{
+						NsxtAlbServiceEngineGroupAssignment: &govcdtypes.NsxtAlbServiceEngineGroupAssignment{
+							ServiceEngineGroupRef: &govcdtypes.OpenApiReference{ID: urnServiceEngineGroup, Name: testName},
+						},
+					},
+				}, nil)
+			},
+		},
+		{
+			name:     "error-not-assigned",
+			nameOrID: testName,
+			mockFunc: func() {
+				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetAllAlbServiceEngineGroupAssignments(gomock.Any()).Return(nil, nil)
+			},
+			expectedErr: true,
+			err:         sdkerrors.ErrNotFound,
+		},
+		{
+			name:        "error-empty-name",
+			nameOrID:    "",
+			mockFunc:    func() {},
+			expectedErr: true,
+			err:         sdkerrors.ErrEmpty,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deleted = false
+			tc.mockFunc()
+
+			err := c.UnassignServiceEngineGroup(context.Background(), urnEdgeGateway, tc.nameOrID)
+			if !tc.expectedErr {
+				assert.NoError(t, err)
+				assert.True(t, deleted)
+				return
+			}
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.False(t, deleted)
+		})
+	}
+}
+
+func TestClient_GetServiceEngineGroupsCapacity(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientFake(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	urnEdgeGateway := urn.Gateway.String() + uuid.New().String()
+
+	clientCAV.EXPECT().Refresh().Return(nil)
+	clientCAV.EXPECT().GetAllAlbServiceEngineGroupAssignments(gomock.Any()).Return([]*govcd.NsxtAlbServiceEngineGroupAssignment{
+		{
+			NsxtAlbServiceEngineGroupAssignment: &govcdtypes.NsxtAlbServiceEngineGroupAssignment{
+				ServiceEngineGroupRef:      &govcdtypes.OpenApiReference{ID: urn.ServiceEngineGroup.String() + uuid.New().String(), Name: "shared"},
+				MaxVirtualServices:         utils.ToPTR(10),
+				MinVirtualServices:         utils.ToPTR(2),
+				NumDeployedVirtualServices: 4,
+			},
+		},
+		{
+			NsxtAlbServiceEngineGroupAssignment: &govcdtypes.NsxtAlbServiceEngineGroupAssignment{
+				ServiceEngineGroupRef:      &govcdtypes.OpenApiReference{ID: urn.ServiceEngineGroup.String() + uuid.New().String(), Name: "dedicated"},
+				NumDeployedVirtualServices: 3,
+			},
+		},
+	}, nil)
+
+	capacity, err := c.GetServiceEngineGroupsCapacity(context.Background(), urnEdgeGateway)
+	assert.NoError(t, err)
+	assert.Equal(t, urnEdgeGateway, capacity.EdgeGatewayID)
+	assert.Len(t, capacity.ServiceEngineGroups, 2)
+	assert.Equal(t, 2, capacity.Reserved)
+	assert.Equal(t, 10, capacity.Max)
+	assert.Equal(t, 7, capacity.Deployed)
+
+	available, limited := capacity.ServiceEngineGroups[0].AvailableVirtualServices()
+	assert.Equal(t, 6, available)
+	assert.True(t, limited)
+
+	_, limited = capacity.ServiceEngineGroups[1].AvailableVirtualServices()
+	assert.False(t, limited)
+	assert.True(t, capacity.ServiceEngineGroups[1].HasCapacity())
+}
+
+func TestClient_selectServiceEngineGroup(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientFake(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	urnEdgeGateway := urn.Gateway.String() + uuid.New().String()
+	urnFull := urn.ServiceEngineGroup.String() + uuid.New().String()
+	urnSmall := urn.ServiceEngineGroup.String() + uuid.New().String()
+	urnLarge := urn.ServiceEngineGroup.String() + uuid.New().String()
+	urnUnlimited := urn.ServiceEngineGroup.String() + uuid.New().String()
+
+	assignment := func(id, name string, maxVirtualServices *int, deployed int) *govcd.NsxtAlbServiceEngineGroupAssignment {
+		return &govcd.NsxtAlbServiceEngineGroupAssignment{
+			NsxtAlbServiceEngineGroupAssignment: &govcdtypes.NsxtAlbServiceEngineGroupAssignment{
+				ServiceEngineGroupRef:      &govcdtypes.OpenApiReference{ID: id, Name: name},
+				MaxVirtualServices:         maxVirtualServices,
+				NumDeployedVirtualServices: deployed,
+			},
+		}
+	}
+
+	full := assignment(urnFull, "full", utils.ToPTR(5), 5)
+	small := assignment(urnSmall, "small", utils.ToPTR(5), 4)
+	large := assignment(urnLarge, "large", utils.ToPTR(20), 10)
+	unlimited := assignment(urnUnlimited, "unlimited", nil, 50)
+
+	tests := []struct {
+		name                 string
+		assignments          []*govcd.NsxtAlbServiceEngineGroupAssignment
+		serviceEngineGroupID string
+		expectedID           string
+		expectedErr          bool
+		err                  error
+	}{
+		{
+			name:        "success-most-headroom",
+			assignments: []*govcd.NsxtAlbServiceEngineGroupAssignment{full, small, large},
+			expectedID:  urnLarge,
+		},
+		{
+			name:        "success-unlimited",
+			assignments: []*govcd.NsxtAlbServiceEngineGroupAssignment{large, unlimited},
+			expectedID:  urnUnlimited,
+		},
+		{
+			name:                 "success-explicit",
+			assignments:          []*govcd.NsxtAlbServiceEngineGroupAssignment{small, large},
+			serviceEngineGroupID: urnSmall,
+			expectedID:           urnSmall,
+		},
+		{
+			name:                 "error-explicit-full",
+			assignments:          []*govcd.NsxtAlbServiceEngineGroupAssignment{full, large},
+			serviceEngineGroupID: urnFull,
+			expectedErr:          true,
+			err:                  sdkerrors.ErrSEGCapacityExceeded,
+		},
+		{
+			name:        "error-all-full",
+			assignments: []*govcd.NsxtAlbServiceEngineGroupAssignment{full},
+			expectedErr: true,
+			err:         sdkerrors.ErrSEGCapacityExceeded,
+		},
+		{
+			name:                 "error-explicit-not-found",
+			assignments:          []*govcd.NsxtAlbServiceEngineGroupAssignment{large},
+			serviceEngineGroupID: urnSmall,
+			expectedErr:          true,
+			err:                  sdkerrors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			clientCAV.EXPECT().GetAllAlbServiceEngineGroupAssignments(gomock.Any()).Return(tc.assignments, nil)
+
+			seg, err := c.(*client).selectServiceEngineGroup(context.Background(), urnEdgeGateway, tc.serviceEngineGroupID)
+			if !tc.expectedErr {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedID, seg.ID)
+				return
+			}
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.Nil(t, seg)
+		})
+	}
+}
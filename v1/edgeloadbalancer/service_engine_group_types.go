@@ -33,4 +33,52 @@ type (
 		// NumDeployedVirtualServices is a number of deployed virtual services
 		NumDeployedVirtualServices int
 	}
+
+	// ServiceEngineGroupCapacityModel represents the virtual service capacity of the
+	// service engine groups assigned to an Edge Gateway.
+	ServiceEngineGroupCapacityModel struct {
+		// EdgeGatewayID is the urn of the edge gateway
+		EdgeGatewayID string
+
+		// ServiceEngineGroups contains the reserved, maximum and deployed virtual services of each service engine group
+		ServiceEngineGroups []*ServiceEngineGroupModel
+
+		// Reserved is the total number of virtual services reserved on the service engine groups
+		Reserved int
+
+		// Max is the total maximum number of virtual services of the service engine groups having a maximum
+		Max int
+
+		// Deployed is the total number of virtual services deployed on the service engine groups
+		Deployed int
+	}
 )
+
+// AvailableVirtualServices returns the number of virtual services that can still be deployed on the service engine group.
+// limited is false when MaxVirtualServices is not set, the number of virtual services is then not limited by the assignment.
+func (m *ServiceEngineGroupModel) AvailableVirtualServices() (available int, limited bool) {
+	if m.MaxVirtualServices == nil {
+		return 0, false
+	}
+
+	return max(*m.MaxVirtualServices-m.NumDeployedVirtualServices, 0), true
+}
+
+// HasCapacity reports whether a new virtual service can be deployed on the service engine group.
+func (m *ServiceEngineGroupModel) HasCapacity() bool {
+	available, limited := m.AvailableVirtualServices()
+	return !limited || available > 0
+}
+
+func (m *ServiceEngineGroupModel) fromVCD(assignment *govcdtypes.NsxtAlbServiceEngineGroupAssignment) *ServiceEngineGroupModel {
+	if assignment.ServiceEngineGroupRef != nil {
+		m.ID = assignment.ServiceEngineGroupRef.ID
+		m.Name = assignment.ServiceEngineGroupRef.Name
+	}
+	m.GatewayRef = assignment.GatewayRef
+	m.MaxVirtualServices = assignment.MaxVirtualServices
+	m.MinVirtualServices = assignment.MinVirtualServices
+	m.NumDeployedVirtualServices = assignment.NumDeployedVirtualServices
+
+	return m
+}
@@ -10,9 +10,11 @@
 package edgeloadbalancer
 
 import (
+	"bytes"
 	"context"
 	stderrors "errors"
 	"fmt"
+	"net"
 
 	"github.com/orange-cloudavenue/common-go/validators"
 
@@ -107,6 +109,12 @@ func (c *client) getVirtualService(_ context.Context, edgeGatewayID, virtualServ
 
 // CreateVirtualService creates a new virtual service based on the provided VirtualServiceModelRequest.
 func (c *client) CreateVirtualService(ctx context.Context, vsr VirtualServiceModelRequest) (*VirtualServiceModel, error) {
+	return c.CreateVirtualServiceWithOptions(ctx, vsr, VirtualServiceCreateOptions{})
+}
+
+// CreateVirtualServiceWithOptions creates a new virtual service based on the provided VirtualServiceModelRequest.
+// See VirtualServiceCreateOptions for the selection of the service engine group.
+func (c *client) CreateVirtualServiceWithOptions(ctx context.Context, vsr VirtualServiceModelRequest, opts VirtualServiceCreateOptions) (*VirtualServiceModel, error) {
 	if err := validators.New().StructCtx(ctx, &vsr); err != nil {
 		return nil, err
 	}
@@ -115,9 +123,24 @@ func (c *client) CreateVirtualService(ctx context.Context, vsr VirtualServiceMod
 		return nil, err
 	}
 
+	if err := c.validateVirtualServiceNetwork(vsr); err != nil {
+		return nil, err
+	}
+
 	model := fromModelRequestToVCDNsxtAlbVirtualService(vsr)
 
-	if model.ServiceEngineGroupRef == (govcdtypes.OpenApiReference{}) {
+	switch {
+	case opts.SelectServiceEngineGroupByCapacity:
+		seg, err := c.selectServiceEngineGroup(ctx, vsr.EdgeGatewayID, model.ServiceEngineGroupRef.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error finding service engine group: %w", err)
+		}
+
+		model.ServiceEngineGroupRef = govcdtypes.OpenApiReference{
+			ID:   seg.ID,
+			Name: seg.Name,
+		}
+	case model.ServiceEngineGroupRef == (govcdtypes.OpenApiReference{}):
 		seg, err := c.GetFirstServiceEngineGroup(ctx, vsr.EdgeGatewayID)
 		if err != nil {
 			return nil, fmt.Errorf("error finding service engine group: %w", err)
@@ -164,6 +187,10 @@ func (c *client) UpdateVirtualService(ctx context.Context, virtualServiceID stri
 		return nil, fmt.Errorf("error retrieving virtual service: %w", err)
 	}
 
+	if err := c.validateVirtualServiceNetwork(vsr); err != nil {
+		return nil, err
+	}
+
 	model := fromModelRequestToVCDNsxtAlbVirtualService(vsr)
 
 	if model.ServiceEngineGroupRef == (govcdtypes.OpenApiReference{}) {
@@ -214,3 +241,79 @@ func (c *client) DeleteVirtualService(ctx context.Context, virtualServiceID stri
 
 	return deleteVirtualService(vsToDelete)
 }
+
+// validateVirtualServiceNetwork checks the virtual IP addresses and the transparent mode
+// of the virtual service against the edge gateway.
+func (c *client) validateVirtualServiceNetwork(vsr VirtualServiceModelRequest) error {
+	egw, err := c.clientGoVCDOrg.GetNsxtEdgeGatewayById(vsr.EdgeGatewayID)
+	if err != nil {
+		return fmt.Errorf("error retrieving edge gateway: %w", err)
+	}
+
+	for _, vip := range []string{vsr.VirtualIPAddress, vsr.IPv6VirtualIPAddress} {
+		if vip == "" {
+			continue
+		}
+
+		if err := validateVirtualIPAddress(egw.EdgeGateway, vip); err != nil {
+			return err
+		}
+	}
+
+	if vsr.TransparentModeEnabled != nil && *vsr.TransparentModeEnabled {
+		settings, err := getAlbSettings(egw)
+		if err != nil {
+			return fmt.Errorf("error retrieving load balancer settings: %w", err)
+		}
+
+		if settings.TransparentModeEnabled == nil || !*settings.TransparentModeEnabled {
+			return fmt.Errorf("transparentModeEnabled has %w. The load balancer of the edge gateway is not in transparent mode", errors.ErrInvalidFormat)
+		}
+	}
+
+	return nil
+}
+
+// validateVirtualIPAddress checks that a virtual IP address belonging to a subnet of the
+// edge gateway uplinks is one of the IP addresses allocated to the edge gateway.
+// Addresses outside of the uplinks subnets are internal virtual IP addresses and are accepted.
+func validateVirtualIPAddress(egw *govcdtypes.OpenAPIEdgeGateway, vip string) error {
+	ip := net.ParseIP(vip)
+	if ip == nil {
+		return fmt.Errorf("virtualIPAddress has %w. Please provide a valid IP address", errors.ErrInvalidFormat)
+	}
+
+	if egw == nil {
+		return nil
+	}
+
+	for _, uplink := range egw.EdgeGatewayUplinks {
+		for _, subnet := range uplink.Subnets.Values {
+			_, ipNet, err := net.ParseCIDR(fmt.Sprintf("%s/%d", subnet.Gateway, subnet.PrefixLength))
+			if err != nil || !ipNet.Contains(ip) {
+				continue
+			}
+
+			if subnet.IPRanges != nil {
+				for _, r := range subnet.IPRanges.Values {
+					if ipInRange(ip, net.ParseIP(r.StartAddress), net.ParseIP(r.EndAddress)) {
+						return nil
+					}
+				}
+			}
+
+			return fmt.Errorf("virtualIPAddress has %w. %s belongs to the subnet %s of the edge gateway but is not allocated to it", errors.ErrInvalidFormat, vip, ipNet)
+		}
+	}
+
+	return nil
+}
+
+// ipInRange returns true if ip is between start and end (inclusive).
+func ipInRange(ip, start, end net.IP) bool {
+	if start == nil || end == nil {
+		return false
+	}
+
+	return bytes.Compare(ip.To16(), start.To16()) >= 0 && bytes.Compare(ip.To16(), end.To16()) <= 0
+}
@@ -0,0 +1,250 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgeloadbalancer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/orange-cloudavenue/common-go/validators"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/utils"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+)
+
+const defaultSwitchPoolHealthyMemberThreshold = 1
+
+// SwitchPool moves a virtual service to another pool of the same edge gateway (blue/green deployment).
+// It waits for the new pool to report at least opts.HealthyMemberThreshold members UP, then switches
+// the pool of the virtual service. See VirtualServiceSwitchPoolOptions for the health watch of the
+// virtual service and the draining of the old pool.
+// The health of the new pool is polled with an exponential backoff until the context is done,
+// or until 10 minutes if the context has no deadline.
+// If the pool of the virtual service is modified concurrently, errors.ErrLoadBalancerVirtualServiceConflict
+// is returned and nothing is applied.
+//
+// The state of the virtual service on error:
+//   - before the switch, nothing is applied and no model is returned.
+//   - if the virtual service is seen DOWN during opts.RevertWatchPeriod, or if the watch cannot complete
+//     (including when ctx is done), the old pool is restored even if ctx is canceled, no model is
+//     returned and the error wraps errors.ErrLoadBalancerPoolSwitchReverted. If the restore fails,
+//     the virtual service stays on the new pool and its model is returned with the error.
+//   - if the draining of the old pool fails, the switch is kept and the model of the virtual service
+//     on the new pool is returned with the error.
+func (c *client) SwitchPool(ctx context.Context, virtualServiceID, newPoolID string, opts VirtualServiceSwitchPoolOptions) (*VirtualServiceModel, error) {
+	if err := c.virtualServiceIDValidator(virtualServiceID); err != nil {
+		return nil, err
+	}
+
+	if err := poolIDValidator(newPoolID); err != nil {
+		return nil, err
+	}
+
+	if err := validators.New().StructCtx(ctx, &opts); err != nil {
+		return nil, err
+	}
+
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return nil, err
+	}
+
+	vs, err := c.getVirtualService(ctx, "", virtualServiceID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving virtual service: %w", err)
+	}
+
+	oldPoolRef := vs.NsxtAlbVirtualService.LoadBalancerPoolRef
+	if oldPoolRef.ID == newPoolID {
+		return fromVCDNsxtAlbVirtualServiceToModel(*vs.NsxtAlbVirtualService), nil
+	}
+
+	newPool, err := c.getpool(ctx, "", newPoolID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving Load Balancer Pool: %w", err)
+	}
+
+	if newPool.NsxtAlbPool.GatewayRef.ID != vs.NsxtAlbVirtualService.GatewayRef.ID {
+		return nil, fmt.Errorf("pool %s %w on the edge gateway of virtual service %s", newPoolID, errors.ErrNotFound, virtualServiceID)
+	}
+
+	// The old pool is checked before switching so the draining cannot fail halfway
+	gracefulTimeoutPeriod := defaultPoolMemberGracefulTimeoutPeriod
+	if opts.DisableOldPoolMembers {
+		oldPool, err := c.getpool(ctx, "", oldPoolRef.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving Load Balancer Pool: %w", err)
+		}
+
+		if oldPool.NsxtAlbPool.MemberGroupRef != nil {
+			return nil, fmt.Errorf("the members of pool %s are managed by the group %s", oldPoolRef.ID, oldPool.NsxtAlbPool.MemberGroupRef.Name)
+		}
+
+		if oldPool.NsxtAlbPool.GracefulTimeoutPeriod != nil {
+			gracefulTimeoutPeriod = *oldPool.NsxtAlbPool.GracefulTimeoutPeriod
+		}
+
+		if gracefulTimeoutPeriod < 0 {
+			return nil, fmt.Errorf("draining is not possible with an infinite GracefulTimeoutPeriod on pool %s", oldPoolRef.ID)
+		}
+	}
+
+	// 0 means the option is not set
+	threshold := opts.HealthyMemberThreshold
+	if threshold == 0 {
+		threshold = defaultSwitchPoolHealthyMemberThreshold
+	}
+
+	if err := c.waitForPoolUpMembers(ctx, newPoolID, threshold); err != nil {
+		return nil, err
+	}
+
+	// * Switch
+	vsUpdated, err := c.setVirtualServicePool(ctx, virtualServiceID, oldPoolRef.ID, govcdtypes.OpenApiReference{ID: newPool.NsxtAlbPool.ID, Name: newPool.NsxtAlbPool.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	vsModel := fromVCDNsxtAlbVirtualServiceToModel(*vsUpdated.NsxtAlbVirtualService)
+
+	// * Watch and revert
+	if opts.RevertWatchPeriod > 0 {
+		down, err := c.watchVirtualServiceDown(ctx, virtualServiceID, opts.RevertWatchPeriod)
+		if err != nil || down {
+			if err == nil {
+				err = fmt.Errorf("virtual service %s is DOWN with pool %s", virtualServiceID, newPoolID)
+			}
+
+			// The health of the new pool is not confirmed, the revert must run even if the context has been canceled
+			if _, revertErr := c.setVirtualServicePool(context.WithoutCancel(ctx), virtualServiceID, newPoolID, oldPoolRef); revertErr != nil {
+				return vsModel, fmt.Errorf("%w, the revert to pool %s failed: %w", err, oldPoolRef.ID, revertErr)
+			}
+			return nil, fmt.Errorf("%w: %w", err, errors.ErrLoadBalancerPoolSwitchReverted)
+		}
+	}
+
+	// * Drain the old pool
+	if opts.DisableOldPoolMembers {
+		select {
+		case <-ctx.Done():
+			return vsModel, fmt.Errorf("context done while draining pool %s: %w", oldPoolRef.ID, ctx.Err())
+		case <-time.After(time.Duration(gracefulTimeoutPeriod) * poolMemberDrainUnit):
+		}
+
+		if _, err := c.modifyPoolMembers(ctx, oldPoolRef.ID, func(members []govcdtypes.NsxtAlbPoolMember) ([]govcdtypes.NsxtAlbPoolMember, error) {
+			for i := range members {
+				members[i].Enabled = false
+			}
+			return members, nil
+		}); err != nil {
+			return vsModel, fmt.Errorf("error disabling the members of pool %s: %w", oldPoolRef.ID, err)
+		}
+	}
+
+	return vsModel, nil
+}
+
+// * Local functions
+
+// setVirtualServicePool sets the pool of a virtual service.
+// The virtual service is read again before being written, and errors.ErrLoadBalancerVirtualServiceConflict
+// is returned if its pool is no longer expectedPoolID.
+func (c *client) setVirtualServicePool(ctx context.Context, virtualServiceID, expectedPoolID string, pool govcdtypes.OpenApiReference) (*govcd.NsxtAlbVirtualService, error) {
+	if err := c.clientCloudavenue.Refresh(); err != nil {
+		return nil, err
+	}
+
+	vs, err := c.getVirtualService(ctx, "", virtualServiceID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving virtual service: %w", err)
+	}
+
+	if vs.NsxtAlbVirtualService.LoadBalancerPoolRef.ID != expectedPoolID {
+		return nil, errors.ErrLoadBalancerVirtualServiceConflict
+	}
+
+	vsToUpdate := *vs.NsxtAlbVirtualService
+	vsToUpdate.LoadBalancerPoolRef = pool
+
+	vsUpdated, err := updateVirtualService(vs, &vsToUpdate)
+	if err != nil {
+		return nil, fmt.Errorf("error updating virtual service: %w", err)
+	}
+
+	return vsUpdated, nil
+}
+
+// waitForPoolUpMembers waits for a pool to report at least threshold members UP.
+func (c *client) waitForPoolUpMembers(ctx context.Context, poolID string, threshold int) error {
+	if _, deadlineSet := ctx.Deadline(); !deadlineSet {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultWaitForPoolMemberUpTimeout)
+		defer cancel()
+	}
+
+	upMemberCount := 0
+
+	err := utils.Poll(ctx, func() (bool, error) {
+		if err := c.clientCloudavenue.Refresh(); err != nil {
+			return false, err
+		}
+
+		pool, err := c.getpool(ctx, "", poolID)
+		if err != nil {
+			return false, fmt.Errorf("error retrieving Load Balancer Pool: %w", err)
+		}
+
+		upMemberCount = pool.NsxtAlbPool.UpMemberCount
+		return upMemberCount >= threshold, nil
+	})
+	if err != nil && ctx.Err() != nil {
+		return fmt.Errorf("timeout reached waiting for %d members of pool %s to be UP, %d members are UP (%w)", threshold, poolID, upMemberCount, ctx.Err())
+	}
+
+	return err
+}
+
+// watchVirtualServiceDown polls the health of a virtual service during the period
+// and reports whether the virtual service has been seen DOWN.
+func (c *client) watchVirtualServiceDown(ctx context.Context, virtualServiceID string, period time.Duration) (bool, error) {
+	watchCtx, cancel := context.WithTimeout(ctx, period)
+	defer cancel()
+
+	down := false
+
+	err := utils.Poll(watchCtx, func() (bool, error) {
+		if err := c.clientCloudavenue.Refresh(); err != nil {
+			return false, err
+		}
+
+		vs, err := c.getVirtualService(ctx, "", virtualServiceID)
+		if err != nil {
+			return false, fmt.Errorf("error retrieving virtual service: %w", err)
+		}
+
+		down = VirtualServiceModelHealthStatus(vs.NsxtAlbVirtualService.HealthStatus) == VirtualServiceHealthStatusDOWN
+		return down, nil
+	})
+
+	switch {
+	case err == nil:
+		return down, nil
+	case ctx.Err() != nil:
+		return false, fmt.Errorf("context done while watching virtual service %s: %w", virtualServiceID, ctx.Err())
+	case watchCtx.Err() != nil:
+		// The end of the period is not an error, unlike the end of the parent context
+		return false, nil
+	}
+
+	return false, err
+}
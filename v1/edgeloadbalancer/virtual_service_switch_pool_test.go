@@ -0,0 +1,340 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package edgeloadbalancer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/utils"
+	sdkerrors "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
+)
+
+// testSwitchPoolState is a fake virtual service with a blue and a green pool.
+type testSwitchPoolState struct {
+	vs    *govcdtypes.NsxtAlbVirtualService
+	pools map[string]*govcdtypes.NsxtAlbPool
+
+	// upMembersAfter is the number of polls of the green pool before its members are UP.
+	upMembersAfter int
+	// vsHealthStatuses are the health statuses returned by the polls of the virtual service after the switch.
+	vsHealthStatuses []string
+
+	switches []string
+}
+
+func (s *testSwitchPoolState) mock(clientCAV *MockclientFake) {
+	clientCAV.EXPECT().Refresh().Return(nil).AnyTimes()
+	clientCAV.EXPECT().GetAlbVirtualServiceById(s.vs.ID).DoAndReturn(func(_ string) (*govcd.NsxtAlbVirtualService, error) {
+		vs := *s.vs
+		if len(s.switches) > 0 && len(s.vsHealthStatuses) > 0 {
+			vs.HealthStatus = s.vsHealthStatuses[0]
+			s.vsHealthStatuses = s.vsHealthStatuses[1:]
+		}
+		return &govcd.NsxtAlbVirtualService{NsxtAlbVirtualService: &vs}, nil
+	}).AnyTimes()
+	clientCAV.EXPECT().GetAlbPoolById(gomock.Any()).DoAndReturn(func(id string) (*govcd.NsxtAlbPool, error) {
+		pool := *s.pools[id]
+		pool.Members = append([]govcdtypes.NsxtAlbPoolMember(nil), s.pools[id].Members...)
+		if s.upMembersAfter > 0 && id != s.vs.LoadBalancerPoolRef.ID {
+			pool.UpMemberCount = 0
+			s.upMembersAfter--
+		}
+		return &govcd.NsxtAlbPool{NsxtAlbPool: &pool}, nil
+	}).AnyTimes()
+
+	updateVirtualService = func(_ fakeVirtualServiceClient, vs *govcdtypes.NsxtAlbVirtualService) (*govcd.NsxtAlbVirtualService, error) {
+		s.switches = append(s.switches, vs.LoadBalancerPoolRef.ID)
+		*s.vs = *vs
+		return &govcd.NsxtAlbVirtualService{NsxtAlbVirtualService: vs}, nil
+	}
+	updatePool = func(_ fakePoolClient, pool *govcdtypes.NsxtAlbPool) (*govcd.NsxtAlbPool, error) {
+		s.pools[pool.ID] = pool
+		return &govcd.NsxtAlbPool{NsxtAlbPool: pool}, nil
+	}
+}
+
+func TestClient_SwitchPool(t *testing.T) {
+	// Speed up the draining and the polling
+	defer func(drainUnit, minInterval, maxInterval time.Duration) {
+		poolMemberDrainUnit, utils.PollMinInterval, utils.PollMaxInterval = drainUnit, minInterval, maxInterval
+	}(poolMemberDrainUnit, utils.PollMinInterval, utils.PollMaxInterval)
+	defer func(f func(fakeVirtualServiceClient, *govcdtypes.NsxtAlbVirtualService) (*govcd.NsxtAlbVirtualService, error)) {
+		updateVirtualService = f
+	}(updateVirtualService)
+	defer func(f func(fakePoolClient, *govcdtypes.NsxtAlbPool) (*govcd.NsxtAlbPool, error)) {
+		updatePool = f
+	}(updatePool)
+	poolMemberDrainUnit, utils.PollMinInterval, utils.PollMaxInterval = time.Millisecond, time.Millisecond, time.Millisecond
+
+	urnEdgeGateway := urn.Gateway.String() + uuid.New().String()
+	urnVirtualService := urn.LoadBalancerVirtualService.String() + uuid.New().String()
+	urnBluePool := urn.LoadBalancerPool.String() + uuid.New().String()
+	urnGreenPool := urn.LoadBalancerPool.String() + uuid.New().String()
+	urnOtherPool := urn.LoadBalancerPool.String() + uuid.New().String()
+
+	newState := func() *testSwitchPoolState {
+		bluePool := testVCDPool(urnBluePool,
+			govcdtypes.NsxtAlbPoolMember{Enabled: true, IpAddress: testIPAddress, Port: 80},
+			govcdtypes.NsxtAlbPoolMember{Enabled: true, IpAddress: testIPAddress2, Port: 80},
+		).NsxtAlbPool
+		bluePool.GatewayRef = govcdtypes.OpenApiReference{ID: urnEdgeGateway}
+
+		greenPool := testVCDPool(urnGreenPool).NsxtAlbPool
+		greenPool.GatewayRef = govcdtypes.OpenApiReference{ID: urnEdgeGateway}
+		greenPool.UpMemberCount = 2
+
+		otherPool := testVCDPool(urnOtherPool).NsxtAlbPool
+		otherPool.GatewayRef = govcdtypes.OpenApiReference{ID: urn.Gateway.String() + uuid.New().String()}
+
+		return &testSwitchPoolState{
+			vs: &govcdtypes.NsxtAlbVirtualService{
+				ID:                  urnVirtualService,
+				Name:                testVirtualServiceName1,
+				GatewayRef:          govcdtypes.OpenApiReference{ID: urnEdgeGateway},
+				LoadBalancerPoolRef: govcdtypes.OpenApiReference{ID: urnBluePool},
+				HealthStatus:        string(VirtualServiceHealthStatusUP),
+			},
+			pools: map[string]*govcdtypes.NsxtAlbPool{
+				urnBluePool:  bluePool,
+				urnGreenPool: greenPool,
+				urnOtherPool: otherPool,
+			},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		virtualServiceID string
+		newPoolID        string
+		opts             VirtualServiceSwitchPoolOptions
+		setup            func(s *testSwitchPoolState)
+		expectedSwitches []string
+		expectedErr      bool
+		err              error
+		// switchKept is set when the model of the virtual service on the new pool is returned with the error
+		switchKept bool
+		check      func(t *testing.T, s *testSwitchPoolState)
+	}{
+		{
+			name:             testSuccess,
+			virtualServiceID: urnVirtualService,
+			newPoolID:        urnGreenPool,
+			opts:             VirtualServiceSwitchPoolOptions{HealthyMemberThreshold: 2, DisableOldPoolMembers: true, RevertWatchPeriod: 10 * time.Millisecond},
+			setup: func(s *testSwitchPoolState) {
+				// The green pool is UP at the third poll
+				s.upMembersAfter = 2
+			},
+			expectedSwitches: []string{urnGreenPool},
+			check: func(t *testing.T, s *testSwitchPoolState) {
+				t.Helper()
+				assert.Equal(t, 0, s.upMembersAfter)
+				// The members of the blue pool are drained
+				for _, m := range s.pools[urnBluePool].Members {
+					assert.False(t, m.Enabled)
+				}
+			},
+		},
+		{
+			name:             "success-already-switched",
+			virtualServiceID: urnVirtualService,
+			newPoolID:        urnBluePool,
+		},
+		{
+			name:             "success-old-pool-kept",
+			virtualServiceID: urnVirtualService,
+			newPoolID:        urnGreenPool,
+			expectedSwitches: []string{urnGreenPool},
+			check: func(t *testing.T, s *testSwitchPoolState) {
+				t.Helper()
+				for _, m := range s.pools[urnBluePool].Members {
+					assert.True(t, m.Enabled)
+				}
+			},
+		},
+		{
+			name:             "error-virtual-service-down-reverted",
+			virtualServiceID: urnVirtualService,
+			newPoolID:        urnGreenPool,
+			opts:             VirtualServiceSwitchPoolOptions{DisableOldPoolMembers: true, RevertWatchPeriod: time.Second},
+			setup: func(s *testSwitchPoolState) {
+				s.vsHealthStatuses = []string{string(VirtualServiceHealthStatusUP), string(VirtualServiceHealthStatusDOWN)}
+			},
+			expectedSwitches: []string{urnGreenPool, urnBluePool},
+			expectedErr:      true,
+			err:              sdkerrors.ErrLoadBalancerPoolSwitchReverted,
+			check: func(t *testing.T, s *testSwitchPoolState) {
+				t.Helper()
+				// The blue pool is not drained
+				for _, m := range s.pools[urnBluePool].Members {
+					assert.True(t, m.Enabled)
+				}
+			},
+		},
+		{
+			name:             "error-context-done-while-watching-reverted",
+			virtualServiceID: urnVirtualService,
+			newPoolID:        urnGreenPool,
+			opts:             VirtualServiceSwitchPoolOptions{RevertWatchPeriod: time.Second},
+			expectedSwitches: []string{urnGreenPool, urnBluePool},
+			expectedErr:      true,
+			err:              sdkerrors.ErrLoadBalancerPoolSwitchReverted,
+			check: func(t *testing.T, s *testSwitchPoolState) {
+				t.Helper()
+				assert.Equal(t, urnBluePool, s.vs.LoadBalancerPoolRef.ID)
+			},
+		},
+		{
+			name:             "error-context-done-while-draining",
+			virtualServiceID: urnVirtualService,
+			newPoolID:        urnGreenPool,
+			opts:             VirtualServiceSwitchPoolOptions{DisableOldPoolMembers: true},
+			setup: func(s *testSwitchPoolState) {
+				s.pools[urnBluePool].GracefulTimeoutPeriod = func(v int) *int { return &v }(1000)
+			},
+			expectedSwitches: []string{urnGreenPool},
+			expectedErr:      true,
+			err:              context.DeadlineExceeded,
+			switchKept:       true,
+			check: func(t *testing.T, s *testSwitchPoolState) {
+				t.Helper()
+				for _, m := range s.pools[urnBluePool].Members {
+					assert.True(t, m.Enabled)
+				}
+			},
+		},
+		{
+			name:             "error-new-pool-unhealthy",
+			virtualServiceID: urnVirtualService,
+			newPoolID:        urnGreenPool,
+			opts:             VirtualServiceSwitchPoolOptions{HealthyMemberThreshold: 3},
+			expectedErr:      true,
+			err:              context.DeadlineExceeded,
+		},
+		{
+			name:             "error-pool-of-another-edge-gateway",
+			virtualServiceID: urnVirtualService,
+			newPoolID:        urnOtherPool,
+			expectedErr:      true,
+			err:              sdkerrors.ErrNotFound,
+		},
+		{
+			name:             "error-old-pool-infinite-graceful-timeout",
+			virtualServiceID: urnVirtualService,
+			newPoolID:        urnGreenPool,
+			opts:             VirtualServiceSwitchPoolOptions{DisableOldPoolMembers: true},
+			setup: func(s *testSwitchPoolState) {
+				s.pools[urnBluePool].GracefulTimeoutPeriod = func(v int) *int { return &v }(-1)
+			},
+			expectedErr: true,
+		},
+		{
+			name:        "error-empty-virtual-service-id",
+			newPoolID:   urnGreenPool,
+			expectedErr: true,
+			err:         sdkerrors.ErrEmpty,
+		},
+		{
+			name:             "error-invalid-pool-id",
+			virtualServiceID: urnVirtualService,
+			newPoolID:        "pool",
+			expectedErr:      true,
+			err:              sdkerrors.ErrInvalidFormat,
+		},
+		{
+			name:             "error-invalid-threshold",
+			virtualServiceID: urnVirtualService,
+			newPoolID:        urnGreenPool,
+			opts:             VirtualServiceSwitchPoolOptions{HealthyMemberThreshold: -1},
+			expectedErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			clientCAV := NewMockclientFake(ctrl)
+			c, _ := NewFakeClient(clientCAV)
+
+			state := newState()
+			if tt.setup != nil {
+				tt.setup(state)
+			}
+			state.mock(clientCAV)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			vs, err := c.SwitchPool(ctx, tt.virtualServiceID, tt.newPoolID, tt.opts)
+			if tt.expectedErr {
+				assert.Error(t, err)
+				if tt.err != nil {
+					assert.ErrorIs(t, err, tt.err)
+				}
+				if tt.switchKept {
+					assert.Equal(t, tt.newPoolID, vs.PoolRef.ID)
+				} else {
+					assert.Nil(t, vs)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.newPoolID, vs.PoolRef.ID)
+			}
+
+			assert.Equal(t, tt.expectedSwitches, state.switches)
+			if tt.check != nil {
+				tt.check(t, state)
+			}
+		})
+	}
+}
+
+func TestClient_setVirtualServicePool(t *testing.T) {
+	defer func(f func(fakeVirtualServiceClient, *govcdtypes.NsxtAlbVirtualService) (*govcd.NsxtAlbVirtualService, error)) {
+		updateVirtualService = f
+	}(updateVirtualService)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clientCAV := NewMockclientFake(ctrl)
+	c, _ := NewFakeClient(clientCAV)
+
+	urnVirtualService := urn.LoadBalancerVirtualService.String() + uuid.New().String()
+	urnPool := urn.LoadBalancerPool.String() + uuid.New().String()
+
+	// The pool has been changed by someone else since the virtual service was read
+	clientCAV.EXPECT().Refresh().Return(nil)
+	clientCAV.EXPECT().GetAlbVirtualServiceById(urnVirtualService).Return(&govcd.NsxtAlbVirtualService{
+		NsxtAlbVirtualService: &govcdtypes.NsxtAlbVirtualService{
+			ID:                  urnVirtualService,
+			LoadBalancerPoolRef: govcdtypes.OpenApiReference{ID: urn.LoadBalancerPool.String() + uuid.New().String()},
+		},
+	}, nil)
+	updateVirtualService = func(_ fakeVirtualServiceClient, _ *govcdtypes.NsxtAlbVirtualService) (*govcd.NsxtAlbVirtualService, error) {
+		t.Fatal("the virtual service must not be updated")
+		return nil, nil
+	}
+
+	_, err := c.(*client).setVirtualServicePool(context.Background(), urnVirtualService, urnPool, govcdtypes.OpenApiReference{ID: urnPool})
+	assert.ErrorIs(t, err, sdkerrors.ErrLoadBalancerVirtualServiceConflict)
+	assert.ErrorIs(t, err, sdkerrors.ErrConflict)
+}
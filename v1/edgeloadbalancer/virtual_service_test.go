@@ -24,6 +24,7 @@ import (
 	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
 
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/internal/utils"
+	sdkerrors "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/errors"
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/urn"
 )
 
@@ -80,6 +81,28 @@ func TestVirtualServiceRequestValidation(t *testing.T) {
 			expectedErr: true,
 			err:         errors.New("Field validation for 'ApplicationProfile'"),
 		},
+		{
+			name: "error-ipv6-address",
+			virtualModel: VirtualServiceModelRequest{
+				Name:                 testVirtualServiceName1,
+				Description:          testVirtualServiceDesc1,
+				Enabled:              utils.ToPTR(true),
+				ApplicationProfile:   VirtualServiceModelApplicationProfile("HTTP"),
+				PoolID:               poolID,
+				EdgeGatewayID:        edgeGatewayID,
+				ServiceEngineGroupID: &serviceEngineID,
+				ServicePorts: []VirtualServiceModelServicePort{
+					{
+						Start: utils.ToPTR(80),
+						End:   nil,
+					},
+				},
+				VirtualIPAddress:     testIPAddress,
+				IPv6VirtualIPAddress: testIPAddress,
+			},
+			expectedErr: true,
+			err:         errors.New("Field validation for 'IPv6VirtualIPAddress'"),
+		},
 		{
 			name: "error-invalid-pool-id",
 			virtualModel: VirtualServiceModelRequest{
@@ -920,6 +943,7 @@ func TestClient_CreateVirtualService(t *testing.T) {
 			},
 			mockFunc: func() {
 				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(gomock.Any()).Return(&govcd.NsxtEdgeGateway{EdgeGateway: &govcdtypes.OpenAPIEdgeGateway{}}, nil)
 				clientCAV.EXPECT().CreateNsxtAlbVirtualService(gomock.AssignableToTypeOf(&govcdtypes.NsxtAlbVirtualService{})).Return(&govcd.NsxtAlbVirtualService{
 					NsxtAlbVirtualService: &govcdtypes.NsxtAlbVirtualService{
 						ID:          virtualServiceID,
@@ -1004,6 +1028,7 @@ func TestClient_CreateVirtualService(t *testing.T) {
 			},
 			mockFunc: func() {
 				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(gomock.Any()).Return(&govcd.NsxtEdgeGateway{EdgeGateway: &govcdtypes.OpenAPIEdgeGateway{}}, nil)
 				clientCAV.EXPECT().CreateNsxtAlbVirtualService(gomock.AssignableToTypeOf(&govcdtypes.NsxtAlbVirtualService{})).Return(&govcd.NsxtAlbVirtualService{
 					NsxtAlbVirtualService: &govcdtypes.NsxtAlbVirtualService{
 						ID:          virtualServiceID,
@@ -1088,6 +1113,7 @@ func TestClient_CreateVirtualService(t *testing.T) {
 			},
 			mockFunc: func() {
 				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(gomock.Any()).Return(&govcd.NsxtEdgeGateway{EdgeGateway: &govcdtypes.OpenAPIEdgeGateway{}}, nil)
 				clientCAV.EXPECT().CreateNsxtAlbVirtualService(gomock.AssignableToTypeOf(&govcdtypes.NsxtAlbVirtualService{})).Return(&govcd.NsxtAlbVirtualService{
 					NsxtAlbVirtualService: &govcdtypes.NsxtAlbVirtualService{
 						ID:          virtualServiceID,
@@ -1173,6 +1199,7 @@ func TestClient_CreateVirtualService(t *testing.T) {
 			},
 			mockFunc: func() {
 				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(gomock.Any()).Return(&govcd.NsxtEdgeGateway{EdgeGateway: &govcdtypes.OpenAPIEdgeGateway{}}, nil)
 				clientCAV.EXPECT().CreateNsxtAlbVirtualService(gomock.AssignableToTypeOf(&govcdtypes.NsxtAlbVirtualService{})).Return(&govcd.NsxtAlbVirtualService{
 					NsxtAlbVirtualService: &govcdtypes.NsxtAlbVirtualService{
 						ID:          virtualServiceID,
@@ -1262,6 +1289,7 @@ func TestClient_CreateVirtualService(t *testing.T) {
 			},
 			mockFunc: func() {
 				clientCAV.EXPECT().Refresh().Return(nil).Times(2)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(gomock.Any()).Return(&govcd.NsxtEdgeGateway{EdgeGateway: &govcdtypes.OpenAPIEdgeGateway{}}, nil)
 				v := url.Values{}
 				v.Add("filter", "gatewayRef.id=="+edgeGatewayID)
 				clientCAV.EXPECT().GetAllAlbServiceEngineGroupAssignments(gomock.AssignableToTypeOf(v)).Return([]*govcd.NsxtAlbServiceEngineGroupAssignment{
@@ -1364,6 +1392,7 @@ func TestClient_CreateVirtualService(t *testing.T) {
 			},
 			mockFunc: func() {
 				clientCAV.EXPECT().Refresh().Return(nil).Times(2)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(gomock.Any()).Return(&govcd.NsxtEdgeGateway{EdgeGateway: &govcdtypes.OpenAPIEdgeGateway{}}, nil)
 				v := url.Values{}
 				v.Add("filter", "gatewayRef.id=="+edgeGatewayID)
 				clientCAV.EXPECT().GetAllAlbServiceEngineGroupAssignments(gomock.AssignableToTypeOf(v)).Return(nil, errors.New("error"))
@@ -1399,6 +1428,7 @@ func TestClient_CreateVirtualService(t *testing.T) {
 			},
 			mockFunc: func() {
 				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(gomock.Any()).Return(&govcd.NsxtEdgeGateway{EdgeGateway: &govcdtypes.OpenAPIEdgeGateway{}}, nil)
 				clientCAV.EXPECT().CreateNsxtAlbVirtualService(gomock.AssignableToTypeOf(&govcdtypes.NsxtAlbVirtualService{})).Return(nil, errors.New("error"))
 			},
 			expectedValue: nil,
@@ -1498,6 +1528,7 @@ func TestClient_UpdateVirtualService(t *testing.T) {
 			},
 			mockFunc: func() {
 				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(gomock.Any()).Return(&govcd.NsxtEdgeGateway{EdgeGateway: &govcdtypes.OpenAPIEdgeGateway{}}, nil)
 				clientCAV.EXPECT().GetAlbVirtualServiceById(virtualServiceID).Return(&govcd.NsxtAlbVirtualService{
 					NsxtAlbVirtualService: &govcdtypes.NsxtAlbVirtualService{
 						ID:          virtualServiceID,
@@ -1622,6 +1653,7 @@ func TestClient_UpdateVirtualService(t *testing.T) {
 			},
 			mockFunc: func() {
 				clientCAV.EXPECT().Refresh().Return(nil).Times(2)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(gomock.Any()).Return(&govcd.NsxtEdgeGateway{EdgeGateway: &govcdtypes.OpenAPIEdgeGateway{}}, nil)
 				clientCAV.EXPECT().GetAlbVirtualServiceById(virtualServiceID).Return(&govcd.NsxtAlbVirtualService{
 					NsxtAlbVirtualService: &govcdtypes.NsxtAlbVirtualService{
 						ID:          virtualServiceID,
@@ -1766,6 +1798,7 @@ func TestClient_UpdateVirtualService(t *testing.T) {
 			},
 			mockFunc: func() {
 				clientCAV.EXPECT().Refresh().Return(nil).Times(2)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(gomock.Any()).Return(&govcd.NsxtEdgeGateway{EdgeGateway: &govcdtypes.OpenAPIEdgeGateway{}}, nil)
 				clientCAV.EXPECT().GetAlbVirtualServiceById(virtualServiceID).Return(&govcd.NsxtAlbVirtualService{
 					NsxtAlbVirtualService: &govcdtypes.NsxtAlbVirtualService{
 						ID:          virtualServiceID,
@@ -1905,6 +1938,7 @@ func TestClient_UpdateVirtualService(t *testing.T) {
 			virtualServiceID: virtualServiceID,
 			mockFunc: func() {
 				clientCAV.EXPECT().Refresh().Return(nil)
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(gomock.Any()).Return(&govcd.NsxtEdgeGateway{EdgeGateway: &govcdtypes.OpenAPIEdgeGateway{}}, nil)
 				clientCAV.EXPECT().GetAlbVirtualServiceById(virtualServiceID).Return(nil, nil)
 				updateVirtualService = func(_ fakeVirtualServiceClient, _ *govcdtypes.NsxtAlbVirtualService) (*govcd.NsxtAlbVirtualService, error) {
 					return nil, errors.New("error")
@@ -2041,3 +2075,166 @@ func TestClient_DeleteVirtualService(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_validateVirtualServiceNetwork(t *testing.T) {
+	// Mock controller.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mock client for cloudavenue.
+	clientCAV := NewMockclientFake(ctrl)
+
+	c, _ := NewFakeClient(clientCAV)
+
+	edgeGatewayID := urn.Gateway.String() + uuid.New().String()
+
+	testEdgeGateway := &govcd.NsxtEdgeGateway{
+		EdgeGateway: &govcdtypes.OpenAPIEdgeGateway{
+			ID: edgeGatewayID,
+			EdgeGatewayUplinks: []govcdtypes.EdgeGatewayUplinks{
+				{
+					Subnets: govcdtypes.OpenAPIEdgeGatewaySubnets{
+						Values: []govcdtypes.OpenAPIEdgeGatewaySubnetValue{
+							{
+								Gateway:      "192.168.0.254",
+								PrefixLength: 24,
+								IPRanges: &govcdtypes.OpenApiIPRanges{
+									Values: []govcdtypes.OpenApiIPRangeValues{
+										{StartAddress: "192.168.0.1", EndAddress: "192.168.0.10"},
+									},
+								},
+							},
+							{
+								Gateway:      "2001:db8::1",
+								PrefixLength: 64,
+								IPRanges: &govcdtypes.OpenApiIPRanges{
+									Values: []govcdtypes.OpenApiIPRangeValues{
+										{StartAddress: "2001:db8::10", EndAddress: "2001:db8::20"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		vsr         VirtualServiceModelRequest
+		mockFunc    func()
+		albSettings *govcdtypes.NsxtAlbConfig
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: testSuccess,
+			vsr: VirtualServiceModelRequest{
+				EdgeGatewayID:    edgeGatewayID,
+				VirtualIPAddress: testIPAddress,
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testEdgeGateway, nil)
+			},
+		},
+		{
+			name: "success-dual-stack-transparent",
+			vsr: VirtualServiceModelRequest{
+				EdgeGatewayID:          edgeGatewayID,
+				VirtualIPAddress:       testIPAddress,
+				IPv6VirtualIPAddress:   "2001:db8::15",
+				TransparentModeEnabled: utils.ToPTR(true),
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testEdgeGateway, nil)
+			},
+			albSettings: &govcdtypes.NsxtAlbConfig{TransparentModeEnabled: utils.ToPTR(true)},
+		},
+		{
+			name: "success-internal-vip",
+			vsr: VirtualServiceModelRequest{
+				EdgeGatewayID:    edgeGatewayID,
+				VirtualIPAddress: "10.0.0.1",
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testEdgeGateway, nil)
+			},
+		},
+		{
+			name: "error-vip-not-allocated",
+			vsr: VirtualServiceModelRequest{
+				EdgeGatewayID:    edgeGatewayID,
+				VirtualIPAddress: "192.168.0.100",
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testEdgeGateway, nil)
+			},
+			expectedErr: true,
+			err:         sdkerrors.ErrInvalidFormat,
+		},
+		{
+			name: "error-ipv6-vip-not-allocated",
+			vsr: VirtualServiceModelRequest{
+				EdgeGatewayID:        edgeGatewayID,
+				VirtualIPAddress:     testIPAddress,
+				IPv6VirtualIPAddress: "2001:db8::1",
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testEdgeGateway, nil)
+			},
+			expectedErr: true,
+			err:         sdkerrors.ErrInvalidFormat,
+		},
+		{
+			name: "error-transparent-mode-disabled",
+			vsr: VirtualServiceModelRequest{
+				EdgeGatewayID:          edgeGatewayID,
+				VirtualIPAddress:       testIPAddress,
+				TransparentModeEnabled: utils.ToPTR(true),
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(testEdgeGateway, nil)
+			},
+			albSettings: &govcdtypes.NsxtAlbConfig{},
+			expectedErr: true,
+			err:         sdkerrors.ErrInvalidFormat,
+		},
+		{
+			name: "error-get-edge-gateway",
+			vsr: VirtualServiceModelRequest{
+				EdgeGatewayID:    edgeGatewayID,
+				VirtualIPAddress: testIPAddress,
+			},
+			mockFunc: func() {
+				clientCAV.EXPECT().GetNsxtEdgeGatewayById(edgeGatewayID).Return(nil, errors.New("error"))
+			},
+			expectedErr: true,
+			err:         errors.New("error retrieving edge gateway"),
+		},
+	}
+
+	defer func(f func(fakeLoadBalancerEdgeGatewayClient) (*govcdtypes.NsxtAlbConfig, error)) { getAlbSettings = f }(getAlbSettings)
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockFunc()
+
+			getAlbSettings = func(_ fakeLoadBalancerEdgeGatewayClient) (*govcdtypes.NsxtAlbConfig, error) {
+				return tc.albSettings, nil
+			}
+
+			err := c.(*client).validateVirtualServiceNetwork(tc.vsr)
+			if !tc.expectedErr {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Error(t, err)
+			if errors.Is(err, tc.err) {
+				return
+			}
+			assert.Contains(t, err.Error(), tc.err.Error())
+		})
+	}
+}
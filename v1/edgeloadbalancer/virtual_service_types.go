@@ -11,6 +11,7 @@ package edgeloadbalancer
 
 import (
 	"net/url"
+	"time"
 
 	"github.com/vmware/go-vcloud-director/v2/govcd"
 	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
@@ -65,6 +66,14 @@ type (
 		// VirtualIpAddress to be used for exposing this virtual service
 		VirtualIPAddress string
 
+		// IPv6VirtualIPAddress is the IPv6 address exposing this virtual service in addition to
+		// VirtualIPAddress (dual-stack). Empty if the virtual service is IPv4 only.
+		IPv6VirtualIPAddress string
+
+		// TransparentModeEnabled preserves the client IP address when forwarding traffic to the
+		// pool members. It requires the Load Balancer of the edge gateway to be in transparent mode.
+		TransparentModeEnabled bool
+
 		// HealthStatus contains status of the Load Balancer Cloud. Possible values are:
 		// VirtualServiceHealthStatusUP - The cloud is healthy and ready to enable Load Balancer for an Edge Gateway.
 		// VirtualServiceHealthStatusDOWN - The cloud is in a failure state. Enabling Load balancer on an Edge Gateway may not be possible.
@@ -88,41 +97,84 @@ type (
 	VirtualServiceModelServicePort struct {
 		// To make a range of ports, set the first value in Start and end value in End.
 		// To make a single port, set the same value in PortStart and PortEnd.
-		Start *int `validate:"required,gte=1,lte=65535"`
-		End   *int `validate:"omitempty,gte=1,lte=65535,gtfield=Start"`
+		Start *int `json:"start" validate:"required,gte=1,lte=65535"`
+		End   *int `json:"end" validate:"omitempty,gte=1,lte=65535,gtfield=Start"`
 	}
 
 	VirtualServiceModelRequest struct {
-		Name        string `validate:"required"`
-		Description string `validate:"omitempty"`
+		Name        string `json:"name" validate:"required"`
+		Description string `json:"description" validate:"omitempty"`
 
 		// Enabled defines if the virtual service is enabled to accept traffic
-		Enabled *bool `validate:"required"`
+		Enabled *bool `json:"enabled" validate:"required"`
 
 		// ApplicationProfile sets protocol for load balancing
-		ApplicationProfile VirtualServiceModelApplicationProfile `validate:"required,oneof=HTTP HTTPS L4_TCP L4_UDP L4_TLS"`
+		ApplicationProfile VirtualServiceModelApplicationProfile `json:"applicationProfile" validate:"required,oneof=HTTP HTTPS L4_TCP L4_UDP L4_TLS"`
 
 		// PoolID contains a reference to the ELB Pool to be used for the virtual service
-		PoolID string `validate:"required,urn_rfc2141,urn=loadBalancerPool"`
+		PoolID string `json:"poolID" validate:"required,urn_rfc2141,urn=loadBalancerPool"`
 
 		// ServiceEngineGroupID contains Service Engine Group reference to be used for the virtual service.
 		// If not set and if more than one service engine group is assigned to the edge gateway: return an error.
 		// If not set and if only one service engine group is assigned to the edge gateway it uses that service engine group.
 		// If set it uses the provided service engine group.
-		ServiceEngineGroupID *string `validate:"omitempty,urn_rfc2141,urn=serviceEngineGroup"`
+		ServiceEngineGroupID *string `json:"serviceEngineGroupID" validate:"omitempty,urn_rfc2141,urn=serviceEngineGroup"`
 
 		// EdgeGatewayID contains a reference to the Edge Gateway where the virtual service will be created
-		EdgeGatewayID string `validate:"required,urn_rfc2141,urn=gateway"`
+		EdgeGatewayID string `json:"edgeGatewayID" validate:"required,urn_rfc2141,urn=gateway"`
 
 		// CertificateID contains certificate reference if serving encrypted traffic
 		// If not set, the virtual service will not serve encrypted traffic (TLS/HTTPS).
-		CertificateID *string `validate:"omitempty,urn_rfc2141,urn=certificateLibraryItem,required_if=ApplicationProfile HTTPS,required_if=ApplicationProfile L4_TLS"`
+		//
+		// Note. Cloud Director does not support SNI parent/child virtual services: a virtual service
+		// serves a single certificate, so each HTTPS hostname with its own certificate needs its own
+		// virtual IP address (or port), or a certificate covering all the hostnames (SAN or wildcard).
+		CertificateID *string `json:"certificateID" validate:"omitempty,urn_rfc2141,urn=certificateLibraryItem,required_if=ApplicationProfile HTTPS,required_if=ApplicationProfile L4_TLS"`
 
 		// ServicePorts define one or more ports (or port ranges) of the virtual service
-		ServicePorts []VirtualServiceModelServicePort `validate:"required,gte=1,dive"`
+		ServicePorts []VirtualServiceModelServicePort `json:"servicePorts" validate:"required,gte=1,dive"`
 
 		// VirtualIpAddress to be used for exposing this virtual service
-		VirtualIPAddress string `validate:"required,ip4_addr"`
+		// The address must be either outside the subnets of the edge gateway uplinks or
+		// one of the IP addresses allocated to the edge gateway.
+		// Cloud Director requires an IPv4 virtual IP address, even when IPv6VirtualIPAddress is set:
+		// an IPv6-only virtual service can't be created.
+		VirtualIPAddress string `json:"virtualIPAddress" validate:"required,ip4_addr"`
+
+		// IPv6VirtualIPAddress is an optional IPv6 address exposing this virtual service in addition
+		// to VirtualIPAddress (dual-stack only). Same rules as VirtualIPAddress apply.
+		IPv6VirtualIPAddress string `json:"ipv6VirtualIPAddress" validate:"omitempty,ip6_addr"`
+
+		// TransparentModeEnabled preserves the client IP address when forwarding traffic to the
+		// pool members. It requires the Load Balancer of the edge gateway to be in transparent mode.
+		TransparentModeEnabled *bool `json:"transparentModeEnabled"`
+	}
+
+	// VirtualServiceCreateOptions defines the behavior of the creation of a virtual service.
+	VirtualServiceCreateOptions struct {
+		// SelectServiceEngineGroupByCapacity checks the capacity of the service engine group before
+		// creating the virtual service and returns errors.ErrSEGCapacityExceeded if the group is full.
+		// If ServiceEngineGroupID is not set, the service engine group with the most available virtual
+		// services is used instead of failing when several groups are assigned to the edge gateway.
+		SelectServiceEngineGroupByCapacity bool
+	}
+
+	// VirtualServiceSwitchPoolOptions defines the behavior of SwitchPool.
+	VirtualServiceSwitchPoolOptions struct {
+		// HealthyMemberThreshold is the minimum number of members of the new pool reporting UP
+		// before the virtual service is switched. The minimum is 1, which is also the default
+		// when the field is not set.
+		HealthyMemberThreshold int `validate:"omitempty,gte=1"`
+
+		// DisableOldPoolMembers disables the members of the old pool once the switch is done,
+		// after waiting for the GracefulTimeoutPeriod of the old pool so the connections are drained.
+		DisableOldPoolMembers bool
+
+		// RevertWatchPeriod is the time during which the health of the virtual service is watched
+		// after the switch. If the virtual service reports DOWN during this period, or if the watch
+		// cannot complete, the old pool is restored and errors.ErrLoadBalancerPoolSwitchReverted is returned.
+		// The health is not watched if RevertWatchPeriod is 0.
+		RevertWatchPeriod time.Duration `validate:"omitempty,gte=0"`
 	}
 )
 
@@ -145,15 +197,17 @@ func fromVCDNsxtAlbVirtualServiceToModel(vs govcdtypes.NsxtAlbVirtualService) *V
 			}
 			return VirtualServiceModelApplicationProfile(vs.ApplicationProfile.Type)
 		}(),
-		PoolRef:               vs.LoadBalancerPoolRef,
-		EdgeGatewayRef:        vs.GatewayRef,
-		ServiceEngineGroupRef: &vs.ServiceEngineGroupRef,
-		CertificateRef:        vs.CertificateRef,
-		ServicePorts:          fromVCDNsxtAlbVirtualServiceServicePortToModel(vs.ServicePorts),
-		VirtualIPAddress:      vs.VirtualIpAddress,
-		HealthStatus:          VirtualServiceModelHealthStatus(vs.HealthStatus),
-		HealthMessage:         vs.HealthMessage,
-		DetailedHealthMessage: vs.DetailedHealthMessage,
+		PoolRef:                vs.LoadBalancerPoolRef,
+		EdgeGatewayRef:         vs.GatewayRef,
+		ServiceEngineGroupRef:  &vs.ServiceEngineGroupRef,
+		CertificateRef:         vs.CertificateRef,
+		ServicePorts:           fromVCDNsxtAlbVirtualServiceServicePortToModel(vs.ServicePorts),
+		VirtualIPAddress:       vs.VirtualIpAddress,
+		IPv6VirtualIPAddress:   vs.IPv6VirtualIpAddress,
+		TransparentModeEnabled: vs.TransparentModeEnabled != nil && *vs.TransparentModeEnabled,
+		HealthStatus:           VirtualServiceModelHealthStatus(vs.HealthStatus),
+		HealthMessage:          vs.HealthMessage,
+		DetailedHealthMessage:  vs.DetailedHealthMessage,
 	}
 }
 
@@ -205,8 +259,10 @@ func fromModelRequestToVCDNsxtAlbVirtualService(vs VirtualServiceModelRequest) *
 				ID: *vs.CertificateID,
 			}
 		}(),
-		ServicePorts:     fromModelRequestServicePortToVCDNsxtAlbVirtualServiceServicePort(vs.ApplicationProfile, vs.ServicePorts),
-		VirtualIpAddress: vs.VirtualIPAddress,
+		ServicePorts:           fromModelRequestServicePortToVCDNsxtAlbVirtualServiceServicePort(vs.ApplicationProfile, vs.ServicePorts),
+		VirtualIpAddress:       vs.VirtualIPAddress,
+		IPv6VirtualIpAddress:   vs.IPv6VirtualIPAddress,
+		TransparentModeEnabled: vs.TransparentModeEnabled,
 	}
 }
 
@@ -51,6 +51,96 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// AddPolicyHTTPRequest mocks base method.
+func (m *MockClient) AddPolicyHTTPRequest(ctx context.Context, virtualServiceID string, policy *PoliciesHTTPRequestModelPolicy, position PoliciesHTTPPolicyPosition, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPRequestModel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, virtualServiceID, policy, position}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddPolicyHTTPRequest", varargs...)
+	ret0, _ := ret[0].(*PoliciesHTTPRequestModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPolicyHTTPRequest indicates an expected call of AddPolicyHTTPRequest.
+func (mr *MockClientMockRecorder) AddPolicyHTTPRequest(ctx, virtualServiceID, policy, position any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, virtualServiceID, policy, position}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPolicyHTTPRequest", reflect.TypeOf((*MockClient)(nil).AddPolicyHTTPRequest), varargs...)
+}
+
+// AddPolicyHTTPResponse mocks base method.
+func (m *MockClient) AddPolicyHTTPResponse(ctx context.Context, virtualServiceID string, policy *PoliciesHTTPResponseModelPolicy, position PoliciesHTTPPolicyPosition, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPResponseModel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, virtualServiceID, policy, position}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddPolicyHTTPResponse", varargs...)
+	ret0, _ := ret[0].(*PoliciesHTTPResponseModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPolicyHTTPResponse indicates an expected call of AddPolicyHTTPResponse.
+func (mr *MockClientMockRecorder) AddPolicyHTTPResponse(ctx, virtualServiceID, policy, position any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, virtualServiceID, policy, position}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPolicyHTTPResponse", reflect.TypeOf((*MockClient)(nil).AddPolicyHTTPResponse), varargs...)
+}
+
+// AddPolicyHTTPSecurity mocks base method.
+func (m *MockClient) AddPolicyHTTPSecurity(ctx context.Context, virtualServiceID string, policy *PoliciesHTTPSecurityModelPolicy, position PoliciesHTTPPolicyPosition, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPSecurityModel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, virtualServiceID, policy, position}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddPolicyHTTPSecurity", varargs...)
+	ret0, _ := ret[0].(*PoliciesHTTPSecurityModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPolicyHTTPSecurity indicates an expected call of AddPolicyHTTPSecurity.
+func (mr *MockClientMockRecorder) AddPolicyHTTPSecurity(ctx, virtualServiceID, policy, position any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, virtualServiceID, policy, position}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPolicyHTTPSecurity", reflect.TypeOf((*MockClient)(nil).AddPolicyHTTPSecurity), varargs...)
+}
+
+// AddPoolMember mocks base method.
+func (m *MockClient) AddPoolMember(ctx context.Context, poolID string, member PoolModelMember) (*PoolModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPoolMember", ctx, poolID, member)
+	ret0, _ := ret[0].(*PoolModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPoolMember indicates an expected call of AddPoolMember.
+func (mr *MockClientMockRecorder) AddPoolMember(ctx, poolID, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPoolMember", reflect.TypeOf((*MockClient)(nil).AddPoolMember), ctx, poolID, member)
+}
+
+// AssignServiceEngineGroup mocks base method.
+func (m *MockClient) AssignServiceEngineGroup(ctx context.Context, edgeGatewayID string, assignment ServiceEngineGroupAssignmentModelRequest) (*ServiceEngineGroupModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignServiceEngineGroup", ctx, edgeGatewayID, assignment)
+	ret0, _ := ret[0].(*ServiceEngineGroupModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignServiceEngineGroup indicates an expected call of AssignServiceEngineGroup.
+func (mr *MockClientMockRecorder) AssignServiceEngineGroup(ctx, edgeGatewayID, assignment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignServiceEngineGroup", reflect.TypeOf((*MockClient)(nil).AssignServiceEngineGroup), ctx, edgeGatewayID, assignment)
+}
+
 // CreatePool mocks base method.
 func (m *MockClient) CreatePool(ctx context.Context, pool PoolModelRequest) (*PoolModel, error) {
 	m.ctrl.T.Helper()
@@ -81,6 +171,21 @@ func (mr *MockClientMockRecorder) CreateVirtualService(ctx, vsr any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualService", reflect.TypeOf((*MockClient)(nil).CreateVirtualService), ctx, vsr)
 }
 
+// CreateVirtualServiceWithOptions mocks base method.
+func (m *MockClient) CreateVirtualServiceWithOptions(ctx context.Context, vsr VirtualServiceModelRequest, opts VirtualServiceCreateOptions) (*VirtualServiceModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVirtualServiceWithOptions", ctx, vsr, opts)
+	ret0, _ := ret[0].(*VirtualServiceModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVirtualServiceWithOptions indicates an expected call of CreateVirtualServiceWithOptions.
+func (mr *MockClientMockRecorder) CreateVirtualServiceWithOptions(ctx, vsr, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualServiceWithOptions", reflect.TypeOf((*MockClient)(nil).CreateVirtualServiceWithOptions), ctx, vsr, opts)
+}
+
 // DeletePoliciesHTTPRequest mocks base method.
 func (m *MockClient) DeletePoliciesHTTPRequest(ctx context.Context, virtualServiceID string) error {
 	m.ctrl.T.Helper()
@@ -123,6 +228,63 @@ func (mr *MockClientMockRecorder) DeletePoliciesHTTPSecurity(ctx, virtualService
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePoliciesHTTPSecurity", reflect.TypeOf((*MockClient)(nil).DeletePoliciesHTTPSecurity), ctx, virtualServiceID)
 }
 
+// DeletePolicyHTTPRequestByName mocks base method.
+func (m *MockClient) DeletePolicyHTTPRequestByName(ctx context.Context, virtualServiceID, name string, opts ...PoliciesHTTPUpdateOptions) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, virtualServiceID, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeletePolicyHTTPRequestByName", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePolicyHTTPRequestByName indicates an expected call of DeletePolicyHTTPRequestByName.
+func (mr *MockClientMockRecorder) DeletePolicyHTTPRequestByName(ctx, virtualServiceID, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, virtualServiceID, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePolicyHTTPRequestByName", reflect.TypeOf((*MockClient)(nil).DeletePolicyHTTPRequestByName), varargs...)
+}
+
+// DeletePolicyHTTPResponseByName mocks base method.
+func (m *MockClient) DeletePolicyHTTPResponseByName(ctx context.Context, virtualServiceID, name string, opts ...PoliciesHTTPUpdateOptions) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, virtualServiceID, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeletePolicyHTTPResponseByName", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePolicyHTTPResponseByName indicates an expected call of DeletePolicyHTTPResponseByName.
+func (mr *MockClientMockRecorder) DeletePolicyHTTPResponseByName(ctx, virtualServiceID, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, virtualServiceID, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePolicyHTTPResponseByName", reflect.TypeOf((*MockClient)(nil).DeletePolicyHTTPResponseByName), varargs...)
+}
+
+// DeletePolicyHTTPSecurityByName mocks base method.
+func (m *MockClient) DeletePolicyHTTPSecurityByName(ctx context.Context, virtualServiceID, name string, opts ...PoliciesHTTPUpdateOptions) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, virtualServiceID, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeletePolicyHTTPSecurityByName", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePolicyHTTPSecurityByName indicates an expected call of DeletePolicyHTTPSecurityByName.
+func (mr *MockClientMockRecorder) DeletePolicyHTTPSecurityByName(ctx, virtualServiceID, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, virtualServiceID, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePolicyHTTPSecurityByName", reflect.TypeOf((*MockClient)(nil).DeletePolicyHTTPSecurityByName), varargs...)
+}
+
 // DeletePool mocks base method.
 func (m *MockClient) DeletePool(ctx context.Context, poolID string) error {
 	m.ctrl.T.Helper()
@@ -151,6 +313,50 @@ func (mr *MockClientMockRecorder) DeleteVirtualService(ctx, virtualServiceID any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVirtualService", reflect.TypeOf((*MockClient)(nil).DeleteVirtualService), ctx, virtualServiceID)
 }
 
+// DisableLoadBalancer mocks base method.
+func (m *MockClient) DisableLoadBalancer(ctx context.Context, edgeGatewayID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableLoadBalancer", ctx, edgeGatewayID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableLoadBalancer indicates an expected call of DisableLoadBalancer.
+func (mr *MockClientMockRecorder) DisableLoadBalancer(ctx, edgeGatewayID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableLoadBalancer", reflect.TypeOf((*MockClient)(nil).DisableLoadBalancer), ctx, edgeGatewayID)
+}
+
+// EnableLoadBalancer mocks base method.
+func (m *MockClient) EnableLoadBalancer(ctx context.Context, edgeGatewayID string, config LoadBalancerConfigModelRequest) (*LoadBalancerConfigModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableLoadBalancer", ctx, edgeGatewayID, config)
+	ret0, _ := ret[0].(*LoadBalancerConfigModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableLoadBalancer indicates an expected call of EnableLoadBalancer.
+func (mr *MockClientMockRecorder) EnableLoadBalancer(ctx, edgeGatewayID, config any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableLoadBalancer", reflect.TypeOf((*MockClient)(nil).EnableLoadBalancer), ctx, edgeGatewayID, config)
+}
+
+// GetEffectiveMembers mocks base method.
+func (m *MockClient) GetEffectiveMembers(ctx context.Context, poolID string) ([]PoolModelMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEffectiveMembers", ctx, poolID)
+	ret0, _ := ret[0].([]PoolModelMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEffectiveMembers indicates an expected call of GetEffectiveMembers.
+func (mr *MockClientMockRecorder) GetEffectiveMembers(ctx, poolID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEffectiveMembers", reflect.TypeOf((*MockClient)(nil).GetEffectiveMembers), ctx, poolID)
+}
+
 // GetFirstServiceEngineGroup mocks base method.
 func (m *MockClient) GetFirstServiceEngineGroup(ctx context.Context, edgeGatewayID string) (*ServiceEngineGroupModel, error) {
 	m.ctrl.T.Helper()
@@ -166,6 +372,21 @@ func (mr *MockClientMockRecorder) GetFirstServiceEngineGroup(ctx, edgeGatewayID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFirstServiceEngineGroup", reflect.TypeOf((*MockClient)(nil).GetFirstServiceEngineGroup), ctx, edgeGatewayID)
 }
 
+// GetLoadBalancerConfig mocks base method.
+func (m *MockClient) GetLoadBalancerConfig(ctx context.Context, edgeGatewayID string) (*LoadBalancerConfigModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerConfig", ctx, edgeGatewayID)
+	ret0, _ := ret[0].(*LoadBalancerConfigModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoadBalancerConfig indicates an expected call of GetLoadBalancerConfig.
+func (mr *MockClientMockRecorder) GetLoadBalancerConfig(ctx, edgeGatewayID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerConfig", reflect.TypeOf((*MockClient)(nil).GetLoadBalancerConfig), ctx, edgeGatewayID)
+}
+
 // GetPoliciesHTTPRequest mocks base method.
 func (m *MockClient) GetPoliciesHTTPRequest(ctx context.Context, virtualServiceID string) (*PoliciesHTTPRequestModel, error) {
 	m.ctrl.T.Helper()
@@ -241,6 +462,21 @@ func (mr *MockClientMockRecorder) GetServiceEngineGroup(ctx, edgeGatewayID, name
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceEngineGroup", reflect.TypeOf((*MockClient)(nil).GetServiceEngineGroup), ctx, edgeGatewayID, nameOrID)
 }
 
+// GetServiceEngineGroupsCapacity mocks base method.
+func (m *MockClient) GetServiceEngineGroupsCapacity(ctx context.Context, edgeGatewayID string) (*ServiceEngineGroupCapacityModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceEngineGroupsCapacity", ctx, edgeGatewayID)
+	ret0, _ := ret[0].(*ServiceEngineGroupCapacityModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceEngineGroupsCapacity indicates an expected call of GetServiceEngineGroupsCapacity.
+func (mr *MockClientMockRecorder) GetServiceEngineGroupsCapacity(ctx, edgeGatewayID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceEngineGroupsCapacity", reflect.TypeOf((*MockClient)(nil).GetServiceEngineGroupsCapacity), ctx, edgeGatewayID)
+}
+
 // GetVirtualService mocks base method.
 func (m *MockClient) GetVirtualService(ctx context.Context, edgeGatewayID, virtualServiceNameOrID string) (*VirtualServiceModel, error) {
 	m.ctrl.T.Helper()
@@ -301,49 +537,292 @@ func (mr *MockClientMockRecorder) ListVirtualServices(ctx, edgeGatewayID any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualServices", reflect.TypeOf((*MockClient)(nil).ListVirtualServices), ctx, edgeGatewayID)
 }
 
+// MigratePoolMembersToIPSet mocks base method.
+func (m *MockClient) MigratePoolMembersToIPSet(ctx context.Context, poolID, ipSetName string, opts ...PoolMigrateToIPSetOptions) (*PoolModel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, poolID, ipSetName}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MigratePoolMembersToIPSet", varargs...)
+	ret0, _ := ret[0].(*PoolModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigratePoolMembersToIPSet indicates an expected call of MigratePoolMembersToIPSet.
+func (mr *MockClientMockRecorder) MigratePoolMembersToIPSet(ctx, poolID, ipSetName any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, poolID, ipSetName}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigratePoolMembersToIPSet", reflect.TypeOf((*MockClient)(nil).MigratePoolMembersToIPSet), varargs...)
+}
+
+// MovePolicyHTTPRequest mocks base method.
+func (m *MockClient) MovePolicyHTTPRequest(ctx context.Context, virtualServiceID, name string, position PoliciesHTTPPolicyPosition, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPRequestModel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, virtualServiceID, name, position}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MovePolicyHTTPRequest", varargs...)
+	ret0, _ := ret[0].(*PoliciesHTTPRequestModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MovePolicyHTTPRequest indicates an expected call of MovePolicyHTTPRequest.
+func (mr *MockClientMockRecorder) MovePolicyHTTPRequest(ctx, virtualServiceID, name, position any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, virtualServiceID, name, position}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovePolicyHTTPRequest", reflect.TypeOf((*MockClient)(nil).MovePolicyHTTPRequest), varargs...)
+}
+
+// MovePolicyHTTPResponse mocks base method.
+func (m *MockClient) MovePolicyHTTPResponse(ctx context.Context, virtualServiceID, name string, position PoliciesHTTPPolicyPosition, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPResponseModel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, virtualServiceID, name, position}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MovePolicyHTTPResponse", varargs...)
+	ret0, _ := ret[0].(*PoliciesHTTPResponseModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MovePolicyHTTPResponse indicates an expected call of MovePolicyHTTPResponse.
+func (mr *MockClientMockRecorder) MovePolicyHTTPResponse(ctx, virtualServiceID, name, position any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, virtualServiceID, name, position}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovePolicyHTTPResponse", reflect.TypeOf((*MockClient)(nil).MovePolicyHTTPResponse), varargs...)
+}
+
+// MovePolicyHTTPSecurity mocks base method.
+func (m *MockClient) MovePolicyHTTPSecurity(ctx context.Context, virtualServiceID, name string, position PoliciesHTTPPolicyPosition, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPSecurityModel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, virtualServiceID, name, position}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MovePolicyHTTPSecurity", varargs...)
+	ret0, _ := ret[0].(*PoliciesHTTPSecurityModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MovePolicyHTTPSecurity indicates an expected call of MovePolicyHTTPSecurity.
+func (mr *MockClientMockRecorder) MovePolicyHTTPSecurity(ctx, virtualServiceID, name, position any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, virtualServiceID, name, position}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovePolicyHTTPSecurity", reflect.TypeOf((*MockClient)(nil).MovePolicyHTTPSecurity), varargs...)
+}
+
+// RemovePoolMember mocks base method.
+func (m *MockClient) RemovePoolMember(ctx context.Context, poolID, ipAddress string, port int) (*PoolModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePoolMember", ctx, poolID, ipAddress, port)
+	ret0, _ := ret[0].(*PoolModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemovePoolMember indicates an expected call of RemovePoolMember.
+func (mr *MockClientMockRecorder) RemovePoolMember(ctx, poolID, ipAddress, port any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePoolMember", reflect.TypeOf((*MockClient)(nil).RemovePoolMember), ctx, poolID, ipAddress, port)
+}
+
+// RollingMaintenance mocks base method.
+func (m *MockClient) RollingMaintenance(ctx context.Context, poolID string, fn PoolMemberMaintenanceFunc) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollingMaintenance", ctx, poolID, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollingMaintenance indicates an expected call of RollingMaintenance.
+func (mr *MockClientMockRecorder) RollingMaintenance(ctx, poolID, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollingMaintenance", reflect.TypeOf((*MockClient)(nil).RollingMaintenance), ctx, poolID, fn)
+}
+
+// SetPoolMemberEnabled mocks base method.
+func (m *MockClient) SetPoolMemberEnabled(ctx context.Context, poolID, ipAddress string, port int, enabled bool) (*PoolModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPoolMemberEnabled", ctx, poolID, ipAddress, port, enabled)
+	ret0, _ := ret[0].(*PoolModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPoolMemberEnabled indicates an expected call of SetPoolMemberEnabled.
+func (mr *MockClientMockRecorder) SetPoolMemberEnabled(ctx, poolID, ipAddress, port, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPoolMemberEnabled", reflect.TypeOf((*MockClient)(nil).SetPoolMemberEnabled), ctx, poolID, ipAddress, port, enabled)
+}
+
+// SetPoolMemberRatio mocks base method.
+func (m *MockClient) SetPoolMemberRatio(ctx context.Context, poolID, ipAddress string, port, ratio int) (*PoolModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPoolMemberRatio", ctx, poolID, ipAddress, port, ratio)
+	ret0, _ := ret[0].(*PoolModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPoolMemberRatio indicates an expected call of SetPoolMemberRatio.
+func (mr *MockClientMockRecorder) SetPoolMemberRatio(ctx, poolID, ipAddress, port, ratio any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPoolMemberRatio", reflect.TypeOf((*MockClient)(nil).SetPoolMemberRatio), ctx, poolID, ipAddress, port, ratio)
+}
+
+// SwitchPool mocks base method.
+func (m *MockClient) SwitchPool(ctx context.Context, virtualServiceID, newPoolID string, opts VirtualServiceSwitchPoolOptions) (*VirtualServiceModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SwitchPool", ctx, virtualServiceID, newPoolID, opts)
+	ret0, _ := ret[0].(*VirtualServiceModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SwitchPool indicates an expected call of SwitchPool.
+func (mr *MockClientMockRecorder) SwitchPool(ctx, virtualServiceID, newPoolID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwitchPool", reflect.TypeOf((*MockClient)(nil).SwitchPool), ctx, virtualServiceID, newPoolID, opts)
+}
+
+// UnassignServiceEngineGroup mocks base method.
+func (m *MockClient) UnassignServiceEngineGroup(ctx context.Context, edgeGatewayID, nameOrID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignServiceEngineGroup", ctx, edgeGatewayID, nameOrID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnassignServiceEngineGroup indicates an expected call of UnassignServiceEngineGroup.
+func (mr *MockClientMockRecorder) UnassignServiceEngineGroup(ctx, edgeGatewayID, nameOrID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignServiceEngineGroup", reflect.TypeOf((*MockClient)(nil).UnassignServiceEngineGroup), ctx, edgeGatewayID, nameOrID)
+}
+
 // UpdatePoliciesHTTPRequest mocks base method.
-func (m *MockClient) UpdatePoliciesHTTPRequest(ctx context.Context, policies *PoliciesHTTPRequestModel) (*PoliciesHTTPRequestModel, error) {
+func (m *MockClient) UpdatePoliciesHTTPRequest(ctx context.Context, policies *PoliciesHTTPRequestModel, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPRequestModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePoliciesHTTPRequest", ctx, policies)
+	varargs := []any{ctx, policies}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdatePoliciesHTTPRequest", varargs...)
 	ret0, _ := ret[0].(*PoliciesHTTPRequestModel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdatePoliciesHTTPRequest indicates an expected call of UpdatePoliciesHTTPRequest.
-func (mr *MockClientMockRecorder) UpdatePoliciesHTTPRequest(ctx, policies any) *gomock.Call {
+func (mr *MockClientMockRecorder) UpdatePoliciesHTTPRequest(ctx, policies any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePoliciesHTTPRequest", reflect.TypeOf((*MockClient)(nil).UpdatePoliciesHTTPRequest), ctx, policies)
+	varargs := append([]any{ctx, policies}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePoliciesHTTPRequest", reflect.TypeOf((*MockClient)(nil).UpdatePoliciesHTTPRequest), varargs...)
 }
 
 // UpdatePoliciesHTTPResponse mocks base method.
-func (m *MockClient) UpdatePoliciesHTTPResponse(ctx context.Context, policies *PoliciesHTTPResponseModel) (*PoliciesHTTPResponseModel, error) {
+func (m *MockClient) UpdatePoliciesHTTPResponse(ctx context.Context, policies *PoliciesHTTPResponseModel, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPResponseModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePoliciesHTTPResponse", ctx, policies)
+	varargs := []any{ctx, policies}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdatePoliciesHTTPResponse", varargs...)
 	ret0, _ := ret[0].(*PoliciesHTTPResponseModel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdatePoliciesHTTPResponse indicates an expected call of UpdatePoliciesHTTPResponse.
-func (mr *MockClientMockRecorder) UpdatePoliciesHTTPResponse(ctx, policies any) *gomock.Call {
+func (mr *MockClientMockRecorder) UpdatePoliciesHTTPResponse(ctx, policies any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePoliciesHTTPResponse", reflect.TypeOf((*MockClient)(nil).UpdatePoliciesHTTPResponse), ctx, policies)
+	varargs := append([]any{ctx, policies}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePoliciesHTTPResponse", reflect.TypeOf((*MockClient)(nil).UpdatePoliciesHTTPResponse), varargs...)
 }
 
 // UpdatePoliciesHTTPSecurity mocks base method.
-func (m *MockClient) UpdatePoliciesHTTPSecurity(ctx context.Context, policies *PoliciesHTTPSecurityModel) (*PoliciesHTTPSecurityModel, error) {
+func (m *MockClient) UpdatePoliciesHTTPSecurity(ctx context.Context, policies *PoliciesHTTPSecurityModel, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPSecurityModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePoliciesHTTPSecurity", ctx, policies)
+	varargs := []any{ctx, policies}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdatePoliciesHTTPSecurity", varargs...)
 	ret0, _ := ret[0].(*PoliciesHTTPSecurityModel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdatePoliciesHTTPSecurity indicates an expected call of UpdatePoliciesHTTPSecurity.
-func (mr *MockClientMockRecorder) UpdatePoliciesHTTPSecurity(ctx, policies any) *gomock.Call {
+func (mr *MockClientMockRecorder) UpdatePoliciesHTTPSecurity(ctx, policies any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, policies}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePoliciesHTTPSecurity", reflect.TypeOf((*MockClient)(nil).UpdatePoliciesHTTPSecurity), varargs...)
+}
+
+// UpdatePolicyHTTPRequestByName mocks base method.
+func (m *MockClient) UpdatePolicyHTTPRequestByName(ctx context.Context, virtualServiceID, name string, policy *PoliciesHTTPRequestModelPolicy, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPRequestModel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, virtualServiceID, name, policy}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdatePolicyHTTPRequestByName", varargs...)
+	ret0, _ := ret[0].(*PoliciesHTTPRequestModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePolicyHTTPRequestByName indicates an expected call of UpdatePolicyHTTPRequestByName.
+func (mr *MockClientMockRecorder) UpdatePolicyHTTPRequestByName(ctx, virtualServiceID, name, policy any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePoliciesHTTPSecurity", reflect.TypeOf((*MockClient)(nil).UpdatePoliciesHTTPSecurity), ctx, policies)
+	varargs := append([]any{ctx, virtualServiceID, name, policy}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePolicyHTTPRequestByName", reflect.TypeOf((*MockClient)(nil).UpdatePolicyHTTPRequestByName), varargs...)
+}
+
+// UpdatePolicyHTTPResponseByName mocks base method.
+func (m *MockClient) UpdatePolicyHTTPResponseByName(ctx context.Context, virtualServiceID, name string, policy *PoliciesHTTPResponseModelPolicy, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPResponseModel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, virtualServiceID, name, policy}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdatePolicyHTTPResponseByName", varargs...)
+	ret0, _ := ret[0].(*PoliciesHTTPResponseModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePolicyHTTPResponseByName indicates an expected call of UpdatePolicyHTTPResponseByName.
+func (mr *MockClientMockRecorder) UpdatePolicyHTTPResponseByName(ctx, virtualServiceID, name, policy any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, virtualServiceID, name, policy}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePolicyHTTPResponseByName", reflect.TypeOf((*MockClient)(nil).UpdatePolicyHTTPResponseByName), varargs...)
+}
+
+// UpdatePolicyHTTPSecurityByName mocks base method.
+func (m *MockClient) UpdatePolicyHTTPSecurityByName(ctx context.Context, virtualServiceID, name string, policy *PoliciesHTTPSecurityModelPolicy, opts ...PoliciesHTTPUpdateOptions) (*PoliciesHTTPSecurityModel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, virtualServiceID, name, policy}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdatePolicyHTTPSecurityByName", varargs...)
+	ret0, _ := ret[0].(*PoliciesHTTPSecurityModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePolicyHTTPSecurityByName indicates an expected call of UpdatePolicyHTTPSecurityByName.
+func (mr *MockClientMockRecorder) UpdatePolicyHTTPSecurityByName(ctx, virtualServiceID, name, policy any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, virtualServiceID, name, policy}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePolicyHTTPSecurityByName", reflect.TypeOf((*MockClient)(nil).UpdatePolicyHTTPSecurityByName), varargs...)
 }
 
 // UpdatePool mocks base method.
@@ -400,6 +879,21 @@ func (m *MockclientFake) EXPECT() *MockclientFakeMockRecorder {
 	return m.recorder
 }
 
+// CreateAlbServiceEngineGroupAssignment mocks base method.
+func (m *MockclientFake) CreateAlbServiceEngineGroupAssignment(assignmentConfig *types.NsxtAlbServiceEngineGroupAssignment) (*govcd.NsxtAlbServiceEngineGroupAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlbServiceEngineGroupAssignment", assignmentConfig)
+	ret0, _ := ret[0].(*govcd.NsxtAlbServiceEngineGroupAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAlbServiceEngineGroupAssignment indicates an expected call of CreateAlbServiceEngineGroupAssignment.
+func (mr *MockclientFakeMockRecorder) CreateAlbServiceEngineGroupAssignment(assignmentConfig any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlbServiceEngineGroupAssignment", reflect.TypeOf((*MockclientFake)(nil).CreateAlbServiceEngineGroupAssignment), assignmentConfig)
+}
+
 // CreateNsxtAlbPool mocks base method.
 func (m *MockclientFake) CreateNsxtAlbPool(albPoolConfig *types.NsxtAlbPool) (*govcd.NsxtAlbPool, error) {
 	m.ctrl.T.Helper()
@@ -460,6 +954,36 @@ func (mr *MockclientFakeMockRecorder) GetAlbPoolByName(edgeGatewayID, name any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbPoolByName", reflect.TypeOf((*MockclientFake)(nil).GetAlbPoolByName), edgeGatewayID, name)
 }
 
+// GetAlbServiceEngineGroupById mocks base method.
+func (m *MockclientFake) GetAlbServiceEngineGroupById(id string) (*govcd.NsxtAlbServiceEngineGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlbServiceEngineGroupById", id)
+	ret0, _ := ret[0].(*govcd.NsxtAlbServiceEngineGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlbServiceEngineGroupById indicates an expected call of GetAlbServiceEngineGroupById.
+func (mr *MockclientFakeMockRecorder) GetAlbServiceEngineGroupById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbServiceEngineGroupById", reflect.TypeOf((*MockclientFake)(nil).GetAlbServiceEngineGroupById), id)
+}
+
+// GetAlbServiceEngineGroupByName mocks base method.
+func (m *MockclientFake) GetAlbServiceEngineGroupByName(optionalContext, name string) (*govcd.NsxtAlbServiceEngineGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlbServiceEngineGroupByName", optionalContext, name)
+	ret0, _ := ret[0].(*govcd.NsxtAlbServiceEngineGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlbServiceEngineGroupByName indicates an expected call of GetAlbServiceEngineGroupByName.
+func (mr *MockclientFakeMockRecorder) GetAlbServiceEngineGroupByName(optionalContext, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbServiceEngineGroupByName", reflect.TypeOf((*MockclientFake)(nil).GetAlbServiceEngineGroupByName), optionalContext, name)
+}
+
 // GetAlbVirtualServiceById mocks base method.
 func (m *MockclientFake) GetAlbVirtualServiceById(id string) (*govcd.NsxtAlbVirtualService, error) {
 	m.ctrl.T.Helper()
@@ -535,6 +1059,36 @@ func (mr *MockclientFakeMockRecorder) GetAllAlbVirtualServiceSummaries(edgeGatew
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllAlbVirtualServiceSummaries", reflect.TypeOf((*MockclientFake)(nil).GetAllAlbVirtualServiceSummaries), edgeGatewayID, queryParameters)
 }
 
+// GetNsxtEdgeGatewayById mocks base method.
+func (m *MockclientFake) GetNsxtEdgeGatewayById(id string) (*govcd.NsxtEdgeGateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNsxtEdgeGatewayById", id)
+	ret0, _ := ret[0].(*govcd.NsxtEdgeGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNsxtEdgeGatewayById indicates an expected call of GetNsxtEdgeGatewayById.
+func (mr *MockclientFakeMockRecorder) GetNsxtEdgeGatewayById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNsxtEdgeGatewayById", reflect.TypeOf((*MockclientFake)(nil).GetNsxtEdgeGatewayById), id)
+}
+
+// QueryWithNotEncodedParams mocks base method.
+func (m *MockclientFake) QueryWithNotEncodedParams(params, notEncodedParams map[string]string) (govcd.Results, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryWithNotEncodedParams", params, notEncodedParams)
+	ret0, _ := ret[0].(govcd.Results)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryWithNotEncodedParams indicates an expected call of QueryWithNotEncodedParams.
+func (mr *MockclientFakeMockRecorder) QueryWithNotEncodedParams(params, notEncodedParams any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWithNotEncodedParams", reflect.TypeOf((*MockclientFake)(nil).QueryWithNotEncodedParams), params, notEncodedParams)
+}
+
 // R mocks base method.
 func (m *MockclientFake) R() *resty.Request {
 	m.ctrl.T.Helper()
@@ -587,6 +1141,21 @@ func (m *MockclientGoVCD) EXPECT() *MockclientGoVCDMockRecorder {
 	return m.recorder
 }
 
+// CreateAlbServiceEngineGroupAssignment mocks base method.
+func (m *MockclientGoVCD) CreateAlbServiceEngineGroupAssignment(assignmentConfig *types.NsxtAlbServiceEngineGroupAssignment) (*govcd.NsxtAlbServiceEngineGroupAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlbServiceEngineGroupAssignment", assignmentConfig)
+	ret0, _ := ret[0].(*govcd.NsxtAlbServiceEngineGroupAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAlbServiceEngineGroupAssignment indicates an expected call of CreateAlbServiceEngineGroupAssignment.
+func (mr *MockclientGoVCDMockRecorder) CreateAlbServiceEngineGroupAssignment(assignmentConfig any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlbServiceEngineGroupAssignment", reflect.TypeOf((*MockclientGoVCD)(nil).CreateAlbServiceEngineGroupAssignment), assignmentConfig)
+}
+
 // CreateNsxtAlbPool mocks base method.
 func (m *MockclientGoVCD) CreateNsxtAlbPool(albPoolConfig *types.NsxtAlbPool) (*govcd.NsxtAlbPool, error) {
 	m.ctrl.T.Helper()
@@ -647,6 +1216,36 @@ func (mr *MockclientGoVCDMockRecorder) GetAlbPoolByName(edgeGatewayID, name any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbPoolByName", reflect.TypeOf((*MockclientGoVCD)(nil).GetAlbPoolByName), edgeGatewayID, name)
 }
 
+// GetAlbServiceEngineGroupById mocks base method.
+func (m *MockclientGoVCD) GetAlbServiceEngineGroupById(id string) (*govcd.NsxtAlbServiceEngineGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlbServiceEngineGroupById", id)
+	ret0, _ := ret[0].(*govcd.NsxtAlbServiceEngineGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlbServiceEngineGroupById indicates an expected call of GetAlbServiceEngineGroupById.
+func (mr *MockclientGoVCDMockRecorder) GetAlbServiceEngineGroupById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbServiceEngineGroupById", reflect.TypeOf((*MockclientGoVCD)(nil).GetAlbServiceEngineGroupById), id)
+}
+
+// GetAlbServiceEngineGroupByName mocks base method.
+func (m *MockclientGoVCD) GetAlbServiceEngineGroupByName(optionalContext, name string) (*govcd.NsxtAlbServiceEngineGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlbServiceEngineGroupByName", optionalContext, name)
+	ret0, _ := ret[0].(*govcd.NsxtAlbServiceEngineGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlbServiceEngineGroupByName indicates an expected call of GetAlbServiceEngineGroupByName.
+func (mr *MockclientGoVCDMockRecorder) GetAlbServiceEngineGroupByName(optionalContext, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbServiceEngineGroupByName", reflect.TypeOf((*MockclientGoVCD)(nil).GetAlbServiceEngineGroupByName), optionalContext, name)
+}
+
 // GetAlbVirtualServiceById mocks base method.
 func (m *MockclientGoVCD) GetAlbVirtualServiceById(id string) (*govcd.NsxtAlbVirtualService, error) {
 	m.ctrl.T.Helper()
@@ -722,6 +1321,60 @@ func (mr *MockclientGoVCDMockRecorder) GetAllAlbVirtualServiceSummaries(edgeGate
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllAlbVirtualServiceSummaries", reflect.TypeOf((*MockclientGoVCD)(nil).GetAllAlbVirtualServiceSummaries), edgeGatewayID, queryParameters)
 }
 
+// QueryWithNotEncodedParams mocks base method.
+func (m *MockclientGoVCD) QueryWithNotEncodedParams(params, notEncodedParams map[string]string) (govcd.Results, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryWithNotEncodedParams", params, notEncodedParams)
+	ret0, _ := ret[0].(govcd.Results)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryWithNotEncodedParams indicates an expected call of QueryWithNotEncodedParams.
+func (mr *MockclientGoVCDMockRecorder) QueryWithNotEncodedParams(params, notEncodedParams any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWithNotEncodedParams", reflect.TypeOf((*MockclientGoVCD)(nil).QueryWithNotEncodedParams), params, notEncodedParams)
+}
+
+// MockclientGoVCDOrg is a mock of clientGoVCDOrg interface.
+type MockclientGoVCDOrg struct {
+	ctrl     *gomock.Controller
+	recorder *MockclientGoVCDOrgMockRecorder
+	isgomock struct{}
+}
+
+// MockclientGoVCDOrgMockRecorder is the mock recorder for MockclientGoVCDOrg.
+type MockclientGoVCDOrgMockRecorder struct {
+	mock *MockclientGoVCDOrg
+}
+
+// NewMockclientGoVCDOrg creates a new mock instance.
+func NewMockclientGoVCDOrg(ctrl *gomock.Controller) *MockclientGoVCDOrg {
+	mock := &MockclientGoVCDOrg{ctrl: ctrl}
+	mock.recorder = &MockclientGoVCDOrgMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockclientGoVCDOrg) EXPECT() *MockclientGoVCDOrgMockRecorder {
+	return m.recorder
+}
+
+// GetNsxtEdgeGatewayById mocks base method.
+func (m *MockclientGoVCDOrg) GetNsxtEdgeGatewayById(id string) (*govcd.NsxtEdgeGateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNsxtEdgeGatewayById", id)
+	ret0, _ := ret[0].(*govcd.NsxtEdgeGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNsxtEdgeGatewayById indicates an expected call of GetNsxtEdgeGatewayById.
+func (mr *MockclientGoVCDOrgMockRecorder) GetNsxtEdgeGatewayById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNsxtEdgeGatewayById", reflect.TypeOf((*MockclientGoVCDOrg)(nil).GetNsxtEdgeGatewayById), id)
+}
+
 // MockclientCloudavenue is a mock of clientCloudavenue interface.
 type MockclientCloudavenue struct {
 	ctrl     *gomock.Controller